@@ -0,0 +1,38 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/database"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	billingService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/billing_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+)
+
+// RunBilling executa o faturamento da competencia informada, retornando o codigo de saida do processo.
+func RunBilling(competencia string, logger *slog.Logger) int {
+	db := database.GetDB()
+
+	invoiceRepository := repositories.NewInvoiceRepository(db, logger)
+	contractRepository := repositories.NewContractRepository(db, logger)
+	contractEventRepository := repositories.NewContractEventRepository(db, logger)
+	planRepository := repositories.NewPlanRepository(db, logger)
+	billingService := billingService.NewBillingService(invoiceRepository, contractRepository, contractEventRepository,
+		planRepository, policies.LoadBilling(), logger)
+
+	billingRun, responseError := billingService.RunBilling(context.Background(), competencia)
+	if responseError != (utils.ResponseError{}) {
+		logger.Error("failed to run billing", slog.String("competencia", competencia), slog.String("error", responseError.Message))
+		return 1
+	}
+
+	fmt.Printf("Competencia %v: faturas geradas: %v, já existentes: %v\n", billingRun.Competencia, billingRun.Geradas, billingRun.Existentes)
+	for _, invoice := range billingRun.Faturas {
+		fmt.Printf("%v %v %v\n", invoice.ClienteID, invoice.ID, invoice.Total)
+	}
+
+	return 0
+}
@@ -0,0 +1,58 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/database"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	paymentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/payment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+)
+
+// SuspendDelinquentContracts executa a rotina diaria de inadimplencia, retornando o codigo de saida do processo.
+func SuspendDelinquentContracts(logger *slog.Logger) int {
+	db := database.GetDB()
+
+	contractRepository := repositories.NewContractRepository(db, logger)
+	contractEventRepository := repositories.NewContractEventRepository(db, logger)
+	contractVersionRepository := repositories.NewContractVersionRepository(db, logger)
+	pointRepository := repositories.NewPointRepository(db, logger)
+	clientRepository := repositories.NewClientRepository(db, logger)
+	contactRepository := repositories.NewContactRepository(db, logger)
+	planRepository := repositories.NewPlanRepository(db, logger)
+	serviceOrderRepository := repositories.NewServiceOrderRepository(db, logger)
+	equipmentRepository := repositories.NewEquipmentRepository(db, logger)
+	equipmentMovementRepository := repositories.NewEquipmentMovementRepository(db, logger)
+	invoiceRepository := repositories.NewInvoiceRepository(db, logger)
+	paymentRepository := repositories.NewPaymentRepository(db, logger)
+
+	clientPolicies := policies.Load()
+	billingPolicy := policies.LoadBilling()
+
+	contractEventService := contractEventService.NewContractEventService(contractEventRepository, contractRepository, logger)
+	contractVersionService := contractVersionService.NewContractVersionService(contractVersionRepository, contractRepository, logger)
+	equipmentService := equipmentService.NewEquipmentService(equipmentRepository, equipmentMovementRepository, pointRepository, logger)
+	contractService := contractService.NewContractService(contractRepository, pointRepository, clientRepository, contactRepository, planRepository,
+		serviceOrderRepository, contractEventService, contractVersionService, equipmentService, clientPolicies, logger)
+	paymentService := paymentService.NewPaymentService(paymentRepository, invoiceRepository, contractService,
+		contractEventService, clientPolicies, billingPolicy, logger)
+
+	result, responseError := paymentService.SuspendDelinquentContracts(context.Background(), time.Now())
+	if responseError != (utils.ResponseError{}) {
+		logger.Error("failed to suspend delinquent contracts", slog.String("error", responseError.Message))
+		return 1
+	}
+
+	fmt.Printf("Faturas vencidas: %v, contratos suspensos: %v, contratos isentos: %v\n",
+		result.FaturasVencidas, len(result.ContratosSuspensos), len(result.ContratosIsentos))
+
+	return 0
+}
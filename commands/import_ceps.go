@@ -0,0 +1,39 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/database"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	cepService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/cep_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+)
+
+// ImportCEPs importa o arquivo CSV informado para o diretorio de CEPs local, retornando o codigo de saida do processo.
+func ImportCEPs(path string, logger *slog.Logger) int {
+	file, err := os.Open(path)
+	if err != nil {
+		logger.Error("failed to open cep file", slog.String("arquivo", path), slog.String("error", err.Error()))
+		return 1
+	}
+	defer file.Close()
+
+	cepRepository := repositories.NewCEPRepository(database.GetDB(), logger)
+	cepService := cepService.NewCEPService(cepRepository, logger)
+
+	result, responseError := cepService.ImportCEPs(context.Background(), file)
+	if responseError != (utils.ResponseError{}) {
+		logger.Error("failed to import ceps", slog.String("arquivo", path), slog.String("error", responseError.Message))
+		return 1
+	}
+
+	fmt.Printf("CEPs importados: %v, linhas ignoradas: %v\n", result.Importados, result.Ignorados)
+	for _, message := range result.Erros {
+		fmt.Println(message)
+	}
+
+	return 0
+}
@@ -0,0 +1,68 @@
+package commands
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"os"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/database"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	importService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/import_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+)
+
+// ImportRecords importa o arquivo CSV informado de clientes, endereços, pontos e contratos, com as colunas do
+// arquivo JSON de mapeamento quando informado, retornando o codigo de saida do processo.
+func ImportRecords(path string, mappingPath string, dryRun bool, logger *slog.Logger) int {
+	options := dtos.ImportOptions{Previa: dryRun}
+
+	if mappingPath != "" {
+		mapping, err := os.ReadFile(mappingPath)
+		if err != nil {
+			logger.Error("failed to read import mapping", slog.String("arquivo", mappingPath), slog.String("error", err.Error()))
+			return 1
+		}
+
+		if err := json.Unmarshal(mapping, &options.Mapeamento); err != nil {
+			logger.Error("invalid import mapping", slog.String("arquivo", mappingPath), slog.String("error", err.Error()))
+			return 1
+		}
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		logger.Error("failed to open import file", slog.String("arquivo", path), slog.String("error", err.Error()))
+		return 1
+	}
+	defer file.Close()
+
+	db := database.GetDB()
+
+	importService := importService.NewImportService(repositories.NewImportRepository(db, logger),
+		repositories.NewClientRepository(db, logger), repositories.NewAddressRepository(db, logger),
+		repositories.NewPointRepository(db, logger), repositories.NewContractRepository(db, logger),
+		repositories.NewContactRepository(db, logger), repositories.NewPlanRepository(db, logger), repositories.NewCEPRepository(db, logger), policies.Load(), logger)
+
+	result, responseError := importService.ImportRecords(context.Background(), file, options)
+	if responseError != (utils.ResponseError{}) {
+		logger.Error("failed to import records", slog.String("arquivo", path), slog.String("error", responseError.Message))
+		return 1
+	}
+
+	if result.Previa {
+		fmt.Println("Prévia: nenhum registro foi gravado")
+	}
+
+	fmt.Printf("Linhas: %v, importadas: %v, rejeitadas: %v\n", result.Linhas, result.Importadas, result.Rejeitadas)
+	fmt.Printf("Clientes criados: %v, endereços criados: %v, pontos criados: %v, contatos criados: %v, contratos criados: %v\n",
+		result.ClientesCriados, result.EnderecosCriados, result.PontosCriados, result.ContatosCriados, result.ContratosCriados)
+	for _, rowError := range result.Erros {
+		fmt.Printf("linha %v: %v\n", rowError.Linha, rowError.Erro)
+	}
+
+	return 0
+}
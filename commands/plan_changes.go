@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/database"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+)
+
+// ApplyScheduledPlanChanges executa a rotina diaria das trocas de plano agendadas, retornando o codigo de saida do processo.
+func ApplyScheduledPlanChanges(logger *slog.Logger) int {
+	db := database.GetDB()
+
+	contractRepository := repositories.NewContractRepository(db, logger)
+	contractEventRepository := repositories.NewContractEventRepository(db, logger)
+	contractVersionRepository := repositories.NewContractVersionRepository(db, logger)
+	pointRepository := repositories.NewPointRepository(db, logger)
+	clientRepository := repositories.NewClientRepository(db, logger)
+	contactRepository := repositories.NewContactRepository(db, logger)
+	planRepository := repositories.NewPlanRepository(db, logger)
+	serviceOrderRepository := repositories.NewServiceOrderRepository(db, logger)
+	equipmentRepository := repositories.NewEquipmentRepository(db, logger)
+	equipmentMovementRepository := repositories.NewEquipmentMovementRepository(db, logger)
+
+	contractEventService := contractEventService.NewContractEventService(contractEventRepository, contractRepository, logger)
+	contractVersionService := contractVersionService.NewContractVersionService(contractVersionRepository, contractRepository, logger)
+	equipmentService := equipmentService.NewEquipmentService(equipmentRepository, equipmentMovementRepository, pointRepository, logger)
+	contractService := contractService.NewContractService(contractRepository, pointRepository, clientRepository, contactRepository, planRepository,
+		serviceOrderRepository, contractEventService, contractVersionService, equipmentService, policies.Load(), logger)
+
+	applied, responseError := contractService.ApplyScheduledPlanChanges(context.Background(), time.Now())
+	if responseError != (utils.ResponseError{}) {
+		logger.Error("failed to apply scheduled plan changes", slog.String("error", responseError.Message))
+		return 1
+	}
+
+	fmt.Printf("Trocas de plano aplicadas: %v\n", applied)
+
+	return 0
+}
@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
@@ -17,15 +19,17 @@ type AddressController interface {
 	FindAddressByID(ctx *gin.Context)
 	DeleteAddress(ctx *gin.Context)
 	FindAddress(ctx *gin.Context)
+	FindDuplicateAddresses(ctx *gin.Context)
 }
 
 type addressController struct {
 	addressService services.AddressService
+	logger         *slog.Logger
 }
 
 // CreateAddress godoc
 // @Summary cria um novo endereço
-// @Description rota para o cadastro de novos endereços
+// @Description rota para o cadastro de novos endereços, preenchendo pelo diretorio de CEPs os campos não informados e retornando as divergencias e os endereços semelhantes
 // @Tags address
 // @Accept json
 // @Produce json
@@ -33,6 +37,8 @@ type addressController struct {
 // @Success 201 {object} entities.Endereco
 // @Failure 400 {object} utils.Response
 // @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /enderecos [post]
 func (controller *addressController) CreateAddress(ctx *gin.Context) {
 	addressDTO := dtos.AddressCreateDTO{}
@@ -43,8 +49,9 @@ func (controller *addressController) CreateAddress(ctx *gin.Context) {
 		return
 	}
 
-	address, responseError := controller.addressService.CreateAddress(addressDTO)
+	address, responseError := controller.addressService.CreateAddress(ctx.Request.Context(), addressDTO)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -65,6 +72,8 @@ func (controller *addressController) CreateAddress(ctx *gin.Context) {
 // @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
 // @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /endereco/{id} [put]
 func (controller *addressController) UpdateAddress(ctx *gin.Context) {
 	addressDTO := dtos.AddressUpdateDTO{}
@@ -79,8 +88,9 @@ func (controller *addressController) UpdateAddress(ctx *gin.Context) {
 
 	addressDTO.ID = addressID
 
-	address, responseError := controller.addressService.UpdateAddress(addressDTO)
+	address, responseError := controller.addressService.UpdateAddress(ctx.Request.Context(), addressDTO)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -98,15 +108,17 @@ func (controller *addressController) UpdateAddress(ctx *gin.Context) {
 // @Param id path string true "id do endereço"
 // @Success 200 {object} entities.Endereco
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /endereco/{id} [get]
 func (controller *addressController) FindAddressByID(ctx *gin.Context) {
 	addressID := ctx.Param("id")
 
-	addressFound := controller.addressService.FindAddressByID(addressID)
-
-	if addressFound == (entities.Endereco{}) {
-		response := utils.NewResponse(utils.AddressNotFound)
-		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+	addressFound, responseError := controller.addressService.FindAddressByID(ctx.Request.Context(), addressID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
 	}
 
@@ -123,12 +135,15 @@ func (controller *addressController) FindAddressByID(ctx *gin.Context) {
 // @Success 204 "No Content"
 // @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /endereco/{id} [delete]
 func (controller *addressController) DeleteAddress(ctx *gin.Context) {
 	addressID := ctx.Param("id")
 
-	responseError := controller.addressService.DeleteAddressByID(addressID)
+	responseError := controller.addressService.DeleteAddressByID(ctx.Request.Context(), addressID)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -148,14 +163,22 @@ func (controller *addressController) DeleteAddress(ctx *gin.Context) {
 // @Param numero query string false "numero da casa"
 // @Success 200 {object} []entities.Endereco
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /enderecos [get]
 func (controller *addressController) FindAddress(ctx *gin.Context) {
 	addressNeighborhood := ctx.Query("bairro")
 	addressStreet := ctx.Query("logradouro")
 	addressNumber := ctx.Query("numero")
 
-	addresses := controller.addressService.FindAddresses(
+	addresses, responseError := controller.addressService.FindAddresses(ctx.Request.Context(),
 		addressStreet, addressNeighborhood, addressNumber)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
 
 	if len(addresses) == 0 {
 		response := utils.NewResponse(utils.AddressNotFound)
@@ -170,9 +193,58 @@ func (controller *addressController) FindAddress(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// FindDuplicateAddresses godoc
+// @Summary lista os endereços possivelmente duplicados
+// @Description rota para o relatorio dos grupos de endereços com o mesmo CEP, numero e complemento e com o logradouro e o bairro parecidos
+// @Tags address
+// @Accept json
+// @Produce json
+// @Param similaridade query number false "semelhança minima, de 0 a 1 (padrão 0.85)"
+// @Success 200 {object} []dtos.AddressDuplicateCluster
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /enderecos/duplicados [get]
+func (controller *addressController) FindDuplicateAddresses(ctx *gin.Context) {
+	minSimilarity := services.DuplicateSimilarity
+
+	if value := ctx.Query("similaridade"); value != "" {
+		similarity, err := strconv.ParseFloat(value, 64)
+		if err != nil || similarity < 0 || similarity > 1 {
+			response := utils.NewResponse(utils.InvalidSimilarity)
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+
+		minSimilarity = similarity
+	}
+
+	clusters, responseError := controller.addressService.FindDuplicateAddresses(ctx.Request.Context(), minSimilarity)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(clusters) == 0 {
+		response := utils.NewResponse(utils.AddressNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	response := map[string][]dtos.AddressDuplicateCluster{
+		"dados": clusters,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // NewAddressController cria uma nova isnancia de AddressController.
-func NewAddressController(addressService services.AddressService) AddressController {
+func NewAddressController(addressService services.AddressService, logger *slog.Logger) AddressController {
 	return &addressController{
 		addressService: addressService,
+		logger:         logger,
 	}
 }
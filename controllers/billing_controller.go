@@ -0,0 +1,127 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/billing_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// BillingController representa o contracto de BillingController.
+type BillingController interface {
+	RunBilling(ctx *gin.Context)
+	FindInvoiceByID(ctx *gin.Context)
+	FindInvoices(ctx *gin.Context)
+}
+
+type billingController struct {
+	billingService services.BillingService
+	logger         *slog.Logger
+}
+
+// RunBilling godoc
+// @Summary executa o faturamento da competencia
+// @Description rota para gerar uma fatura por cliente na competencia, mantendo as faturas já geradas
+// @Tags billing
+// @Accept json
+// @Produce json
+// @Param competencia query string true "competencia no formato 2026-10"
+// @Success 200 {object} dtos.BillingRunResponse
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /faturamento/executar [post]
+func (controller *billingController) RunBilling(ctx *gin.Context) {
+	competencia := ctx.Query("competencia")
+
+	billingRun, responseError := controller.billingService.RunBilling(ctx.Request.Context(), competencia)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, billingRun)
+}
+
+// FindInvoiceByID godoc
+// @Summary pesquisa a fatura
+// @Description rota para a pesquisa da fatura pelo id, com os itens do calculo
+// @Tags billing
+// @Accept json
+// @Produce json
+// @Param id path string true "id da fatura"
+// @Success 200 {object} dtos.InvoiceResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /fatura/{id} [get]
+func (controller *billingController) FindInvoiceByID(ctx *gin.Context) {
+	invoiceID := ctx.Param("id")
+
+	invoice, responseError := controller.billingService.FindInvoiceByID(ctx.Request.Context(), invoiceID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateInvoiceResponse(invoice))
+}
+
+// FindInvoices godoc
+// @Summary lista as faturas existentes
+// @Description rota para a listagem das faturas, podendo filtrar pelo cliente e pela competencia
+// @Tags billing
+// @Accept json
+// @Produce json
+// @Param cliente_id query string false "id do cliente"
+// @Param competencia query string false "competencia no formato 2026-10"
+// @Success 200 {object} []dtos.InvoiceResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /faturas [get]
+func (controller *billingController) FindInvoices(ctx *gin.Context) {
+	clientID := ctx.Query("cliente_id")
+	competencia := ctx.Query("competencia")
+
+	invoices, responseError := controller.billingService.FindInvoices(ctx.Request.Context(), clientID, competencia)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(invoices) == 0 {
+		response := utils.NewResponse(utils.InvoiceNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	invoicesResponse := []dtos.InvoiceResponse{}
+
+	for _, invoice := range invoices {
+		invoicesResponse = append(invoicesResponse, dtos.CreateInvoiceResponse(invoice))
+	}
+
+	response := map[string][]dtos.InvoiceResponse{
+		"dados": invoicesResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// NewBillingController cria uma nova isnancia de BillingController.
+func NewBillingController(billingService services.BillingService, logger *slog.Logger) BillingController {
+	return &billingController{
+		billingService: billingService,
+		logger:         logger,
+	}
+}
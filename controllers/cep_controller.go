@@ -0,0 +1,95 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/cep_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// CEPController representa o contracto de CEPController.
+type CEPController interface {
+	FindCEP(ctx *gin.Context)
+	ImportCEPs(ctx *gin.Context)
+}
+
+type cepController struct {
+	cepService services.CEPService
+	logger     *slog.Logger
+}
+
+// FindCEP godoc
+// @Summary pesquisa o CEP
+// @Description rota para a pesquisa do logradouro, bairro, cidade e uf do CEP no diretorio de CEPs local
+// @Tags cep
+// @Accept json
+// @Produce json
+// @Param cep path string true "CEP, com ou sem o traço"
+// @Success 200 {object} entities.Cep
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cep/{cep} [get]
+func (controller *cepController) FindCEP(ctx *gin.Context) {
+	cep := ctx.Param("cep")
+
+	cepFound, responseError := controller.cepService.FindCEP(ctx.Request.Context(), cep)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, cepFound)
+}
+
+// ImportCEPs godoc
+// @Summary importa o diretorio de CEPs
+// @Description rota para a importação de um arquivo CSV (cep, logradouro, bairro, cidade, uf) para o diretorio de CEPs local
+// @Tags cep
+// @Accept multipart/form-data
+// @Produce json
+// @Param arquivo formData file true "arquivo CSV separado por ponto e virgula ou virgula"
+// @Success 200 {object} dtos.CEPImportResult
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cep/importacoes [post]
+func (controller *cepController) ImportCEPs(ctx *gin.Context) {
+	fileHeader, err := ctx.FormFile("arquivo")
+	if err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+	defer file.Close()
+
+	result, responseError := controller.cepService.ImportCEPs(ctx.Request.Context(), file)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// NewCEPController cria uma nova isnancia de CEPController.
+func NewCEPController(cepService services.CEPService, logger *slog.Logger) CEPController {
+	return &cepController{
+		cepService: cepService,
+		logger:     logger,
+	}
+}
@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"log/slog"
 	"net/http"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
@@ -17,10 +18,12 @@ type ClientController interface {
 	FindClientByID(ctx *gin.Context)
 	DeleteClient(ctx *gin.Context)
 	FindClients(ctx *gin.Context)
+	FindBranches(ctx *gin.Context)
 }
 
 type clientController struct {
 	clientService services.ClientService
+	logger        *slog.Logger
 }
 
 // CreateClient godoc
@@ -33,6 +36,8 @@ type clientController struct {
 // @Success 201 {object} entities.Cliente
 // @Failure 400 {object} utils.Response
 // @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /clientes [post]
 func (controller *clientController) CreateClient(ctx *gin.Context) {
 	clientDTO := dtos.ClientCreateDTO{}
@@ -43,8 +48,9 @@ func (controller *clientController) CreateClient(ctx *gin.Context) {
 		return
 	}
 
-	client, responseError := controller.clientService.CreateClient(clientDTO)
+	client, responseError := controller.clientService.CreateClient(ctx.Request.Context(), clientDTO)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -65,6 +71,8 @@ func (controller *clientController) CreateClient(ctx *gin.Context) {
 // @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
 // @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /cliente/{id} [put]
 func (controller *clientController) UpdateClient(ctx *gin.Context) {
 	clientDTO := dtos.ClientUpdateDTO{}
@@ -79,8 +87,9 @@ func (controller *clientController) UpdateClient(ctx *gin.Context) {
 
 	clientDTO.ID = clientID
 
-	client, responseError := controller.clientService.UpdateClient(clientDTO)
+	client, responseError := controller.clientService.UpdateClient(ctx.Request.Context(), clientDTO)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -91,26 +100,28 @@ func (controller *clientController) UpdateClient(ctx *gin.Context) {
 
 // FindClientByID godoc
 // @Summary pesquisa o cliente
-// @Description rota para a pesquisa do cliente pelo id
+// @Description rota para a pesquisa do cliente pelo id, com os chamados em aberto
 // @Tags client
 // @Accept json
 // @Produce json
 // @Param id path string true "id do cliente"
-// @Success 200 {object} entities.Cliente
+// @Success 200 {object} dtos.ClientDetailResponse
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /cliente/{id} [get]
 func (controller *clientController) FindClientByID(ctx *gin.Context) {
 	clientID := ctx.Param("id")
 
-	clientFound := controller.clientService.FindClientByID(clientID)
-
-	if clientFound == (entities.Cliente{}) {
-		response := utils.NewResponse(utils.ClientNotFound)
-		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+	clientFound, openTickets, responseError := controller.clientService.FindClientDetail(ctx.Request.Context(), clientID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
 	}
 
-	ctx.JSON(http.StatusOK, clientFound)
+	ctx.JSON(http.StatusOK, dtos.CreateClientDetailResponse(clientFound, openTickets))
 }
 
 // DeleteClient godoc
@@ -123,12 +134,15 @@ func (controller *clientController) FindClientByID(ctx *gin.Context) {
 // @Success 204 "No Content"
 // @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /cliente/{id} [delete]
 func (controller *clientController) DeleteClient(ctx *gin.Context) {
 	clientID := ctx.Param("id")
 
-	responseError := controller.clientService.DeleteClientByID(clientID)
+	responseError := controller.clientService.DeleteClientByID(ctx.Request.Context(), clientID)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -145,14 +159,24 @@ func (controller *clientController) DeleteClient(ctx *gin.Context) {
 // @Produce json
 // @Param tipo query string false "tipo de cliente"
 // @Param nome query string false "nome do cliente"
+// @Param documento query string false "CPF ou CNPJ do cliente, com ou sem formatação"
 // @Success 200 {object} []entities.Cliente
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /clientes [get]
 func (controller *clientController) FindClients(ctx *gin.Context) {
 	clientType := ctx.Query("tipo")
 	clientName := ctx.Query("nome")
+	clientDocument := ctx.Query("documento")
 
-	clients := controller.clientService.FindClients(clientName, entities.ClientType(clientType))
+	clients, responseError := controller.clientService.FindClients(ctx.Request.Context(), clientName, entities.ClientType(clientType), clientDocument)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
 
 	if len(clients) == 0 {
 		response := utils.NewResponse(utils.ClientNotFound)
@@ -167,9 +191,52 @@ func (controller *clientController) FindClients(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// FindBranches godoc
+// @Summary lista as filiais de um cliente
+// @Description rota para a listagem das filiais diretas e indiretas de um cliente juridico
+// @Tags client
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Success 200 {object} []dtos.ClientBranchResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/filiais [get]
+func (controller *clientController) FindBranches(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+
+	branches, responseError := controller.clientService.FindBranches(ctx.Request.Context(), clientID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(branches) == 0 {
+		response := utils.NewResponse(utils.BranchesNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	branchesResponse := []dtos.ClientBranchResponse{}
+
+	for _, branch := range branches {
+		branchesResponse = append(branchesResponse, dtos.CreateClientBranchResponse(branch))
+	}
+
+	response := map[string][]dtos.ClientBranchResponse{
+		"dados": branchesResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // NewClientController cria uma nova isnancia de ClientController.
-func NewClientController(clientService services.ClientService) ClientController {
+func NewClientController(clientService services.ClientService, logger *slog.Logger) ClientController {
 	return &clientController{
 		clientService: clientService,
+		logger:        logger,
 	}
 }
@@ -0,0 +1,107 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_merge_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ClientMergeController representa o contracto de ClientMergeController.
+type ClientMergeController interface {
+	MergeClients(ctx *gin.Context)
+	FindClientMerges(ctx *gin.Context)
+}
+
+type clientMergeController struct {
+	clientMergeService services.ClientMergeService
+	logger             *slog.Logger
+}
+
+// MergeClients godoc
+// @Summary mescla clientes duplicados
+// @Description rota para mesclar os clientes duplicados no cliente sobrevivente, transferindo os pontos, contratos e contatos e removendo os duplicados.
+// @Description Com previa, retorna as alterações sem aplica-las.
+// @Tags client
+// @Accept json
+// @Produce json
+// @Param merge body dtos.ClientMergeDTO true "Mesclar Clientes"
+// @Success 200 {object} dtos.ClientMergePlan
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /clientes/merge [post]
+func (controller *clientMergeController) MergeClients(ctx *gin.Context) {
+	clientMergeDTO := dtos.ClientMergeDTO{}
+
+	if err := ctx.ShouldBindJSON(&clientMergeDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	plan, responseError := controller.clientMergeService.MergeClients(ctx.Request.Context(), clientMergeDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, plan)
+}
+
+// FindClientMerges godoc
+// @Summary lista as mesclagens do cliente
+// @Description rota para a listagem do historico das mesclagens em que o cliente foi o sobrevivente
+// @Tags client
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Success 200 {object} []dtos.ClientMergeResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/mesclagens [get]
+func (controller *clientMergeController) FindClientMerges(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+
+	clientMerges, responseError := controller.clientMergeService.FindClientMergesByClientID(ctx.Request.Context(), clientID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(clientMerges) == 0 {
+		response := utils.NewResponse(utils.ClientMergeNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	clientMergesResponse := []dtos.ClientMergeResponse{}
+
+	for _, clientMerge := range clientMerges {
+		clientMergesResponse = append(clientMergesResponse, dtos.CreateClientMergeResponse(clientMerge))
+	}
+
+	response := map[string][]dtos.ClientMergeResponse{
+		"dados": clientMergesResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// NewClientMergeController cria uma nova isnancia de ClientMergeController.
+func NewClientMergeController(clientMergeService services.ClientMergeService, logger *slog.Logger) ClientMergeController {
+	return &clientMergeController{
+		clientMergeService: clientMergeService,
+		logger:             logger,
+	}
+}
@@ -0,0 +1,245 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ContactController representa o contracto de ContactController.
+type ContactController interface {
+	CreateContact(ctx *gin.Context)
+	UpdateContact(ctx *gin.Context)
+	FindContactByID(ctx *gin.Context)
+	DeleteContact(ctx *gin.Context)
+	FindContacts(ctx *gin.Context)
+	UpdatePreferredChannel(ctx *gin.Context)
+}
+
+type contactController struct {
+	contactService services.ContactService
+	logger         *slog.Logger
+}
+
+// CreateContact godoc
+// @Summary cria um novo contato do cliente
+// @Description rota para o cadastro de emails, telefones e whatsapps do cliente
+// @Tags contact
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Param contact body dtos.ContactCreateDTO true "Criar Novo Contato"
+// @Success 201 {object} dtos.ContactResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/contatos [post]
+func (controller *contactController) CreateContact(ctx *gin.Context) {
+	contactDTO := dtos.ContactCreateDTO{}
+
+	if err := ctx.ShouldBindJSON(&contactDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	contactDTO.ClienteID = ctx.Param("id")
+
+	contact, responseError := controller.contactService.CreateContact(ctx.Request.Context(), contactDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreateContactResponse(contact))
+}
+
+// UpdateContact godoc
+// @Summary atualiza o contato do cliente
+// @Description rota para a atualização do valor, do contato principal e da verificação do contato
+// @Tags contact
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Param contato_id path string true "id do contato"
+// @Param contact body dtos.ContactUpdateDTO true "atualizar contato"
+// @Success 200 {object} dtos.ContactResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/contatos/{contato_id} [put]
+func (controller *contactController) UpdateContact(ctx *gin.Context) {
+	contactDTO := dtos.ContactUpdateDTO{}
+
+	if err := ctx.ShouldBindJSON(&contactDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	contactDTO.ClienteID = ctx.Param("id")
+	contactDTO.ID = ctx.Param("contato_id")
+
+	contact, responseError := controller.contactService.UpdateContact(ctx.Request.Context(), contactDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateContactResponse(contact))
+}
+
+// FindContactByID godoc
+// @Summary pesquisa o contato do cliente
+// @Description rota para a pesquisa do contato do cliente pelo id
+// @Tags contact
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Param contato_id path string true "id do contato"
+// @Success 200 {object} dtos.ContactResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/contatos/{contato_id} [get]
+func (controller *contactController) FindContactByID(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+	contactID := ctx.Param("contato_id")
+
+	contact, responseError := controller.contactService.FindContactByID(ctx.Request.Context(), clientID, contactID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateContactResponse(contact))
+}
+
+// DeleteContact godoc
+// @Summary deleta o contato do cliente
+// @Description rota para a exclusão do contato do cliente pelo id
+// @Tags contact
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Param contato_id path string true "id do contato"
+// @Success 204 "No Content"
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/contatos/{contato_id} [delete]
+func (controller *contactController) DeleteContact(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+	contactID := ctx.Param("contato_id")
+
+	responseError := controller.contactService.DeleteContactByID(ctx.Request.Context(), clientID, contactID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, entities.Contato{})
+}
+
+// FindContacts godoc
+// @Summary lista os contatos do cliente
+// @Description rota para a listagem de todos os contatos do cliente
+// @Tags contact
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Success 200 {object} []dtos.ContactResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/contatos [get]
+func (controller *contactController) FindContacts(ctx *gin.Context) {
+	clientID := ctx.Param("id")
+
+	contacts, responseError := controller.contactService.FindContactsByClientID(ctx.Request.Context(), clientID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(contacts) == 0 {
+		response := utils.NewResponse(utils.ContactNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	contactsResponse := []dtos.ContactResponse{}
+
+	for _, contact := range contacts {
+		contactsResponse = append(contactsResponse, dtos.CreateContactResponse(contact))
+	}
+
+	response := map[string][]dtos.ContactResponse{
+		"dados": contactsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdatePreferredChannel godoc
+// @Summary define o canal preferido do cliente
+// @Description rota para definir o tipo de contato preferido do cliente, que precisa possuir um contato desse tipo
+// @Tags contact
+// @Accept json
+// @Produce json
+// @Param id path string true "id do cliente"
+// @Param channel body dtos.PreferredChannelUpdateDTO true "canal preferido"
+// @Success 200 {object} entities.Cliente
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /cliente/{id}/canal-preferido [put]
+func (controller *contactController) UpdatePreferredChannel(ctx *gin.Context) {
+	channelDTO := dtos.PreferredChannelUpdateDTO{}
+
+	if err := ctx.ShouldBindJSON(&channelDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	clientID := ctx.Param("id")
+
+	client, responseError := controller.contactService.UpdatePreferredChannel(ctx.Request.Context(), clientID, channelDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, client)
+}
+
+// NewContactController cria uma nova isnancia de ContactController.
+func NewContactController(contactService services.ContactService, logger *slog.Logger) ContactController {
+	return &contactController{
+		contactService: contactService,
+		logger:         logger,
+	}
+}
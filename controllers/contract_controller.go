@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
@@ -17,10 +19,15 @@ type ContractController interface {
 	FindContractByID(ctx *gin.Context)
 	DeleteContract(ctx *gin.Context)
 	FindContracts(ctx *gin.Context)
+	SummarizeContracts(ctx *gin.Context)
+	FindContractsForRenewal(ctx *gin.Context)
+	ChangePlan(ctx *gin.Context)
+	RenewContract(ctx *gin.Context)
 }
 
 type contractController struct {
 	contractService services.ContractService
+	logger          *slog.Logger
 }
 
 // CreateContract godoc
@@ -33,6 +40,8 @@ type contractController struct {
 // @Success 201 {object} entities.Contrato
 // @Failure 400 {object} utils.Response
 // @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /contratos [post]
 func (controller *contractController) CreateContract(ctx *gin.Context) {
 	contractDTO := dtos.ContractCreateDTO{}
@@ -44,9 +53,13 @@ func (controller *contractController) CreateContract(ctx *gin.Context) {
 	}
 
 	contractDTO.Estado = entities.VIGOR
+	if contractDTO.Instalacao {
+		contractDTO.Estado = entities.PENDENTE
+	}
 
-	contract, responseError := controller.contractService.CreateContract(contractDTO)
+	contract, responseError := controller.contractService.CreateContract(ctx.Request.Context(), contractDTO)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -67,6 +80,8 @@ func (controller *contractController) CreateContract(ctx *gin.Context) {
 // @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
 // @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /contrato/{id} [put]
 func (controller *contractController) UpdateContract(ctx *gin.Context) {
 	contractDTO := dtos.ContractUpdateDTO{}
@@ -81,8 +96,9 @@ func (controller *contractController) UpdateContract(ctx *gin.Context) {
 
 	contractDTO.ID = contractID
 
-	contract, responseError := controller.contractService.UpdateContract(contractDTO)
+	contract, responseError := controller.contractService.UpdateContract(ctx.Request.Context(), contractDTO)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -100,15 +116,17 @@ func (controller *contractController) UpdateContract(ctx *gin.Context) {
 // @Param id path string true "id do contrato"
 // @Success 200 {object} dtos.ContractResponse
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /contrato/{id} [get]
 func (controller *contractController) FindContractByID(ctx *gin.Context) {
 	contractID := ctx.Param("id")
 
-	contractFound := controller.contractService.FindContractByID(contractID)
-
-	if contractFound == (entities.Contrato{}) {
-		response := utils.NewResponse(utils.ContractNotFound)
-		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+	contractFound, responseError := controller.contractService.FindContractByID(ctx.Request.Context(), contractID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
 	}
 
@@ -127,12 +145,15 @@ func (controller *contractController) FindContractByID(ctx *gin.Context) {
 // @Success 204 "No Content"
 // @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /contrato/{id} [delete]
 func (controller *contractController) DeleteContract(ctx *gin.Context) {
 	contractID := ctx.Param("id")
 
-	responseError := controller.contractService.DeleteContractByID(contractID)
+	responseError := controller.contractService.DeleteContractByID(ctx.Request.Context(), contractID)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -149,14 +170,78 @@ func (controller *contractController) DeleteContract(ctx *gin.Context) {
 // @Produce json
 // @Param cliente_id query string false "id do cliente"
 // @Param endereco_id query string false "id do endereço"
+// @Param incluir_filiais query bool false "inclui os contratos das filiais do cliente"
 // @Success 200 {object} []dtos.ContractResponse
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /contratos [get]
 func (controller *contractController) FindContracts(ctx *gin.Context) {
 	clientID := ctx.Query("cliente_id")
 	addressID := ctx.Query("endereco_id")
+	includeBranches := ctx.Query("incluir_filiais") == "true"
+
+	contracts, responseError := controller.contractService.FindContracts(ctx.Request.Context(), clientID, addressID, includeBranches)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(contracts) == 0 {
+		response := utils.NewResponse(utils.ContractNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	contractsResponse := []dtos.ContractResponse{}
+
+	for _, contract := range contracts {
+		contractsResponse = append(contractsResponse, dtos.CreateContractResponse(contract))
+	}
+
+	response := map[string][]dtos.ContractResponse{
+		"dados": contractsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// FindContractsForRenewal godoc
+// @Summary lista os contratos a renovar
+// @Description rota para a listagem dos contratos ativos com a data de termino, ou o fim da fidelidade, nos proximos dias
+// @Tags contract
+// @Accept json
+// @Produce json
+// @Param dias query int false "quantidade de dias, de 1 a 365 (padrão 30)"
+// @Success 200 {object} []dtos.ContractResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /contratos/renovacao [get]
+func (controller *contractController) FindContractsForRenewal(ctx *gin.Context) {
+	days := services.RenewalWindow
+
+	if value := ctx.Query("dias"); value != "" {
+		window, err := strconv.Atoi(value)
+		if err != nil {
+			response := utils.NewResponse(utils.InvalidRenewalWindow)
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+
+		days = window
+	}
 
-	contracts := controller.contractService.FindContracts(clientID, addressID)
+	contracts, responseError := controller.contractService.FindContractsForRenewal(ctx.Request.Context(), days)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
 
 	if len(contracts) == 0 {
 		response := utils.NewResponse(utils.ContractNotFound)
@@ -177,9 +262,116 @@ func (controller *contractController) FindContracts(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// SummarizeContracts godoc
+// @Summary resume os contratos por estado
+// @Description rota para o resumo dos contratos de um cliente, ou do seu grupo de filiais, totalizados por estado
+// @Tags contract
+// @Accept json
+// @Produce json
+// @Param cliente_id query string false "id do cliente"
+// @Param incluir_filiais query bool false "inclui os contratos das filiais do cliente"
+// @Success 200 {object} dtos.ContractSummaryResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /contratos/resumo [get]
+func (controller *contractController) SummarizeContracts(ctx *gin.Context) {
+	clientID := ctx.Query("cliente_id")
+	includeBranches := ctx.Query("incluir_filiais") == "true"
+
+	contracts, responseError := controller.contractService.FindContracts(ctx.Request.Context(), clientID, "", includeBranches)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(contracts) == 0 {
+		response := utils.NewResponse(utils.ContractNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateContractSummaryResponse(clientID, contracts))
+}
+
+// ChangePlan godoc
+// @Summary troca o plano do contrato
+// @Description rota para o upgrade ou downgrade do plano do contrato, registrando a troca e a data efetiva no historico do contrato. A troca com a data efetiva futura é agendada, e o contrato mantém o plano atual até a data
+// @Tags contract
+// @Accept json
+// @Produce json
+// @Param id path string true "id do contrato"
+// @Param plan body dtos.ContractPlanChangeDTO true "trocar plano"
+// @Success 200 {object} dtos.ContractResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /contrato/{id}/plano [put]
+func (controller *contractController) ChangePlan(ctx *gin.Context) {
+	planChangeDTO := dtos.ContractPlanChangeDTO{}
+
+	if err := ctx.ShouldBindJSON(&planChangeDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	planChangeDTO.ID = ctx.Param("id")
+
+	contract, responseError := controller.contractService.ChangePlan(ctx.Request.Context(), planChangeDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateContractResponse(contract))
+}
+
+// RenewContract godoc
+// @Summary renova o contrato
+// @Description rota para a renovação do contrato até a nova data de termino, registrando a renovação como uma nova versão do contrato
+// @Tags contract
+// @Accept json
+// @Produce json
+// @Param id path string true "id do contrato"
+// @Param renewal body dtos.ContractRenewalDTO true "renovar contrato"
+// @Success 200 {object} dtos.ContractResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /contrato/{id}/renovacao [post]
+func (controller *contractController) RenewContract(ctx *gin.Context) {
+	renewalDTO := dtos.ContractRenewalDTO{}
+
+	if err := ctx.ShouldBindJSON(&renewalDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	renewalDTO.ID = ctx.Param("id")
+
+	contract, responseError := controller.contractService.RenewContract(ctx.Request.Context(), renewalDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateContractResponse(contract))
+}
+
 // NewContractController cria uma nova isnancia de ContractController.
-func NewContractController(contractService services.ContractService) ContractController {
+func NewContractController(contractService services.ContractService, logger *slog.Logger) ContractController {
 	return &contractController{
 		contractService: contractService,
+		logger:          logger,
 	}
 }
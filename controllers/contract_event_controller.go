@@ -1,6 +1,7 @@
 package controllers
 
 import (
+	"log/slog"
 	"net/http"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
@@ -16,6 +17,7 @@ type ContractEventController interface {
 
 type contractEventController struct {
 	contractEventService services.ContractEventService
+	logger               *slog.Logger
 }
 
 // FindContractEventsByContractID godoc
@@ -27,11 +29,20 @@ type contractEventController struct {
 // @Param id path string true "id do contrato"
 // @Success 200 {object} []dtos.ContractEventResponse
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /contrato/{id}/historico [get]
 func (controller *contractEventController) FindContractEventsByContractID(ctx *gin.Context) {
 	contractID := ctx.Param("id")
 
-	contractEvents := controller.contractEventService.FindContractEventsByContractID(contractID)
+	contractEvents, responseError := controller.contractEventService.FindContractEventsByContractID(ctx.Request.Context(), contractID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
 	if len(contractEvents) == 0 {
 		response := utils.NewResponse(utils.HistoryOfContractNotFound)
 		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
@@ -52,8 +63,9 @@ func (controller *contractEventController) FindContractEventsByContractID(ctx *g
 }
 
 // NewContractEventController cria uma nova isnancia de ContractEventController.
-func NewContractEventController(contractEventService services.ContractEventService) ContractEventController {
+func NewContractEventController(contractEventService services.ContractEventService, logger *slog.Logger) ContractEventController {
 	return &contractEventController{
 		contractEventService: contractEventService,
+		logger:               logger,
 	}
 }
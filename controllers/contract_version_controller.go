@@ -0,0 +1,144 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ContractVersionController representa o contracto de ContractVersionController.
+type ContractVersionController interface {
+	FindContractVersions(ctx *gin.Context)
+	DiffContractVersions(ctx *gin.Context)
+}
+
+type contractVersionController struct {
+	contractVersionService services.ContractVersionService
+	logger                 *slog.Logger
+}
+
+// FindContractVersions godoc
+// @Summary pesquisa as versões do contrato
+// @Description rota para a pesquisa das versões (aditivos) do contrato, ou da versão em vigor na data informada
+// @Tags contractVersion
+// @Accept json
+// @Produce json
+// @Param id path string true "id do contrato"
+// @Param data query string false "data da versão em vigor, como 2026-10-19"
+// @Success 200 {object} []dtos.ContractVersionResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /contrato/{id}/versoes [get]
+func (controller *contractVersionController) FindContractVersions(ctx *gin.Context) {
+	contractID := ctx.Param("id")
+
+	contractVersions := []entities.ContratoVersao{}
+	responseError := utils.ResponseError{}
+
+	if value := ctx.Query("data"); value != "" {
+		date, err := time.ParseInLocation(dtos.DateLayout, value, time.Local)
+		if err != nil {
+			response := utils.NewResponse(utils.InvalidDate)
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+
+		// A versão em vigor na data inclui as versões que passam a valer ao longo do dia.
+		contractVersion, responseError := controller.contractVersionService.FindContractVersionAt(ctx.Request.Context(), contractID, date.AddDate(0, 0, 1).Add(-time.Nanosecond))
+		if responseError != (utils.ResponseError{}) {
+			logResponseError(ctx, controller.logger, responseError)
+			response := utils.NewResponse(responseError.Message)
+			ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+			return
+		}
+
+		contractVersions = append(contractVersions, contractVersion)
+	} else {
+		contractVersions, responseError = controller.contractVersionService.FindContractVersions(ctx.Request.Context(), contractID)
+		if responseError != (utils.ResponseError{}) {
+			logResponseError(ctx, controller.logger, responseError)
+			response := utils.NewResponse(responseError.Message)
+			ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+			return
+		}
+	}
+
+	if len(contractVersions) == 0 {
+		response := utils.NewResponse(utils.ContractVersionNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	contractVersionsResponse := []dtos.ContractVersionResponse{}
+
+	for _, contractVersion := range contractVersions {
+		contractVersionsResponse = append(contractVersionsResponse, dtos.CreateContractVersionResponse(contractVersion))
+	}
+
+	response := map[string][]dtos.ContractVersionResponse{
+		"dados": contractVersionsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// DiffContractVersions godoc
+// @Summary compara duas versões do contrato
+// @Description rota para a comparação dos termos de duas versões do contrato, por padrão a ultima versão e a sua anterior
+// @Tags contractVersion
+// @Accept json
+// @Produce json
+// @Param id path string true "id do contrato"
+// @Param de query int false "numero da versão inicial"
+// @Param para query int false "numero da versão final"
+// @Success 200 {object} dtos.ContractVersionDiffResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /contrato/{id}/versoes/diferencas [get]
+func (controller *contractVersionController) DiffContractVersions(ctx *gin.Context) {
+	contractID := ctx.Param("id")
+
+	versions := map[string]int{"de": 0, "para": 0}
+
+	for key := range versions {
+		if value := ctx.Query(key); value != "" {
+			number, err := strconv.Atoi(value)
+			if err != nil || number < 1 {
+				response := utils.NewResponse(utils.InvalidVersionDiff)
+				ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+				return
+			}
+
+			versions[key] = number
+		}
+	}
+
+	diffResponse, responseError := controller.contractVersionService.DiffContractVersions(ctx.Request.Context(), contractID, versions["de"], versions["para"])
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, diffResponse)
+}
+
+// NewContractVersionController cria uma nova isnancia de ContractVersionController.
+func NewContractVersionController(contractVersionService services.ContractVersionService, logger *slog.Logger) ContractVersionController {
+	return &contractVersionController{
+		contractVersionService: contractVersionService,
+		logger:                 logger,
+	}
+}
@@ -0,0 +1,258 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// EquipmentController representa o contracto de EquipmentController.
+type EquipmentController interface {
+	CreateEquipment(ctx *gin.Context)
+	FindEquipmentByID(ctx *gin.Context)
+	FindEquipments(ctx *gin.Context)
+	FindEquipmentsToReturn(ctx *gin.Context)
+	FindEquipmentMovements(ctx *gin.Context)
+	MoveEquipment(ctx *gin.Context)
+}
+
+type equipmentController struct {
+	equipmentService services.EquipmentService
+	logger           *slog.Logger
+}
+
+// CreateEquipment godoc
+// @Summary cadastra um novo equipamento
+// @Description rota para o cadastro de roteadores e ONTs no estoque
+// @Tags equipment
+// @Accept json
+// @Produce json
+// @Param equipment body dtos.EquipmentCreateDTO true "Cadastrar Equipamento"
+// @Success 201 {object} dtos.EquipmentResponse
+// @Failure 400 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /equipamentos [post]
+func (controller *equipmentController) CreateEquipment(ctx *gin.Context) {
+	equipmentDTO := dtos.EquipmentCreateDTO{}
+
+	if err := ctx.ShouldBindJSON(&equipmentDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	equipment, responseError := controller.equipmentService.CreateEquipment(ctx.Request.Context(), equipmentDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreateEquipmentResponse(equipment))
+}
+
+// FindEquipmentByID godoc
+// @Summary pesquisa o equipamento
+// @Description rota para a pesquisa do equipamento pelo id
+// @Tags equipment
+// @Accept json
+// @Produce json
+// @Param id path string true "id do equipamento"
+// @Success 200 {object} dtos.EquipmentResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /equipamento/{id} [get]
+func (controller *equipmentController) FindEquipmentByID(ctx *gin.Context) {
+	equipmentID := ctx.Param("id")
+
+	equipment, responseError := controller.equipmentService.FindEquipmentByID(ctx.Request.Context(), equipmentID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateEquipmentResponse(equipment))
+}
+
+// FindEquipments godoc
+// @Summary lista os equipamentos
+// @Description rota para a listagem do inventario de equipamentos, ordenados pelo serial
+// @Tags equipment
+// @Accept json
+// @Produce json
+// @Param ponto_id query string false "id do ponto"
+// @Param estado query string false "estoque, instalado, defeito ou extraviado"
+// @Param devolucao_pendente query bool false "equipamentos com devolução pendente"
+// @Success 200 {object} []dtos.EquipmentResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /equipamentos [get]
+func (controller *equipmentController) FindEquipments(ctx *gin.Context) {
+	filter := dtos.EquipmentFilter{
+		PontoID: ctx.Query("ponto_id"),
+		Estado:  entities.EquipmentStatus(ctx.Query("estado")),
+	}
+
+	if value := ctx.Query("devolucao_pendente"); value != "" {
+		pending := value == "true"
+		filter.DevolucaoPendente = &pending
+	}
+
+	equipments, responseError := controller.equipmentService.FindEquipments(ctx.Request.Context(), filter)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(equipments) == 0 {
+		response := utils.NewResponse(utils.EquipmentNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	equipmentsResponse := []dtos.EquipmentResponse{}
+
+	for _, equipment := range equipments {
+		equipmentsResponse = append(equipmentsResponse, dtos.CreateEquipmentResponse(equipment))
+	}
+
+	response := map[string][]dtos.EquipmentResponse{
+		"dados": equipmentsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// FindEquipmentsToReturn godoc
+// @Summary relatorio dos equipamentos a recolher
+// @Description rota para o relatorio dos equipamentos ainda instalados em pontos removidos ou com o contrato cancelado
+// @Tags equipment
+// @Accept json
+// @Produce json
+// @Success 200 {object} []dtos.EquipmentReturnResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /equipamentos/devolucoes [get]
+func (controller *equipmentController) FindEquipmentsToReturn(ctx *gin.Context) {
+	equipments, responseError := controller.equipmentService.FindEquipmentsToReturn(ctx.Request.Context())
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(equipments) == 0 {
+		response := utils.NewResponse(utils.EquipmentNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	equipmentsResponse := []dtos.EquipmentReturnResponse{}
+
+	for _, equipment := range equipments {
+		equipmentsResponse = append(equipmentsResponse, dtos.CreateEquipmentReturnResponse(equipment))
+	}
+
+	response := map[string][]dtos.EquipmentReturnResponse{
+		"dados": equipmentsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// FindEquipmentMovements godoc
+// @Summary historico de movimentações do equipamento
+// @Description rota para a listagem das movimentações do equipamento entre os estados e os pontos
+// @Tags equipment
+// @Accept json
+// @Produce json
+// @Param id path string true "id do equipamento"
+// @Success 200 {object} []dtos.EquipmentMovementResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /equipamento/{id}/movimentacoes [get]
+func (controller *equipmentController) FindEquipmentMovements(ctx *gin.Context) {
+	equipmentID := ctx.Param("id")
+
+	movements, responseError := controller.equipmentService.FindEquipmentMovements(ctx.Request.Context(), equipmentID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	movementsResponse := []dtos.EquipmentMovementResponse{}
+
+	for _, movement := range movements {
+		movementsResponse = append(movementsResponse, dtos.CreateEquipmentMovementResponse(movement))
+	}
+
+	response := map[string][]dtos.EquipmentMovementResponse{
+		"dados": movementsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// MoveEquipment godoc
+// @Summary movimenta o equipamento
+// @Description rota para a instalação do equipamento no ponto, o retorno ao estoque ou o registro de defeito e extravio
+// @Tags equipment
+// @Accept json
+// @Produce json
+// @Param id path string true "id do equipamento"
+// @Param movement body dtos.EquipmentMovementDTO true "Movimentar Equipamento"
+// @Success 200 {object} dtos.EquipmentResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /equipamento/{id}/movimentacao [put]
+func (controller *equipmentController) MoveEquipment(ctx *gin.Context) {
+	movementDTO := dtos.EquipmentMovementDTO{}
+
+	if err := ctx.ShouldBindJSON(&movementDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	movementDTO.ID = ctx.Param("id")
+
+	equipment, responseError := controller.equipmentService.MoveEquipment(ctx.Request.Context(), movementDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateEquipmentResponse(equipment))
+}
+
+// NewEquipmentController cria uma nova isnancia de EquipmentController.
+func NewEquipmentController(equipmentService services.EquipmentService, logger *slog.Logger) EquipmentController {
+	return &equipmentController{
+		equipmentService: equipmentService,
+		logger:           logger,
+	}
+}
@@ -0,0 +1,83 @@
+package controllers
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/import_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ImportController representa o contracto de ImportController.
+type ImportController interface {
+	ImportRecords(ctx *gin.Context)
+}
+
+type importController struct {
+	importService services.ImportService
+	logger        *slog.Logger
+}
+
+// ImportRecords godoc
+// @Summary importa clientes, endereços, pontos e contratos em lote
+// @Description rota para a importação de um arquivo CSV com um ponto por linha, com o relatorio dos erros de cada linha
+// @Tags import
+// @Accept multipart/form-data
+// @Produce json
+// @Param arquivo formData file true "arquivo CSV separado por ponto e virgula ou virgula"
+// @Param mapeamento formData string false "JSON com a coluna do CSV de cada campo, como {\"cliente_nome\": \"NOME\"}"
+// @Param previa formData bool false "apenas valida as linhas, sem gravar os registros"
+// @Success 200 {object} dtos.ImportResult
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /importacoes [post]
+func (controller *importController) ImportRecords(ctx *gin.Context) {
+	options := dtos.ImportOptions{
+		Previa: ctx.PostForm("previa") == "true",
+	}
+
+	if mapping := ctx.PostForm("mapeamento"); mapping != "" {
+		if err := json.Unmarshal([]byte(mapping), &options.Mapeamento); err != nil {
+			response := utils.NewResponse(utils.InvalidImportMapping + ": " + err.Error())
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+	}
+
+	fileHeader, err := ctx.FormFile("arquivo")
+	if err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+	defer file.Close()
+
+	result, responseError := controller.importService.ImportRecords(ctx.Request.Context(), file, options)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// NewImportController cria uma nova isnancia de ImportController.
+func NewImportController(importService services.ImportService, logger *slog.Logger) ImportController {
+	return &importController{
+		importService: importService,
+		logger:        logger,
+	}
+}
@@ -0,0 +1,21 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// logResponseError registra no log os erros internos retornados pelos serviços.
+func logResponseError(ctx *gin.Context, logger *slog.Logger, responseError utils.ResponseError) {
+	if responseError.StatusCode < http.StatusInternalServerError {
+		return
+	}
+
+	logger.ErrorContext(ctx.Request.Context(), "request failed",
+		slog.Int("status", responseError.StatusCode),
+		slog.String("error", responseError.Message),
+	)
+}
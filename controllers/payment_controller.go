@@ -0,0 +1,173 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/payment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentController representa o contracto de PaymentController.
+type PaymentController interface {
+	CreatePayment(ctx *gin.Context)
+	FindPaymentsByInvoiceID(ctx *gin.Context)
+	ImportPayments(ctx *gin.Context)
+	SuspendDelinquentContracts(ctx *gin.Context)
+}
+
+type paymentController struct {
+	paymentService services.PaymentService
+	logger         *slog.Logger
+}
+
+// CreatePayment godoc
+// @Summary registra um pagamento da fatura
+// @Description rota para o registro manual de um pagamento, com o valor em centavos; a fatura quitada reativa os contratos suspensos por inadimplencia
+// @Tags payment
+// @Accept json
+// @Produce json
+// @Param id path string true "id da fatura"
+// @Param payment body dtos.PaymentCreateDTO true "Registrar Pagamento"
+// @Success 201 {object} dtos.PaymentResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /fatura/{id}/pagamentos [post]
+func (controller *paymentController) CreatePayment(ctx *gin.Context) {
+	paymentDTO := dtos.PaymentCreateDTO{}
+
+	if err := ctx.ShouldBindJSON(&paymentDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	paymentDTO.FaturaID = ctx.Param("id")
+
+	payment, responseError := controller.paymentService.CreatePayment(ctx.Request.Context(), paymentDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreatePaymentResponse(payment))
+}
+
+// FindPaymentsByInvoiceID godoc
+// @Summary lista os pagamentos da fatura
+// @Description rota para a listagem dos pagamentos da fatura, do mais antigo ao mais recente
+// @Tags payment
+// @Accept json
+// @Produce json
+// @Param id path string true "id da fatura"
+// @Success 200 {object} []dtos.PaymentResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /fatura/{id}/pagamentos [get]
+func (controller *paymentController) FindPaymentsByInvoiceID(ctx *gin.Context) {
+	invoiceID := ctx.Param("id")
+
+	payments, responseError := controller.paymentService.FindPaymentsByInvoiceID(ctx.Request.Context(), invoiceID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(payments) == 0 {
+		response := utils.NewResponse(utils.PaymentNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	paymentsResponse := []dtos.PaymentResponse{}
+
+	for _, payment := range payments {
+		paymentsResponse = append(paymentsResponse, dtos.CreatePaymentResponse(payment))
+	}
+
+	response := map[string][]dtos.PaymentResponse{
+		"dados": paymentsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ImportPayments godoc
+// @Summary importa o arquivo de retorno do banco
+// @Description rota para a importação dos pagamentos de um arquivo de retorno em largura fixa, ignorando os pagamentos já importados
+// @Tags payment
+// @Accept multipart/form-data
+// @Produce json
+// @Param arquivo formData file true "arquivo de retorno"
+// @Success 200 {object} dtos.PaymentImportResult
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /pagamentos/importacoes [post]
+func (controller *paymentController) ImportPayments(ctx *gin.Context) {
+	fileHeader, err := ctx.FormFile("arquivo")
+	if err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+	defer file.Close()
+
+	result, responseError := controller.paymentService.ImportPayments(ctx.Request.Context(), file)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// SuspendDelinquentContracts godoc
+// @Summary executa a rotina de inadimplencia
+// @Description rota para marcar as faturas vencidas e suspender os contratos dos clientes com faturas em atraso após a carencia
+// @Tags payment
+// @Accept json
+// @Produce json
+// @Success 200 {object} dtos.DelinquencyResult
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /faturamento/inadimplencia [post]
+func (controller *paymentController) SuspendDelinquentContracts(ctx *gin.Context) {
+	result, responseError := controller.paymentService.SuspendDelinquentContracts(ctx.Request.Context(), time.Now())
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, result)
+}
+
+// NewPaymentController cria uma nova isnancia de PaymentController.
+func NewPaymentController(paymentService services.PaymentService, logger *slog.Logger) PaymentController {
+	return &paymentController{
+		paymentService: paymentService,
+		logger:         logger,
+	}
+}
@@ -0,0 +1,199 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/plan_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// PlanController representa o contracto de PlanController.
+type PlanController interface {
+	CreatePlan(ctx *gin.Context)
+	UpdatePlan(ctx *gin.Context)
+	FindPlanByID(ctx *gin.Context)
+	DeletePlan(ctx *gin.Context)
+	FindPlans(ctx *gin.Context)
+}
+
+type planController struct {
+	planService services.PlanService
+	logger      *slog.Logger
+}
+
+// CreatePlan godoc
+// @Summary cria um novo plano
+// @Description rota para o cadastro de planos no catalogo, com a velocidade em Mbps e o preço mensal em centavos
+// @Tags plan
+// @Accept json
+// @Produce json
+// @Param plan body dtos.PlanCreateDTO true "Criar Novo Plano"
+// @Success 201 {object} dtos.PlanResponse
+// @Failure 400 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /planos [post]
+func (controller *planController) CreatePlan(ctx *gin.Context) {
+	planDTO := dtos.PlanCreateDTO{}
+
+	if err := ctx.ShouldBindJSON(&planDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	plan, responseError := controller.planService.CreatePlan(ctx.Request.Context(), planDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreatePlanResponse(plan))
+}
+
+// UpdatePlan godoc
+// @Summary atualiza o plano
+// @Description rota para a atualização do nome, da velocidade, do preço e da fidelidade do plano
+// @Tags plan
+// @Accept json
+// @Produce json
+// @Param id path string true "id do plano"
+// @Param plan body dtos.PlanUpdateDTO true "atualizar plano"
+// @Success 200 {object} dtos.PlanResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /plano/{id} [put]
+func (controller *planController) UpdatePlan(ctx *gin.Context) {
+	planDTO := dtos.PlanUpdateDTO{}
+
+	if err := ctx.ShouldBindJSON(&planDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	planDTO.ID = ctx.Param("id")
+
+	plan, responseError := controller.planService.UpdatePlan(ctx.Request.Context(), planDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreatePlanResponse(plan))
+}
+
+// FindPlanByID godoc
+// @Summary pesquisa o plano
+// @Description rota para a pesquisa do plano pelo id
+// @Tags plan
+// @Accept json
+// @Produce json
+// @Param id path string true "id do plano"
+// @Success 200 {object} dtos.PlanResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /plano/{id} [get]
+func (controller *planController) FindPlanByID(ctx *gin.Context) {
+	planID := ctx.Param("id")
+
+	plan, responseError := controller.planService.FindPlanByID(ctx.Request.Context(), planID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreatePlanResponse(plan))
+}
+
+// DeletePlan godoc
+// @Summary deleta o plano
+// @Description rota para a exclusão do plano pelo id, desde que nenhum contrato ativo o utilize
+// @Tags plan
+// @Accept json
+// @Produce json
+// @Param id path string true "id do plano"
+// @Success 204 {object} entities.Plano
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /plano/{id} [delete]
+func (controller *planController) DeletePlan(ctx *gin.Context) {
+	planID := ctx.Param("id")
+
+	responseError := controller.planService.DeletePlanByID(ctx.Request.Context(), planID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusNoContent, entities.Plano{})
+}
+
+// FindPlans godoc
+// @Summary lista os planos existentes
+// @Description rota para a listagem do catalogo de planos, do mais barato ao mais caro
+// @Tags plan
+// @Accept json
+// @Produce json
+// @Param nome query string false "nome do plano"
+// @Success 200 {object} []dtos.PlanResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /planos [get]
+func (controller *planController) FindPlans(ctx *gin.Context) {
+	name := ctx.Query("nome")
+
+	plans, responseError := controller.planService.FindPlans(ctx.Request.Context(), name)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(plans) == 0 {
+		response := utils.NewResponse(utils.PlanNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	plansResponse := []dtos.PlanResponse{}
+
+	for _, plan := range plans {
+		plansResponse = append(plansResponse, dtos.CreatePlanResponse(plan))
+	}
+
+	response := map[string][]dtos.PlanResponse{
+		"dados": plansResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// NewPlanController cria uma nova isnancia de PlanController.
+func NewPlanController(planService services.PlanService, logger *slog.Logger) PlanController {
+	return &planController{
+		planService: planService,
+		logger:      logger,
+	}
+}
@@ -1,7 +1,9 @@
 package controllers
 
 import (
+	"log/slog"
 	"net/http"
+	"strconv"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
@@ -15,10 +17,13 @@ type PointController interface {
 	CreatePoint(ctx *gin.Context)
 	DeletePoint(ctx *gin.Context)
 	FindPoints(ctx *gin.Context)
+	FindPointContracts(ctx *gin.Context)
+	TransferPoint(ctx *gin.Context)
 }
 
 type pointController struct {
 	pointService services.PointService
+	logger       *slog.Logger
 }
 
 // CreatePoint godoc
@@ -31,6 +36,8 @@ type pointController struct {
 // @Success 201 {object} entities.Ponto
 // @Failure 400 {object} utils.Response
 // @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /pontos [post]
 func (controller *pointController) CreatePoint(ctx *gin.Context) {
 	pointDTO := dtos.PointCreateDTO{}
@@ -41,8 +48,9 @@ func (controller *pointController) CreatePoint(ctx *gin.Context) {
 		return
 	}
 
-	point, responseError := controller.pointService.CreatePoint(pointDTO)
+	point, responseError := controller.pointService.CreatePoint(ctx.Request.Context(), pointDTO)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -61,12 +69,15 @@ func (controller *pointController) CreatePoint(ctx *gin.Context) {
 // @Success 204 "No Content"
 // @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /ponto/{id} [delete]
 func (controller *pointController) DeletePoint(ctx *gin.Context) {
 	pointID := ctx.Param("id")
 
-	responseError := controller.pointService.DeletePointByID(pointID)
+	responseError := controller.pointService.DeletePointByID(ctx.Request.Context(), pointID)
 	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
 		response := utils.NewResponse(responseError.Message)
 		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
 		return
@@ -77,20 +88,39 @@ func (controller *pointController) DeletePoint(ctx *gin.Context) {
 
 // FindPoints godoc
 // @Summary lista os pontos existentes
-// @Description rota para a listagem de todos os pontos existentes no banco de dados
+// @Description rota para a listagem de todos os pontos existentes no banco de dados.
+// @Description Com perto ou poligono, lista os pontos dentro do raio ou do poligono, ordenados pela distancia e com o estado do contrato.
 // @Tags point
 // @Accept json
 // @Produce json
 // @Param cliente_id query string false "id do cliente"
 // @Param endereco_id query string false "id do endereço"
+// @Param perto query string false "coordenada de referencia no formato lat,lng"
+// @Param raio query number false "raio em metros ao redor de perto (padrão 2000)"
+// @Param poligono query string false "area de atendimento no formato lat,lng;lat,lng;lat,lng"
 // @Success 200 {object} []dtos.PointResponse
+// @Success 200 {object} []dtos.PointGeoResponse
+// @Failure 400 {object} utils.Response
 // @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
 // @Router /pontos [get]
 func (controller *pointController) FindPoints(ctx *gin.Context) {
+	if ctx.Query("perto") != "" || ctx.Query("poligono") != "" {
+		controller.findPointsInArea(ctx)
+		return
+	}
+
 	clientID := ctx.Query("cliente_id")
 	addressID := ctx.Query("endereco_id")
 
-	points := controller.pointService.FindPoints(clientID, addressID)
+	points, responseError := controller.pointService.FindPoints(ctx.Request.Context(), clientID, addressID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
 
 	if len(points) == 0 {
 		response := utils.NewResponse(utils.PointNotFound)
@@ -111,10 +141,148 @@ func (controller *pointController) FindPoints(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// TransferPoint godoc
+// @Summary transfere o ponto para outro cliente
+// @Description rota para a transferencia do ponto, e do seu contrato, para o novo cliente, registrando o evento de transferencia no historico do contrato
+// @Tags point
+// @Accept json
+// @Produce json
+// @Param id path string true "id do ponto"
+// @Param point body dtos.PointTransferDTO true "Transferir Ponto"
+// @Success 200 {object} entities.Ponto
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ponto/{id}/transferencia [put]
+func (controller *pointController) TransferPoint(ctx *gin.Context) {
+	pointDTO := dtos.PointTransferDTO{}
+
+	if err := ctx.ShouldBindJSON(&pointDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	pointDTO.ID = ctx.Param("id")
+
+	point, responseError := controller.pointService.TransferPoint(ctx.Request.Context(), pointDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, point)
+}
+
+// FindPointContracts godoc
+// @Summary lista os contratos do ponto
+// @Description rota para a listagem dos contratos do ponto ao longo do tempo, do mais antigo ao atual, incluindo os cancelados
+// @Tags point
+// @Accept json
+// @Produce json
+// @Param id path string true "id do ponto"
+// @Success 200 {object} []dtos.ContractResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ponto/{id}/contratos [get]
+func (controller *pointController) FindPointContracts(ctx *gin.Context) {
+	pointID := ctx.Param("id")
+
+	contracts, responseError := controller.pointService.FindPointContracts(ctx.Request.Context(), pointID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(contracts) == 0 {
+		response := utils.NewResponse(utils.ContractNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	contractsResponse := []dtos.ContractResponse{}
+
+	for _, contract := range contracts {
+		contractsResponse = append(contractsResponse, dtos.CreateContractResponse(contract))
+	}
+
+	response := map[string][]dtos.ContractResponse{
+		"dados": contractsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// findPointsInArea lista os pontos dentro do raio ou do poligono informados na query.
+func (controller *pointController) findPointsInArea(ctx *gin.Context) {
+	filter := dtos.PointGeoFilter{}
+
+	if value := ctx.Query("perto"); value != "" {
+		coordinate, ok := dtos.ParseCoordinate(value)
+		if !ok {
+			response := utils.NewResponse(utils.InvalidLocation)
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+
+		filter.Perto = &coordinate
+	}
+
+	if value := ctx.Query("raio"); value != "" {
+		radius, err := strconv.ParseFloat(value, 64)
+		if err != nil || radius <= 0 {
+			response := utils.NewResponse(utils.InvalidRadius)
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+
+		filter.Raio = radius
+	}
+
+	if value := ctx.Query("poligono"); value != "" {
+		polygon, ok := dtos.ParsePolygon(value)
+		if !ok {
+			response := utils.NewResponse(utils.InvalidPolygon)
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+
+		filter.Poligono = polygon
+	}
+
+	points, responseError := controller.pointService.FindPointsInArea(ctx.Request.Context(), filter)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(points) == 0 {
+		response := utils.NewResponse(utils.PointNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	response := map[string][]dtos.PointGeoResponse{
+		"dados": points,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
 // NewPointController cria uma nova isnancia de PointController.
-func NewPointController(pointService services.PointService) PointController {
+func NewPointController(pointService services.PointService, logger *slog.Logger) PointController {
 
 	return &pointController{
 		pointService: pointService,
+		logger:       logger,
 	}
 }
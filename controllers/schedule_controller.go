@@ -0,0 +1,216 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/schedule_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ScheduleController representa o contracto de ScheduleController.
+type ScheduleController interface {
+	CreateTechnician(ctx *gin.Context)
+	FindTechnicians(ctx *gin.Context)
+	FindAgenda(ctx *gin.Context)
+	SuggestSlots(ctx *gin.Context)
+}
+
+type scheduleController struct {
+	scheduleService services.ScheduleService
+	logger          *slog.Logger
+}
+
+// CreateTechnician godoc
+// @Summary cadastra um novo tecnico
+// @Description rota para o cadastro dos tecnicos com os bairros atendidos e o expediente
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param technician body dtos.TechnicianCreateDTO true "Cadastrar Tecnico"
+// @Success 201 {object} dtos.TechnicianResponse
+// @Failure 400 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /tecnicos [post]
+func (controller *scheduleController) CreateTechnician(ctx *gin.Context) {
+	technicianDTO := dtos.TechnicianCreateDTO{}
+
+	if err := ctx.ShouldBindJSON(&technicianDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	technician, responseError := controller.scheduleService.CreateTechnician(ctx.Request.Context(), technicianDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreateTechnicianResponse(technician))
+}
+
+// FindTechnicians godoc
+// @Summary lista os tecnicos
+// @Description rota para a listagem dos tecnicos, ou apenas dos que atendem o bairro informado
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param bairro query string false "bairro atendido"
+// @Success 200 {object} []dtos.TechnicianResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /tecnicos [get]
+func (controller *scheduleController) FindTechnicians(ctx *gin.Context) {
+	neighborhood := ctx.Query("bairro")
+
+	technicians, responseError := controller.scheduleService.FindTechnicians(ctx.Request.Context(), neighborhood)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(technicians) == 0 {
+		response := utils.NewResponse(utils.TechnicianNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	techniciansResponse := []dtos.TechnicianResponse{}
+
+	for _, technician := range technicians {
+		techniciansResponse = append(techniciansResponse, dtos.CreateTechnicianResponse(technician))
+	}
+
+	response := map[string][]dtos.TechnicianResponse{
+		"dados": techniciansResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// FindAgenda godoc
+// @Summary agenda dos tecnicos
+// @Description rota para a agenda do dia do tecnico, ou de todos os tecnicos, com os horarios livres e ocupados e a carga do dia
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param tecnico query string false "nome do tecnico"
+// @Param data query string false "data da agenda como YYYY-MM-DD, padrão hoje"
+// @Success 200 {object} []dtos.AgendaResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /agenda [get]
+func (controller *scheduleController) FindAgenda(ctx *gin.Context) {
+	date, ok := parseScheduleDate(ctx)
+	if !ok {
+		return
+	}
+
+	agendas, responseError := controller.scheduleService.FindAgenda(ctx.Request.Context(), ctx.Query("tecnico"), date)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(agendas) == 0 {
+		response := utils.NewResponse(utils.TechnicianNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	response := map[string][]dtos.AgendaResponse{
+		"dados": agendas,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// SuggestSlots godoc
+// @Summary sugere horarios para o ponto
+// @Description rota para a sugestão dos primeiros horarios livres dos tecnicos que atendem o bairro do endereço do ponto
+// @Tags schedule
+// @Accept json
+// @Produce json
+// @Param ponto_id query string true "id do ponto"
+// @Param data query string false "data inicial da pesquisa como YYYY-MM-DD, padrão hoje"
+// @Param limite query int false "quantidade de horarios sugeridos, padrão 5"
+// @Success 200 {object} []dtos.SlotSuggestionResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /agenda/sugestoes [get]
+func (controller *scheduleController) SuggestSlots(ctx *gin.Context) {
+	date, ok := parseScheduleDate(ctx)
+	if !ok {
+		return
+	}
+
+	limit := services.SuggestionLimit
+
+	if value := ctx.Query("limite"); value != "" {
+		suggestionLimit, err := strconv.Atoi(value)
+		if err != nil || suggestionLimit < 1 {
+			response := utils.NewResponse(utils.InvalidSuggestionLimit)
+			ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+			return
+		}
+
+		limit = suggestionLimit
+	}
+
+	suggestions, responseError := controller.scheduleService.SuggestSlots(ctx.Request.Context(), ctx.Query("ponto_id"), date, limit)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	response := map[string][]dtos.SlotSuggestionResponse{
+		"dados": suggestions,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// parseScheduleDate lê a data da agenda, usando o dia atual quando ela não é informada.
+func parseScheduleDate(ctx *gin.Context) (time.Time, bool) {
+	value := ctx.Query("data")
+	if value == "" {
+		return time.Now(), true
+	}
+
+	date, err := time.ParseInLocation(dtos.DateLayout, value, time.Local)
+	if err != nil {
+		response := utils.NewResponse(utils.InvalidDate)
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return time.Time{}, false
+	}
+
+	return date, true
+}
+
+// NewScheduleController cria uma nova isnancia de ScheduleController.
+func NewScheduleController(scheduleService services.ScheduleService, logger *slog.Logger) ScheduleController {
+	return &scheduleController{
+		scheduleService: scheduleService,
+		logger:          logger,
+	}
+}
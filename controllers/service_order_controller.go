@@ -0,0 +1,257 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/service_order_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// ServiceOrderController representa o contracto de ServiceOrderController.
+type ServiceOrderController interface {
+	CreateServiceOrder(ctx *gin.Context)
+	FindServiceOrderByID(ctx *gin.Context)
+	FindServiceOrders(ctx *gin.Context)
+	ScheduleServiceOrder(ctx *gin.Context)
+	UpdateServiceOrderStatus(ctx *gin.Context)
+	CompleteServiceOrder(ctx *gin.Context)
+}
+
+type serviceOrderController struct {
+	serviceOrderService services.ServiceOrderService
+	logger              *slog.Logger
+}
+
+// CreateServiceOrder godoc
+// @Summary abre uma nova ordem de serviço
+// @Description rota para a abertura de ordens de serviço de instalação, reparo ou retirada de equipamento no ponto
+// @Tags serviceOrder
+// @Accept json
+// @Produce json
+// @Param serviceOrder body dtos.ServiceOrderCreateDTO true "Abrir Ordem de Serviço"
+// @Success 201 {object} dtos.ServiceOrderResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ordens [post]
+func (controller *serviceOrderController) CreateServiceOrder(ctx *gin.Context) {
+	serviceOrderDTO := dtos.ServiceOrderCreateDTO{}
+
+	if err := ctx.ShouldBindJSON(&serviceOrderDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	serviceOrder, responseError := controller.serviceOrderService.CreateServiceOrder(ctx.Request.Context(), serviceOrderDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreateServiceOrderResponse(serviceOrder))
+}
+
+// FindServiceOrderByID godoc
+// @Summary pesquisa a ordem de serviço
+// @Description rota para a pesquisa da ordem de serviço pelo id
+// @Tags serviceOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id da ordem de serviço"
+// @Success 200 {object} dtos.ServiceOrderResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ordem/{id} [get]
+func (controller *serviceOrderController) FindServiceOrderByID(ctx *gin.Context) {
+	serviceOrderID := ctx.Param("id")
+
+	serviceOrder, responseError := controller.serviceOrderService.FindServiceOrderByID(ctx.Request.Context(), serviceOrderID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateServiceOrderResponse(serviceOrder))
+}
+
+// FindServiceOrders godoc
+// @Summary lista as ordens de serviço
+// @Description rota para a listagem das ordens de serviço, ordenadas pela janela agendada
+// @Tags serviceOrder
+// @Accept json
+// @Produce json
+// @Param ponto_id query string false "id do ponto"
+// @Param contrato_id query string false "id do contrato"
+// @Param tecnico query string false "tecnico responsavel"
+// @Param tipo query string false "instalacao, reparo ou retirada"
+// @Param estado query string false "aberta, agendada, em_execucao, concluida ou cancelada"
+// @Success 200 {object} []dtos.ServiceOrderResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ordens [get]
+func (controller *serviceOrderController) FindServiceOrders(ctx *gin.Context) {
+	filter := dtos.ServiceOrderFilter{
+		PontoID:    ctx.Query("ponto_id"),
+		ContratoID: ctx.Query("contrato_id"),
+		Tecnico:    ctx.Query("tecnico"),
+		Tipo:       entities.ServiceOrderType(ctx.Query("tipo")),
+		Estado:     entities.ServiceOrderStatus(ctx.Query("estado")),
+	}
+
+	serviceOrders, responseError := controller.serviceOrderService.FindServiceOrders(ctx.Request.Context(), filter)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(serviceOrders) == 0 {
+		response := utils.NewResponse(utils.ServiceOrderNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	serviceOrdersResponse := []dtos.ServiceOrderResponse{}
+
+	for _, serviceOrder := range serviceOrders {
+		serviceOrdersResponse = append(serviceOrdersResponse, dtos.CreateServiceOrderResponse(serviceOrder))
+	}
+
+	response := map[string][]dtos.ServiceOrderResponse{
+		"dados": serviceOrdersResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// ScheduleServiceOrder godoc
+// @Summary agenda a ordem de serviço
+// @Description rota para o agendamento, ou reagendamento, da ordem de serviço na janela informada com o tecnico responsavel
+// @Tags serviceOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id da ordem de serviço"
+// @Param schedule body dtos.ServiceOrderScheduleDTO true "Agendar Ordem de Serviço"
+// @Success 200 {object} dtos.ServiceOrderResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ordem/{id}/agendamento [put]
+func (controller *serviceOrderController) ScheduleServiceOrder(ctx *gin.Context) {
+	scheduleDTO := dtos.ServiceOrderScheduleDTO{}
+
+	if err := ctx.ShouldBindJSON(&scheduleDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	scheduleDTO.ID = ctx.Param("id")
+
+	serviceOrder, responseError := controller.serviceOrderService.ScheduleServiceOrder(ctx.Request.Context(), scheduleDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateServiceOrderResponse(serviceOrder))
+}
+
+// UpdateServiceOrderStatus godoc
+// @Summary altera o estado da ordem de serviço
+// @Description rota para iniciar a execução da ordem agendada ou cancelar a ordem ainda não concluida
+// @Tags serviceOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id da ordem de serviço"
+// @Param status body dtos.ServiceOrderStatusDTO true "Alterar Estado da Ordem de Serviço"
+// @Success 200 {object} dtos.ServiceOrderResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ordem/{id}/estado [put]
+func (controller *serviceOrderController) UpdateServiceOrderStatus(ctx *gin.Context) {
+	statusDTO := dtos.ServiceOrderStatusDTO{}
+
+	if err := ctx.ShouldBindJSON(&statusDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	statusDTO.ID = ctx.Param("id")
+
+	serviceOrder, responseError := controller.serviceOrderService.UpdateServiceOrderStatus(ctx.Request.Context(), statusDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateServiceOrderResponse(serviceOrder))
+}
+
+// CompleteServiceOrder godoc
+// @Summary conclui a ordem de serviço
+// @Description rota para a conclusão da ordem de serviço com o relatorio do tecnico; a conclusão da instalação coloca o contrato em vigor
+// @Tags serviceOrder
+// @Accept json
+// @Produce json
+// @Param id path string true "id da ordem de serviço"
+// @Param report body dtos.ServiceOrderCompleteDTO true "Concluir Ordem de Serviço"
+// @Success 200 {object} dtos.ServiceOrderResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /ordem/{id}/conclusao [post]
+func (controller *serviceOrderController) CompleteServiceOrder(ctx *gin.Context) {
+	completeDTO := dtos.ServiceOrderCompleteDTO{}
+
+	if err := ctx.ShouldBindJSON(&completeDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	completeDTO.ID = ctx.Param("id")
+
+	serviceOrder, responseError := controller.serviceOrderService.CompleteServiceOrder(ctx.Request.Context(), completeDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateServiceOrderResponse(serviceOrder))
+}
+
+// NewServiceOrderController cria uma nova isnancia de ServiceOrderController.
+func NewServiceOrderController(serviceOrderService services.ServiceOrderService, logger *slog.Logger) ServiceOrderController {
+	return &serviceOrderController{
+		serviceOrderService: serviceOrderService,
+		logger:              logger,
+	}
+}
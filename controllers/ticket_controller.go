@@ -0,0 +1,219 @@
+package controllers
+
+import (
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/ticket_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// TicketController representa o contracto de TicketController.
+type TicketController interface {
+	CreateTicket(ctx *gin.Context)
+	FindTicketByID(ctx *gin.Context)
+	FindTickets(ctx *gin.Context)
+	UpdateTicketStatus(ctx *gin.Context)
+	AddTicketComment(ctx *gin.Context)
+}
+
+type ticketController struct {
+	ticketService services.TicketService
+	logger        *slog.Logger
+}
+
+// CreateTicket godoc
+// @Summary abre um novo chamado
+// @Description rota para a abertura do chamado do cliente, com o prazo do SLA definido pelo tipo do cliente e pela prioridade
+// @Tags ticket
+// @Accept json
+// @Produce json
+// @Param ticket body dtos.TicketCreateDTO true "Abrir Chamado"
+// @Success 201 {object} dtos.TicketResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /chamados [post]
+func (controller *ticketController) CreateTicket(ctx *gin.Context) {
+	ticketDTO := dtos.TicketCreateDTO{}
+
+	if err := ctx.ShouldBindJSON(&ticketDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	ticket, responseError := controller.ticketService.CreateTicket(ctx.Request.Context(), ticketDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreateTicketResponse(ticket))
+}
+
+// FindTicketByID godoc
+// @Summary pesquisa o chamado
+// @Description rota para a pesquisa do chamado pelo id, com o historico de comentarios
+// @Tags ticket
+// @Accept json
+// @Produce json
+// @Param id path string true "id do chamado"
+// @Success 200 {object} dtos.TicketResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /chamado/{id} [get]
+func (controller *ticketController) FindTicketByID(ctx *gin.Context) {
+	ticketID := ctx.Param("id")
+
+	ticket, responseError := controller.ticketService.FindTicketByID(ctx.Request.Context(), ticketID)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateTicketResponse(ticket))
+}
+
+// FindTickets godoc
+// @Summary lista os chamados
+// @Description rota para a listagem dos chamados, ordenados pelo prazo do SLA
+// @Tags ticket
+// @Accept json
+// @Produce json
+// @Param cliente_id query string false "id do cliente"
+// @Param estado query string false "aberto, em_atendimento, aguardando_cliente, resolvido ou fechado"
+// @Param prioridade query string false "baixa, media, alta ou critica"
+// @Param categoria query string false "reclamacao, solicitacao, suporte_tecnico ou financeiro"
+// @Param atrasados query bool false "apenas os chamados não resolvidos com o prazo do SLA vencido"
+// @Success 200 {object} []dtos.TicketResponse
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /chamados [get]
+func (controller *ticketController) FindTickets(ctx *gin.Context) {
+	filter := dtos.TicketFilter{
+		ClienteID:  ctx.Query("cliente_id"),
+		Estado:     entities.TicketStatus(ctx.Query("estado")),
+		Prioridade: entities.TicketPriority(ctx.Query("prioridade")),
+		Categoria:  entities.TicketCategory(ctx.Query("categoria")),
+		Atrasados:  ctx.Query("atrasados") == "true",
+	}
+
+	tickets, responseError := controller.ticketService.FindTickets(ctx.Request.Context(), filter)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	if len(tickets) == 0 {
+		response := utils.NewResponse(utils.TicketNotFound)
+		ctx.AbortWithStatusJSON(http.StatusNotFound, response)
+		return
+	}
+
+	ticketsResponse := []dtos.TicketResponse{}
+
+	for _, ticket := range tickets {
+		ticketsResponse = append(ticketsResponse, dtos.CreateTicketResponse(ticket))
+	}
+
+	response := map[string][]dtos.TicketResponse{
+		"dados": ticketsResponse,
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// UpdateTicketStatus godoc
+// @Summary altera o estado do chamado
+// @Description rota para o atendimento, a resolução, a reabertura e o fechamento do chamado
+// @Tags ticket
+// @Accept json
+// @Produce json
+// @Param id path string true "id do chamado"
+// @Param status body dtos.TicketStatusDTO true "Alterar Estado do Chamado"
+// @Success 200 {object} dtos.TicketResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /chamado/{id}/estado [put]
+func (controller *ticketController) UpdateTicketStatus(ctx *gin.Context) {
+	statusDTO := dtos.TicketStatusDTO{}
+
+	if err := ctx.ShouldBindJSON(&statusDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	statusDTO.ID = ctx.Param("id")
+
+	ticket, responseError := controller.ticketService.UpdateTicketStatus(ctx.Request.Context(), statusDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusOK, dtos.CreateTicketResponse(ticket))
+}
+
+// AddTicketComment godoc
+// @Summary comenta o chamado
+// @Description rota para adicionar um comentario ao historico do chamado
+// @Tags ticket
+// @Accept json
+// @Produce json
+// @Param id path string true "id do chamado"
+// @Param comment body dtos.TicketCommentDTO true "Comentar Chamado"
+// @Success 201 {object} dtos.TicketResponse
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Failure 504 {object} utils.Response
+// @Router /chamado/{id}/comentarios [post]
+func (controller *ticketController) AddTicketComment(ctx *gin.Context) {
+	commentDTO := dtos.TicketCommentDTO{}
+
+	if err := ctx.ShouldBindJSON(&commentDTO); err != nil {
+		response := utils.NewResponse(err.Error())
+		ctx.AbortWithStatusJSON(http.StatusBadRequest, response)
+		return
+	}
+
+	commentDTO.ID = ctx.Param("id")
+
+	ticket, responseError := controller.ticketService.AddTicketComment(ctx.Request.Context(), commentDTO)
+	if responseError != (utils.ResponseError{}) {
+		logResponseError(ctx, controller.logger, responseError)
+		response := utils.NewResponse(responseError.Message)
+		ctx.AbortWithStatusJSON(responseError.StatusCode, response)
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, dtos.CreateTicketResponse(ticket))
+}
+
+// NewTicketController cria uma nova isnancia de TicketController.
+func NewTicketController(ticketService services.TicketService, logger *slog.Logger) TicketController {
+	return &ticketController{
+		ticketService: ticketService,
+		logger:        logger,
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"gorm.io/driver/postgres"
 	"gorm.io/gorm"
 	"gorm.io/gorm/schema"
+	"gorm.io/plugin/opentelemetry/tracing"
 )
 
 var db *gorm.DB
@@ -42,6 +43,7 @@ func ConnectDB() {
 			SingularTable: true,
 			NameReplacer:  strings.NewReplacer("CID", "Cid"),
 		},
+		TranslateError: true,
 	})
 	if err != nil {
 		log.Fatalf("error to connect to database: %v", err)
@@ -49,6 +51,11 @@ func ConnectDB() {
 
 	db = database
 
+	err = db.Use(tracing.NewPlugin(tracing.WithoutMetrics()))
+	if err != nil {
+		log.Fatalf("error to register database tracing: %v", err)
+	}
+
 	migrations.RunMigrations(db)
 
 	config, err := db.DB()
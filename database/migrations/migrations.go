@@ -1,7 +1,12 @@
 package migrations
 
 import (
+	"encoding/json"
+	"log"
+	"time"
+
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
 	"gorm.io/gorm"
 )
 
@@ -13,5 +18,80 @@ func RunMigrations(db *gorm.DB) {
 		entities.Ponto{},
 		entities.Contrato{},
 		entities.ContratoEvento{},
+		entities.Contato{},
+		entities.Cep{},
+		entities.ClienteMesclagem{},
+		entities.Plano{},
+		entities.Fatura{},
+		entities.Pagamento{},
+		entities.ContratoVersao{},
+		entities.OrdemServico{},
+		entities.Equipamento{},
+		entities.EquipamentoMovimentacao{},
+		entities.Tecnico{},
+		entities.TecnicoBairro{},
+		entities.Chamado{},
+		entities.ChamadoComentario{},
 	)
+
+	// O ponto pode ter uma sequencia de contratos, mas apenas um contrato não cancelado por vez. O estado é escrito
+	// na instrução, pois o DDL não aceita parametros.
+	err := db.Exec("CREATE UNIQUE INDEX IF NOT EXISTS idx_contrato_ponto_atual ON t_contrato (ponto_id) " +
+		"WHERE estado <> '" + string(entities.CANCELADO) + "' AND data_remocao IS NULL").Error
+	if err != nil {
+		log.Printf("failed to create index idx_contrato_ponto_atual: %v", err)
+	}
+
+	// A unicidade do cliente passou a ser pelo documento, e não mais pelo nome.
+	if db.Migrator().HasConstraint(&entities.Cliente{}, "t_cliente_nome_key") {
+		db.Migrator().DropConstraint(&entities.Cliente{}, "t_cliente_nome_key")
+	}
+
+	// O identificador do pagamento passou a ser unico, substituindo o indice anterior.
+	if db.Migrator().HasIndex(&entities.Pagamento{}, "idx_t_pagamento_identificador") {
+		db.Migrator().DropIndex(&entities.Pagamento{}, "idx_t_pagamento_identificador")
+	}
+
+	// Os endereços cadastrados antes da normalização recebem a sua chave normalizada.
+	addresses := []entities.Endereco{}
+	db.Unscoped().Find(&addresses, "chave_normalizada = ''")
+
+	for _, address := range addresses {
+		db.Unscoped().Model(&address).UpdateColumn("chave_normalizada", dtos.AddressKey(address))
+	}
+
+	// Os contratos apontam para a sua ultima versão, e os contratos cadastrados antes do versionamento recebem a
+	// primeira versão com os termos do seu cadastro.
+	db.Exec("UPDATE t_contrato SET versao_id = v.id::text FROM (SELECT DISTINCT ON (contrato_id) id, contrato_id " +
+		"FROM t_contrato_versao ORDER BY contrato_id, numero DESC) v WHERE v.contrato_id = t_contrato.id AND t_contrato.versao_id = ''")
+
+	contracts := []entities.Contrato{}
+	db.Unscoped().Preload("Plano", func(db *gorm.DB) *gorm.DB { return db.Unscoped() }).Find(&contracts, "versao_id = ''")
+
+	for _, contract := range contracts {
+		start := contract.DataCriacao
+
+		switch {
+		case contract.DataAtivacao != nil:
+			start = *contract.DataAtivacao
+		case contract.DataAssinatura != nil:
+			start = *contract.DataAssinatura
+		}
+
+		terms, _ := json.Marshal(dtos.CreateContractTerms(contract, contract.Plano))
+
+		contractVersion := entities.ContratoVersao{
+			ContratoID:  contract.ID,
+			Numero:      1,
+			Motivo:      entities.CADASTRO,
+			DataEfetiva: start,
+			Termos:      string(terms),
+		}
+		contractVersion.DataCriacao = time.Now()
+		contractVersion.DataAtualizacao = time.Now()
+
+		if db.Create(&contractVersion).Error == nil {
+			db.Unscoped().Model(&entities.Contrato{}).Where("id = ?", contract.ID).UpdateColumn("versao_id", contractVersion.ID)
+		}
+	}
 }
@@ -5,8 +5,36 @@ import "gorm.io/gorm"
 // Endereco representa a tabela t_endereco no banco de dados.
 type Endereco struct {
 	Base
+	Cep         string         `json:"cep" gorm:"type:varchar(8);not null;default:''"`
+	Cidade      string         `json:"cidade" gorm:"type:text;size:128;not null;default:''"`
+	Uf          string         `json:"uf" gorm:"type:char(2);not null;default:''"`
 	Logradouro  string         `json:"logradouro" gorm:"type:text;size:128;not null"`
 	Bairro      string         `json:"bairro" gorm:"type:text;size:128;not null"`
-	Numero      int            `json:"numero" gorm:"type:smallint"`
+	Numero      int            `json:"numero" gorm:"type:integer"`
+	Complemento string         `json:"complemento" gorm:"type:text;size:128;not null;default:''"`
+	Latitude    *float64       `json:"latitude" gorm:"type:double precision"`
+	Longitude   *float64       `json:"longitude" gorm:"type:double precision"`
 	DataRemocao gorm.DeletedAt `json:"-" gorm:"index"`
+
+	// ChaveNormalizada guarda os campos do endereço normalizados, identificando endereços iguais escritos de formas diferentes.
+	ChaveNormalizada string `json:"-" gorm:"type:text;not null;default:'';index"`
+
+	// Divergencias não é persistido, apenas informa os campos que diferem do diretorio de CEPs no cadastro.
+	Divergencias []DivergenciaCep `json:"divergencias,omitempty" gorm:"-"`
+
+	// Semelhantes não é persistido, apenas informa os endereços parecidos já cadastrados no cadastro.
+	Semelhantes []EnderecoSemelhante `json:"semelhantes,omitempty" gorm:"-"`
+}
+
+// DivergenciaCep representa um campo do endereço informado com um valor diferente do diretorio de CEPs.
+type DivergenciaCep struct {
+	Campo     string `json:"campo"`
+	Informado string `json:"informado"`
+	Diretorio string `json:"diretorio"`
+}
+
+// EnderecoSemelhante representa um endereço já cadastrado parecido com o endereço informado.
+type EnderecoSemelhante struct {
+	EnderecoID   string  `json:"endereco_id"`
+	Similaridade float64 `json:"similaridade"`
 }
@@ -0,0 +1,11 @@
+package entities
+
+// Cep representa a tabela t_cep no banco de dados, com o diretorio de CEPs importado de um arquivo CSV.
+type Cep struct {
+	Base
+	Codigo     string `json:"cep" gorm:"type:varchar(8);not null;uniqueIndex"`
+	Logradouro string `json:"logradouro" gorm:"type:text;size:128;not null"`
+	Bairro     string `json:"bairro" gorm:"type:text;size:128;not null"`
+	Cidade     string `json:"cidade" gorm:"type:text;size:128;not null"`
+	Uf         string `json:"uf" gorm:"type:char(2);not null"`
+}
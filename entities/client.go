@@ -15,7 +15,10 @@ const (
 // Cliente representa a tabela t_cliente no banco de dados.
 type Cliente struct {
 	Base
-	Nome        string         `json:"nome" gorm:"type:text;size:128;not null;unique"`
-	Tipo        ClientType     `json:"tipo" gorm:"not null"`
-	DataRemocao gorm.DeletedAt `json:"-" gorm:"index"`
+	Nome           string         `json:"nome" gorm:"type:text;size:128;not null"`
+	Tipo           ClientType     `json:"tipo" gorm:"not null"`
+	Documento      string         `json:"documento" gorm:"type:varchar(14);not null;default:'';uniqueIndex:idx_cliente_documento,where:documento <> ''"`
+	CanalPreferido ContactType    `json:"canal_preferido" gorm:"type:text;not null;default:''"`
+	MatrizID       string         `json:"matriz_id" gorm:"type:text;not null;default:'';index"`
+	DataRemocao    gorm.DeletedAt `json:"-" gorm:"index"`
 }
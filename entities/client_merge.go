@@ -0,0 +1,10 @@
+package entities
+
+// ClienteMesclagem representa a tabela t_cliente_mesclagem no banco de dados, registrando a auditoria das mesclagens de clientes.
+type ClienteMesclagem struct {
+	Base
+	SobreviventeID string  `json:"sobrevivente_id" gorm:"type:uuid;not null;index"`
+	DuplicadosIDs  string  `json:"duplicados_ids" gorm:"type:text;not null"`
+	Detalhes       string  `json:"detalhes" gorm:"type:text;not null"`
+	Sobrevivente   Cliente `json:"-" gorm:"foreignKey:SobreviventeID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
@@ -0,0 +1,25 @@
+package entities
+
+import "gorm.io/gorm"
+
+// ContactType representa o type ContactType.
+type ContactType string
+
+// Constantes que representam os tipos de contatos validos.
+const (
+	EMAIL    ContactType = "email"
+	TELEFONE ContactType = "telefone"
+	WHATSAPP ContactType = "whatsapp"
+)
+
+// Contato representa a tabela t_contato no banco de dados.
+type Contato struct {
+	Base
+	ClienteID   string         `json:"cliente_id" gorm:"type:uuid;not null;index"`
+	Tipo        ContactType    `json:"tipo" gorm:"not null"`
+	Valor       string         `json:"valor" gorm:"type:text;size:254;not null"`
+	Principal   bool           `json:"principal" gorm:"not null;default:false"`
+	Verificado  bool           `json:"verificado" gorm:"not null;default:false"`
+	Cliente     Cliente        `json:"-" gorm:"foreignKey:ClienteID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	DataRemocao gorm.DeletedAt `json:"-" gorm:"index"`
+}
@@ -1,6 +1,10 @@
 package entities
 
-import "gorm.io/gorm"
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
 
 // ContractState representa o type ContractState.
 type ContractState string
@@ -10,13 +14,24 @@ const (
 	VIGOR      ContractState = "Em vigor"
 	DESATIVADO ContractState = "Desativado Temporario"
 	CANCELADO  ContractState = "Cancelado"
+	PENDENTE   ContractState = "Pendente Instalacao"
 )
 
 // Contrato representa a tabela t_contrato no banco de dados.
 type Contrato struct {
 	Base
-	Estado      ContractState  `json:"-" gorm:"not null"`
-	PontoID     string         `json:"ponto_id" gorm:"type:uuid;not null"`
-	Ponto       Ponto          `json:"-" gorm:"foreignKey:PontoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
-	DataRemocao gorm.DeletedAt `json:"-" gorm:"index"`
+	Estado               ContractState   `json:"-" gorm:"not null"`
+	PontoID              string          `json:"ponto_id" gorm:"type:uuid;not null"`
+	ContatoResponsavelID string          `json:"contato_responsavel_id" gorm:"type:text;not null;default:''"`
+	PlanoID              string          `json:"plano_id" gorm:"type:text;not null;default:'';index"`
+	DataAssinatura       *time.Time      `json:"data_assinatura" gorm:"type:date"`
+	DataAtivacao         *time.Time      `json:"data_ativacao" gorm:"type:date"`
+	DataFimFidelidade    *time.Time      `json:"data_fim_fidelidade" gorm:"type:date"`
+	DataTermino          *time.Time      `json:"data_termino" gorm:"type:date;index"`
+	MultaRescisao        int64           `json:"multa_rescisao" gorm:"not null;default:0"`
+	VersaoID             string          `json:"versao_id" gorm:"type:text;not null;default:''"`
+	Ponto                Ponto           `json:"-" gorm:"foreignKey:PontoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Plano                Plano           `json:"-" gorm:"foreignKey:PlanoID;-:migration"`
+	Versao               *ContratoVersao `json:"-" gorm:"foreignKey:VersaoID;-:migration"`
+	DataRemocao          gorm.DeletedAt  `json:"-" gorm:"index"`
 }
@@ -1,10 +1,35 @@
 package entities
 
+import "time"
+
+// ContractEventType representa o type ContractEventType.
+type ContractEventType string
+
+// Constantes que representam os tipos de eventos do contrato.
+const (
+	ESTADO        ContractEventType = "estado"
+	TRANSFERENCIA ContractEventType = "transferencia"
+	PLANO         ContractEventType = "plano"
+)
+
+// Constantes que representam os motivos das alterações de estado feitas pelas rotinas da API.
+const (
+	INADIMPLENCIA = "inadimplencia"
+	PAGAMENTO     = "pagamento"
+)
+
 // ContratoEvento representa a tabela t_contrato_evento no banco de dados.
 type ContratoEvento struct {
 	Base
-	EstadoAnterior  ContractState `json:"estado_anterior" gorm:"not null"`
-	EstadoPosterior ContractState `json:"estado_posterior" gorm:"not null"`
-	ContratoID      string        `json:"contrato_id" gorm:"type:uuid;not null"`
-	Contrato        Contrato      `json:"-" gorm:"foreignKey:ContratoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Tipo               ContractEventType `json:"tipo" gorm:"type:text;not null;default:'estado'"`
+	EstadoAnterior     ContractState     `json:"estado_anterior" gorm:"not null"`
+	EstadoPosterior    ContractState     `json:"estado_posterior" gorm:"not null"`
+	ClienteAnteriorID  string            `json:"cliente_anterior_id" gorm:"type:text;not null;default:''"`
+	ClientePosteriorID string            `json:"cliente_posterior_id" gorm:"type:text;not null;default:''"`
+	PlanoAnteriorID    string            `json:"plano_anterior_id" gorm:"type:text;not null;default:''"`
+	PlanoPosteriorID   string            `json:"plano_posterior_id" gorm:"type:text;not null;default:''"`
+	DataEfetiva        *time.Time        `json:"data_efetiva"`
+	Motivo             string            `json:"motivo" gorm:"type:text;not null;default:''"`
+	ContratoID         string            `json:"contrato_id" gorm:"type:uuid;not null"`
+	Contrato           Contrato          `json:"-" gorm:"foreignKey:ContratoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
 }
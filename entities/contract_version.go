@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+// ContractVersionReason representa o type ContractVersionReason.
+type ContractVersionReason string
+
+// Constantes que representam os motivos da criação de uma versão do contrato.
+const (
+	CADASTRO    ContractVersionReason = "cadastro"
+	ATIVACAO    ContractVersionReason = "ativacao"
+	RENOVACAO   ContractVersionReason = "renovacao"
+	TROCA_PLANO ContractVersionReason = "troca_plano"
+)
+
+// ContratoVersao representa a tabela t_contrato_versao no banco de dados, com os termos do contrato em vigor
+// a partir da data efetiva de cada versão (aditivo).
+type ContratoVersao struct {
+	Base
+	ContratoID       string                `json:"contrato_id" gorm:"type:uuid;not null;uniqueIndex:idx_contrato_versao_numero"`
+	Numero           int                   `json:"numero" gorm:"not null;uniqueIndex:idx_contrato_versao_numero"`
+	VersaoAnteriorID string                `json:"versao_anterior_id" gorm:"type:text;not null;default:''"`
+	Motivo           ContractVersionReason `json:"motivo" gorm:"type:text;not null"`
+	DataEfetiva      time.Time             `json:"data_efetiva" gorm:"not null"`
+	Termos           string                `json:"termos" gorm:"type:text;not null"`
+	Contrato         Contrato              `json:"-" gorm:"foreignKey:ContratoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
@@ -1,16 +1,115 @@
 package dtos
 
+import (
+	"strings"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
 // AddressCreateDTO representa o modelo usado para cadastrar endereços.
+// Cidade, uf, logradouro e bairro podem ser omitidos quando o CEP existe no diretorio de CEPs.
 type AddressCreateDTO struct {
-	Logradouro string `json:"logradouro" form:"logradouro" binding:"required,min=3,max=128"`
-	Bairro     string `json:"bairro" form:"bairro" binding:"required,min=3,max=128"`
-	Numero     int    `json:"numero" gorm:"type:smallint" binding:"required"`
+	Cep         string   `json:"cep" form:"cep" binding:"required"`
+	Cidade      string   `json:"cidade" form:"cidade" binding:"omitempty,min=3,max=128"`
+	Uf          string   `json:"uf" form:"uf" binding:"omitempty,len=2"`
+	Logradouro  string   `json:"logradouro" form:"logradouro" binding:"omitempty,min=3,max=128"`
+	Bairro      string   `json:"bairro" form:"bairro" binding:"omitempty,min=3,max=128"`
+	Numero      int      `json:"numero" binding:"required"`
+	Complemento string   `json:"complemento" form:"complemento" binding:"max=128"`
+	Latitude    *float64 `json:"latitude" form:"latitude"`
+	Longitude   *float64 `json:"longitude" form:"longitude"`
 }
 
 // AddressUpdateDTO representa o modelo usado para atualizar endereços.
 type AddressUpdateDTO struct {
 	Base
-	Logradouro string `json:"logradouro" form:"logradouro"`
-	Bairro     string `json:"bairro" form:"bairro"`
-	Numero     int    `json:"numero" gorm:"type:smallint"`
+	Cep         string   `json:"cep" form:"cep"`
+	Cidade      string   `json:"cidade" form:"cidade"`
+	Uf          string   `json:"uf" form:"uf"`
+	Logradouro  string   `json:"logradouro" form:"logradouro"`
+	Bairro      string   `json:"bairro" form:"bairro"`
+	Numero      int      `json:"numero"`
+	Complemento *string  `json:"complemento" form:"complemento"`
+	Latitude    *float64 `json:"latitude" form:"latitude"`
+	Longitude   *float64 `json:"longitude" form:"longitude"`
+}
+
+// AddressDuplicateResponse representa o modelo usado para retornar os endereços do relatorio de duplicados.
+type AddressDuplicateResponse struct {
+	ID          string `json:"id"`
+	Cep         string `json:"cep"`
+	Cidade      string `json:"cidade"`
+	Uf          string `json:"uf"`
+	Logradouro  string `json:"logradouro"`
+	Bairro      string `json:"bairro"`
+	Numero      int    `json:"numero"`
+	Complemento string `json:"complemento"`
+}
+
+// AddressDuplicateCluster representa um grupo de endereços possivelmente duplicados.
+type AddressDuplicateCluster struct {
+	Similaridade float64                    `json:"similaridade"`
+	Enderecos    []AddressDuplicateResponse `json:"enderecos"`
+}
+
+// CreateAddressDuplicateResponse cria a resposta modelada para o relatorio de endereços duplicados.
+func CreateAddressDuplicateResponse(address entities.Endereco) AddressDuplicateResponse {
+	return AddressDuplicateResponse{
+		ID:          address.ID,
+		Cep:         address.Cep,
+		Cidade:      address.Cidade,
+		Uf:          address.Uf,
+		Logradouro:  address.Logradouro,
+		Bairro:      address.Bairro,
+		Numero:      address.Numero,
+		Complemento: address.Complemento,
+	}
+}
+
+// ufs siglas das unidades federativas do Brasil.
+var ufs = map[string]bool{
+	"AC": true, "AL": true, "AP": true, "AM": true, "BA": true, "CE": true, "DF": true, "ES": true, "GO": true,
+	"MA": true, "MT": true, "MS": true, "MG": true, "PA": true, "PB": true, "PR": true, "PE": true, "PI": true,
+	"RJ": true, "RN": true, "RS": true, "RO": true, "RR": true, "SC": true, "SP": true, "SE": true, "TO": true,
+}
+
+// NormalizeCEP valida o CEP, com ou sem o traço (00000-000), e o retorna somente com os 8 digitos.
+func NormalizeCEP(cep string) (string, bool) {
+	cep = strings.TrimSpace(cep)
+
+	if len(cep) == 9 && cep[5] == '-' {
+		cep = cep[:5] + cep[6:]
+	}
+
+	if len(cep) != 8 || cep == "00000000" {
+		return "", false
+	}
+
+	for _, char := range cep {
+		if char < '0' || char > '9' {
+			return "", false
+		}
+	}
+
+	return cep, true
+}
+
+// NormalizeUF valida a sigla da unidade federativa e a retorna em letras maiusculas.
+func NormalizeUF(uf string) (string, bool) {
+	uf = strings.ToUpper(strings.TrimSpace(uf))
+
+	return uf, ufs[uf]
+}
+
+// IsValidCoordinates verifica se a latitude e a longitude foram informadas juntas e estão dentro dos limites.
+func IsValidCoordinates(latitude *float64, longitude *float64) bool {
+	if latitude == nil && longitude == nil {
+		return true
+	}
+
+	if latitude == nil || longitude == nil {
+		return false
+	}
+
+	return *latitude >= -90 && *latitude <= 90 && *longitude >= -180 && *longitude <= 180
 }
@@ -0,0 +1,101 @@
+package dtos
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// accentReplacer remove os acentos das letras usadas nos endereços brasileiros.
+var accentReplacer = strings.NewReplacer(
+	"á", "a", "à", "a", "â", "a", "ã", "a", "ä", "a", "ª", "a",
+	"é", "e", "è", "e", "ê", "e", "ë", "e",
+	"í", "i", "ì", "i", "î", "i", "ï", "i",
+	"ó", "o", "ò", "o", "ô", "o", "õ", "o", "ö", "o", "º", "o",
+	"ú", "u", "ù", "u", "û", "u", "ü", "u",
+	"ç", "c", "ñ", "n",
+)
+
+// addressAbbreviations abreviações comuns nos endereços e a sua forma por extenso.
+var addressAbbreviations = map[string]string{
+	"r": "rua", "av": "avenida", "ave": "avenida", "al": "alameda", "tv": "travessa", "trav": "travessa",
+	"pc": "praca", "pca": "praca", "rod": "rodovia", "estr": "estrada", "est": "estrada", "lg": "largo",
+	"lgo": "largo", "jd": "jardim", "jdm": "jardim", "vl": "vila", "pq": "parque", "res": "residencial",
+	"cj": "conjunto", "conj": "conjunto", "qd": "quadra", "lt": "lote", "bl": "bloco", "ap": "apartamento",
+	"apto": "apartamento", "cs": "casa", "sl": "sala", "dr": "doutor", "prof": "professor", "eng": "engenheiro",
+	"gen": "general", "cel": "coronel", "pres": "presidente", "sto": "santo", "sta": "santa", "sr": "senhor",
+	"sra": "senhora", "ns": "nossa senhora",
+}
+
+// NormalizeAddressText normaliza um texto do endereço, expandindo as abreviações,
+// removendo acentos e pontuação e unificando as letras em minusculas e os espaços.
+func NormalizeAddressText(text string) string {
+	text = accentReplacer.Replace(strings.ToLower(text))
+
+	words := strings.FieldsFunc(text, func(char rune) bool {
+		return !unicode.IsLetter(char) && !unicode.IsDigit(char)
+	})
+
+	for i, word := range words {
+		if expanded, ok := addressAbbreviations[word]; ok {
+			words[i] = expanded
+		}
+	}
+
+	return strings.Join(words, " ")
+}
+
+// AddressKey gera a chave normalizada do endereço, usada para identificar endereços iguais escritos de formas diferentes.
+func AddressKey(address entities.Endereco) string {
+	return strings.Join([]string{
+		address.Cep,
+		strings.ToLower(address.Uf),
+		NormalizeAddressText(address.Cidade),
+		NormalizeAddressText(address.Logradouro),
+		NormalizeAddressText(address.Bairro),
+		strconv.Itoa(address.Numero),
+		NormalizeAddressText(address.Complemento),
+	}, "|")
+}
+
+// AddressSimilarity calcula a semelhança, de 0 a 1, entre o logradouro e o bairro normalizados de dois endereços.
+func AddressSimilarity(first entities.Endereco, second entities.Endereco) float64 {
+	firstText := []rune(NormalizeAddressText(first.Logradouro + " " + first.Bairro))
+	secondText := []rune(NormalizeAddressText(second.Logradouro + " " + second.Bairro))
+
+	length := max(len(firstText), len(secondText))
+	if length == 0 {
+		return 1
+	}
+
+	return 1 - float64(levenshtein(firstText, secondText))/float64(length)
+}
+
+// levenshtein calcula a quantidade minima de inserções, remoções e substituições para transformar um texto no outro.
+func levenshtein(first []rune, second []rune) int {
+	previous := make([]int, len(second)+1)
+	current := make([]int, len(second)+1)
+
+	for j := range previous {
+		previous[j] = j
+	}
+
+	for i := 1; i <= len(first); i++ {
+		current[0] = i
+
+		for j := 1; j <= len(second); j++ {
+			cost := 1
+			if first[i-1] == second[j-1] {
+				cost = 0
+			}
+
+			current[j] = min(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+
+		previous, current = current, previous
+	}
+
+	return previous[len(second)]
+}
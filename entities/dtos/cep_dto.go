@@ -0,0 +1,8 @@
+package dtos
+
+// CEPImportResult representa o modelo usado para retornar o resultado da importação do diretorio de CEPs.
+type CEPImportResult struct {
+	Importados int      `json:"importados"`
+	Ignorados  int      `json:"ignorados"`
+	Erros      []string `json:"erros"`
+}
@@ -4,15 +4,28 @@ import "github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 
 // ClientCreateDTO representa o modelo usado para cadastrar clientes.
 type ClientCreateDTO struct {
-	Nome string              `json:"nome" form:"nome" binding:"required,min=3,max=128"`
-	Tipo entities.ClientType `json:"tipo" form:"tipo" binding:"required,eq=juridico|eq=fisico|eq=especial"`
+	Nome      string              `json:"nome" form:"nome" binding:"required,min=3,max=128"`
+	Tipo      entities.ClientType `json:"tipo" form:"tipo" binding:"required,eq=juridico|eq=fisico|eq=especial"`
+	Documento string              `json:"documento" form:"documento"`
+	MatrizID  string              `json:"matriz_id" form:"matriz_id"`
 }
 
 // ClientUpdateDTO representa o modelo usado para atualizar clientes.
 type ClientUpdateDTO struct {
-	Base `json:"base" form:"base"`
-	Nome string              `json:"nome" form:"nome"`
-	Tipo entities.ClientType `json:"tipo" form:"tipo"`
+	Base      `json:"base" form:"base"`
+	Nome      string              `json:"nome" form:"nome"`
+	Tipo      entities.ClientType `json:"tipo" form:"tipo"`
+	Documento string              `json:"documento" form:"documento"`
+	MatrizID  *string             `json:"matriz_id" form:"matriz_id"`
+}
+
+// ClientBranchResponse representa o modelo usado para retornar as filiais de um cliente.
+type ClientBranchResponse struct {
+	ID        string              `json:"id"`
+	Nome      string              `json:"nome"`
+	Tipo      entities.ClientType `json:"tipo"`
+	Documento string              `json:"documento"`
+	MatrizID  string              `json:"matriz_id"`
 }
 
 // IsValidClientType verifica se o tipo de cliente e valido.
@@ -23,3 +36,14 @@ func IsValidClientType(clientType entities.ClientType) bool {
 
 	return true
 }
+
+// CreateClientBranchResponse cria a resposta modelada para a listagem das filiais.
+func CreateClientBranchResponse(client entities.Cliente) ClientBranchResponse {
+	return ClientBranchResponse{
+		ID:        client.ID,
+		Nome:      client.Nome,
+		Tipo:      client.Tipo,
+		Documento: client.Documento,
+		MatrizID:  client.MatrizID,
+	}
+}
@@ -0,0 +1,79 @@
+package dtos
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// ClientMergeDTO representa o modelo usado para mesclar clientes duplicados.
+type ClientMergeDTO struct {
+	SobreviventeID string   `json:"sobrevivente_id" form:"sobrevivente_id" binding:"required"`
+	DuplicadosIDs  []string `json:"duplicados_ids" form:"duplicados_ids" binding:"required,min=1"`
+	Previa         bool     `json:"previa" form:"previa"`
+}
+
+// PointTransfer representa um ponto do cliente duplicado transferido para o cliente sobrevivente.
+type PointTransfer struct {
+	PontoID           string `json:"ponto_id"`
+	ClienteAnteriorID string `json:"cliente_anterior_id"`
+	EnderecoID        string `json:"endereco_id"`
+	ContratoID        string `json:"contrato_id,omitempty"`
+}
+
+// PointUnification representa um ponto do cliente duplicado no mesmo endereço de um ponto do sobrevivente,
+// que é removido, tendo o seu contrato transferido para o ponto do sobrevivente.
+type PointUnification struct {
+	PontoID             string `json:"ponto_id"`
+	ClienteAnteriorID   string `json:"cliente_anterior_id"`
+	PontoSobreviventeID string `json:"ponto_sobrevivente_id"`
+	EnderecoID          string `json:"endereco_id"`
+	ContratoID          string `json:"contrato_id,omitempty"`
+}
+
+// ContactTransfer representa um contato do cliente duplicado transferido para o cliente sobrevivente.
+type ContactTransfer struct {
+	ContatoID string `json:"contato_id"`
+	Principal bool   `json:"principal"`
+}
+
+// ClientMergePlan representa as alterações da mesclagem de clientes, retornado também na prévia.
+type ClientMergePlan struct {
+	MesclagemID          string             `json:"mesclagem_id,omitempty"`
+	Previa               bool               `json:"previa"`
+	SobreviventeID       string             `json:"sobrevivente_id"`
+	DuplicadosIDs        []string           `json:"duplicados_ids"`
+	PontosTransferidos   []PointTransfer    `json:"pontos_transferidos"`
+	PontosUnificados     []PointUnification `json:"pontos_unificados"`
+	ContatosTransferidos []ContactTransfer  `json:"contatos_transferidos"`
+	ContatosDescartados  []string           `json:"contatos_descartados"`
+	// ContatosSubstituidos associa cada contato descartado ao contato do sobrevivente com o mesmo tipo e valor,
+	// que passa a ser o contato responsavel dos contratos do contato descartado.
+	ContatosSubstituidos map[string]string `json:"contatos_substituidos"`
+	Conflitos            []string          `json:"conflitos"`
+}
+
+// ClientMergeResponse representa o modelo usado para retornar o historico das mesclagens do cliente.
+type ClientMergeResponse struct {
+	ID             string          `json:"id"`
+	DataMesclagem  time.Time       `json:"data_mesclagem"`
+	SobreviventeID string          `json:"sobrevivente_id"`
+	DuplicadosIDs  []string        `json:"duplicados_ids"`
+	Detalhes       ClientMergePlan `json:"detalhes"`
+}
+
+// CreateClientMergeResponse cria a resposta modelada para o historico das mesclagens do cliente.
+func CreateClientMergeResponse(clientMerge entities.ClienteMesclagem) ClientMergeResponse {
+	clientMergeResponse := ClientMergeResponse{
+		ID:             clientMerge.ID,
+		DataMesclagem:  clientMerge.DataCriacao,
+		SobreviventeID: clientMerge.SobreviventeID,
+		DuplicadosIDs:  strings.Split(clientMerge.DuplicadosIDs, ","),
+	}
+
+	json.Unmarshal([]byte(clientMerge.Detalhes), &clientMergeResponse.Detalhes)
+
+	return clientMergeResponse
+}
@@ -0,0 +1,137 @@
+package dtos
+
+import (
+	"net/mail"
+	"strings"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// ContactCreateDTO representa o modelo usado para cadastrar contatos.
+type ContactCreateDTO struct {
+	ClienteID string               `json:"-" form:"-"`
+	Tipo      entities.ContactType `json:"tipo" form:"tipo" binding:"required,eq=email|eq=telefone|eq=whatsapp"`
+	Valor     string               `json:"valor" form:"valor" binding:"required"`
+	Principal bool                 `json:"principal" form:"principal"`
+}
+
+// ContactUpdateDTO representa o modelo usado para atualizar contatos.
+type ContactUpdateDTO struct {
+	Base
+	ClienteID  string `json:"-" form:"-"`
+	Valor      string `json:"valor" form:"valor"`
+	Principal  *bool  `json:"principal" form:"principal"`
+	Verificado *bool  `json:"verificado" form:"verificado"`
+}
+
+// PreferredChannelUpdateDTO representa o modelo usado para definir o canal preferido do cliente.
+type PreferredChannelUpdateDTO struct {
+	CanalPreferido entities.ContactType `json:"canal_preferido" form:"canal_preferido" binding:"required,eq=email|eq=telefone|eq=whatsapp"`
+}
+
+// ContactResponse representa o modelo usado para retornar a resposta da pesquisa dos contatos.
+type ContactResponse struct {
+	ID         string               `json:"id"`
+	ClienteID  string               `json:"cliente_id"`
+	Tipo       entities.ContactType `json:"tipo"`
+	Valor      string               `json:"valor"`
+	Principal  bool                 `json:"principal"`
+	Verificado bool                 `json:"verificado"`
+}
+
+// CreateContactResponse cria a responsta modelada para a pesquisa de contatos.
+func CreateContactResponse(contact entities.Contato) ContactResponse {
+	contactResponse := ContactResponse{
+		ID:         contact.ID,
+		ClienteID:  contact.ClienteID,
+		Tipo:       contact.Tipo,
+		Valor:      contact.Valor,
+		Principal:  contact.Principal,
+		Verificado: contact.Verificado}
+
+	return contactResponse
+}
+
+// IsValidContactType verifica se o tipo de contato e valido.
+func IsValidContactType(contactType entities.ContactType) bool {
+	if contactType != entities.EMAIL && contactType != entities.TELEFONE && contactType != entities.WHATSAPP {
+		return false
+	}
+
+	return true
+}
+
+// NormalizeEmail valida o email conforme a RFC 5322, sem nome de exibição, e o retorna em letras minusculas.
+func NormalizeEmail(email string) (string, bool) {
+	email = strings.ToLower(strings.TrimSpace(email))
+
+	address, err := mail.ParseAddress(email)
+	if err != nil || address.Address != email || address.Name != "" {
+		return "", false
+	}
+
+	domain := email[strings.LastIndex(email, "@")+1:]
+	if !strings.Contains(domain, ".") || strings.HasPrefix(domain, ".") || strings.HasSuffix(domain, ".") {
+		return "", false
+	}
+
+	return email, true
+}
+
+// NormalizePhone valida um telefone brasileiro, fixo ou celular, e o retorna no formato E.164 (+55DDNNNNNNNNN).
+// Aceita o numero com ou sem o codigo do pais, com o prefixo de longa distancia 0 e com qualquer formatação.
+func NormalizePhone(phone string) (string, bool) {
+	phone = strings.TrimSpace(phone)
+	hasCountryCode := strings.HasPrefix(phone, "+")
+
+	var builder strings.Builder
+	for _, char := range phone {
+		if char >= '0' && char <= '9' {
+			builder.WriteRune(char)
+		}
+	}
+
+	digits := builder.String()
+
+	switch {
+	case hasCountryCode:
+		if !strings.HasPrefix(digits, "55") {
+			return "", false
+		}
+
+		digits = digits[2:]
+	case len(digits) > 11 && strings.HasPrefix(digits, "55"):
+		digits = digits[2:]
+	case strings.HasPrefix(digits, "0"):
+		digits = digits[1:]
+	}
+
+	if len(digits) != 10 && len(digits) != 11 {
+		return "", false
+	}
+
+	// O DDD não possui o digito 0.
+	if digits[0] == '0' || digits[1] == '0' {
+		return "", false
+	}
+
+	// Celulares possuem 9 digitos iniciados por 9, e fixos 8 digitos iniciados de 2 a 5.
+	if len(digits) == 11 && digits[2] != '9' {
+		return "", false
+	}
+
+	if len(digits) == 10 && (digits[2] < '2' || digits[2] > '5') {
+		return "", false
+	}
+
+	return "+55" + digits, true
+}
+
+// NormalizeContactValue valida e normaliza o valor do contato de acordo com o seu tipo.
+func NormalizeContactValue(contactType entities.ContactType, value string) (string, bool) {
+	if contactType == entities.EMAIL {
+		return NormalizeEmail(value)
+	}
+
+	return NormalizePhone(value)
+}
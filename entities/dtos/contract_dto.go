@@ -1,31 +1,77 @@
 package dtos
 
 import (
+	"math"
+	"sort"
+	"time"
+
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 )
 
 // ContractCreateDTO representa o modelo usado para cadastrar contratos.
 type ContractCreateDTO struct {
-	Estado  entities.ContractState `json:"estado" form:"estado"`
-	PontoID string                 `json:"ponto_id" form:"ponto_id" binding:"required"`
+	Estado               entities.ContractState `json:"estado" form:"estado"`
+	PontoID              string                 `json:"ponto_id" form:"ponto_id" binding:"required"`
+	ContatoResponsavelID string                 `json:"contato_responsavel_id" form:"contato_responsavel_id"`
+	PlanoID              string                 `json:"plano_id" form:"plano_id"`
+	DataAssinatura       *time.Time             `json:"data_assinatura" form:"data_assinatura"`
+	DataAtivacao         *time.Time             `json:"data_ativacao" form:"data_ativacao"`
+	DataTermino          *time.Time             `json:"data_termino" form:"data_termino"`
+	// Instalacao cadastra o contrato pendente de instalação, com a ordem de serviço de instalação aberta.
+	Instalacao bool `json:"instalacao" form:"instalacao"`
 }
 
 // ContractUpdateDTO representa o modelo usado para atualizar contratos.
 type ContractUpdateDTO struct {
 	Base
-	Estado entities.ContractState `json:"estado" form:"estado" binding:"required,eq=Em vigor|eq=Desativado Temporario|eq=Cancelado"`
+	Estado               entities.ContractState `json:"estado" form:"estado" binding:"required,eq=Em vigor|eq=Desativado Temporario|eq=Cancelado"`
+	ContatoResponsavelID *string                `json:"contato_responsavel_id" form:"contato_responsavel_id"`
+	Motivo               string                 `json:"motivo" form:"motivo" binding:"max=128"`
+	// Automatica indica que a alteração foi feita por uma rotina da API, e não pelo usuario.
+	Automatica bool `json:"-" form:"-"`
 }
 
 // ContractResponse representa o modelo usado para retornar a resposta da pesquisa dos contratos.
 type ContractResponse struct {
-	ID                 string              `json:"id"`
-	ClienteID          string              `json:"cliente_id"`
-	ClienteNome        string              `json:"cliente_nome"`
-	ClienteTipo        entities.ClientType `json:"cliente_tipo"`
-	EnderecoID         string              `json:"endereco_id"`
-	EnderecoLogradouro string              `json:"endereco_logradouro"`
-	EnderecoBairro     string              `json:"endereco_bairro"`
-	EnderecoNumero     int                 `json:"endereco_numero"`
+	ID                  string                 `json:"id"`
+	Estado              entities.ContractState `json:"estado"`
+	ClienteID           string                 `json:"cliente_id"`
+	ClienteNome         string                 `json:"cliente_nome"`
+	ClienteTipo         entities.ClientType    `json:"cliente_tipo"`
+	EnderecoID          string                 `json:"endereco_id"`
+	EnderecoCep         string                 `json:"endereco_cep"`
+	EnderecoCidade      string                 `json:"endereco_cidade"`
+	EnderecoUf          string                 `json:"endereco_uf"`
+	EnderecoLogradouro  string                 `json:"endereco_logradouro"`
+	EnderecoBairro      string                 `json:"endereco_bairro"`
+	EnderecoNumero      int                    `json:"endereco_numero"`
+	EnderecoComplemento string                 `json:"endereco_complemento"`
+	EnderecoLatitude    *float64               `json:"endereco_latitude"`
+	EnderecoLongitude   *float64               `json:"endereco_longitude"`
+	ContatoResponsavel  string                 `json:"contato_responsavel_id"`
+	Plano               *PlanResponse          `json:"plano,omitempty"`
+	DataAssinatura      *time.Time             `json:"data_assinatura"`
+	DataAtivacao        *time.Time             `json:"data_ativacao"`
+	DataFimFidelidade   *time.Time             `json:"data_fim_fidelidade"`
+	DataTermino         *time.Time             `json:"data_termino"`
+	MultaRescisao       int64                  `json:"multa_rescisao,omitempty"`
+	VersaoID            string                 `json:"versao_id,omitempty"`
+}
+
+// ContractSummaryResponse representa o modelo usado para retornar o resumo dos contratos de um cliente ou grupo.
+type ContractSummaryResponse struct {
+	ClienteID string                         `json:"cliente_id,omitempty"`
+	Total     int                            `json:"total"`
+	PorEstado map[entities.ContractState]int `json:"por_estado"`
+	Clientes  []ClientContractSummary        `json:"clientes"`
+}
+
+// ClientContractSummary representa o resumo dos contratos de cada cliente do grupo.
+type ClientContractSummary struct {
+	ClienteID   string                         `json:"cliente_id"`
+	ClienteNome string                         `json:"cliente_nome"`
+	Total       int                            `json:"total"`
+	PorEstado   map[entities.ContractState]int `json:"por_estado"`
 }
 
 // IsAuthorized verifica se a alteração de estado do contrato é valida.
@@ -35,6 +81,10 @@ func IsAuthorized(oldState entities.ContractState, newState entities.ContractSta
 		return false
 	case oldState == entities.CANCELADO:
 		return false
+	case newState == entities.PENDENTE:
+		return false
+	case oldState == entities.PENDENTE && newState == entities.DESATIVADO:
+		return false
 	case oldState == entities.VIGOR && newState != entities.DESATIVADO:
 		return false
 	default:
@@ -42,18 +92,110 @@ func IsAuthorized(oldState entities.ContractState, newState entities.ContractSta
 	}
 }
 
+// FidelityEnd retorna o fim da fidelidade do plano para o contrato ativado na data informada.
+func FidelityEnd(activation time.Time, plan entities.Plano) *time.Time {
+	if plan.MesesFidelidade <= 0 {
+		return nil
+	}
+
+	fidelityEnd := activation.AddDate(0, plan.MesesFidelidade, 0)
+
+	return &fidelityEnd
+}
+
+// TerminationFee calcula a multa do cancelamento do contrato na data informada, proporcional aos dias que
+// faltam para o fim da fidelidade. Sem fidelidade, ou após o seu fim, não há multa.
+func TerminationFee(contract entities.Contrato, plan entities.Plano, date time.Time) int64 {
+	if contract.DataAtivacao == nil || contract.DataFimFidelidade == nil || !date.Before(*contract.DataFimFidelidade) {
+		return 0
+	}
+
+	fidelityDays := contract.DataFimFidelidade.Sub(*contract.DataAtivacao).Hours()
+	remainingDays := contract.DataFimFidelidade.Sub(date).Hours()
+
+	if fidelityDays <= 0 {
+		return 0
+	}
+
+	return int64(math.Round(float64(plan.MultaFidelidade) * min(remainingDays/fidelityDays, 1)))
+}
+
 // CreateContractResponse cria a responsta modelada para a pesquisa de contratos.
 func CreateContractResponse(contrat entities.Contrato) ContractResponse {
 	contractResponse := ContractResponse{
-		ID:                 contrat.ID,
-		ClienteID:          contrat.Ponto.ClienteID,
-		ClienteNome:        contrat.Ponto.Cliente.Nome,
-		ClienteTipo:        contrat.Ponto.Cliente.Tipo,
-		EnderecoID:         contrat.Ponto.EnderecoID,
-		EnderecoLogradouro: contrat.Ponto.Endereco.Logradouro,
-		EnderecoBairro:     contrat.Ponto.Endereco.Bairro,
-		EnderecoNumero:     contrat.Ponto.Endereco.Numero,
+		ID:                  contrat.ID,
+		Estado:              contrat.Estado,
+		ClienteID:           contrat.Ponto.ClienteID,
+		ClienteNome:         contrat.Ponto.Cliente.Nome,
+		ClienteTipo:         contrat.Ponto.Cliente.Tipo,
+		EnderecoID:          contrat.Ponto.EnderecoID,
+		EnderecoCep:         contrat.Ponto.Endereco.Cep,
+		EnderecoCidade:      contrat.Ponto.Endereco.Cidade,
+		EnderecoUf:          contrat.Ponto.Endereco.Uf,
+		EnderecoLogradouro:  contrat.Ponto.Endereco.Logradouro,
+		EnderecoBairro:      contrat.Ponto.Endereco.Bairro,
+		EnderecoNumero:      contrat.Ponto.Endereco.Numero,
+		EnderecoComplemento: contrat.Ponto.Endereco.Complemento,
+		EnderecoLatitude:    contrat.Ponto.Endereco.Latitude,
+		EnderecoLongitude:   contrat.Ponto.Endereco.Longitude,
+		ContatoResponsavel:  contrat.ContatoResponsavelID,
+		DataAssinatura:      contrat.DataAssinatura,
+		DataAtivacao:        contrat.DataAtivacao,
+		DataFimFidelidade:   contrat.DataFimFidelidade,
+		DataTermino:         ContractTermination(contrat),
+		MultaRescisao:       contrat.MultaRescisao,
+		VersaoID:            contrat.VersaoID,
+	}
+
+	if contrat.Plano.ID != "" {
+		plan := CreatePlanResponse(contrat.Plano)
+		contractResponse.Plano = &plan
 	}
 
 	return contractResponse
 }
+
+// CreateContractSummaryResponse cria o resumo dos contratos, totalizando por estado no grupo e em cada cliente.
+func CreateContractSummaryResponse(clientID string, contracts []entities.Contrato) ContractSummaryResponse {
+	summary := ContractSummaryResponse{
+		ClienteID: clientID,
+		PorEstado: map[entities.ContractState]int{},
+		Clientes:  []ClientContractSummary{},
+	}
+
+	clients := map[string]*ClientContractSummary{}
+	clientIDs := []string{}
+
+	for _, contract := range contracts {
+		clientSummary, ok := clients[contract.Ponto.ClienteID]
+		if !ok {
+			clientSummary = &ClientContractSummary{
+				ClienteID:   contract.Ponto.ClienteID,
+				ClienteNome: contract.Ponto.Cliente.Nome,
+				PorEstado:   map[entities.ContractState]int{},
+			}
+			clients[contract.Ponto.ClienteID] = clientSummary
+			clientIDs = append(clientIDs, contract.Ponto.ClienteID)
+		}
+
+		clientSummary.Total++
+		clientSummary.PorEstado[contract.Estado]++
+		summary.Total++
+		summary.PorEstado[contract.Estado]++
+	}
+
+	// A matriz aparece primeiro e as filiais seguem em ordem alfabetica.
+	sort.SliceStable(clientIDs, func(i, j int) bool {
+		if clientIDs[i] == clientID || clientIDs[j] == clientID {
+			return clientIDs[i] == clientID
+		}
+
+		return clients[clientIDs[i]].ClienteNome < clients[clientIDs[j]].ClienteNome
+	})
+
+	for _, id := range clientIDs {
+		summary.Clientes = append(summary.Clientes, *clients[id])
+	}
+
+	return summary
+}
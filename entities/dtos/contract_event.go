@@ -7,27 +7,49 @@ import (
 )
 
 // ContratoEventCreateDTO representa a tabela t_contrato_evento no banco de dados.
+// Tipo pode ser omitido nos eventos de alteração de estado.
 type ContratoEventCreateDTO struct {
-	EstadoAnterior  entities.ContractState `json:"estado_anterior" form:"estado_anterior" binding:"required"`
-	EstadoPosterior entities.ContractState `json:"estado_posterior" form:"estado_posterior" binding:"required"`
-	ContratoID      string                 `json:"contrato_id" form:"contrato_id" binding:"required"`
+	Tipo               entities.ContractEventType `json:"tipo" form:"tipo"`
+	EstadoAnterior     entities.ContractState     `json:"estado_anterior" form:"estado_anterior" binding:"required"`
+	EstadoPosterior    entities.ContractState     `json:"estado_posterior" form:"estado_posterior" binding:"required"`
+	ClienteAnteriorID  string                     `json:"cliente_anterior_id" form:"cliente_anterior_id"`
+	ClientePosteriorID string                     `json:"cliente_posterior_id" form:"cliente_posterior_id"`
+	PlanoAnteriorID    string                     `json:"plano_anterior_id" form:"plano_anterior_id"`
+	PlanoPosteriorID   string                     `json:"plano_posterior_id" form:"plano_posterior_id"`
+	DataEfetiva        *time.Time                 `json:"data_efetiva" form:"data_efetiva"`
+	Motivo             string                     `json:"motivo" form:"motivo"`
+	ContratoID         string                     `json:"contrato_id" form:"contrato_id" binding:"required"`
 }
 
 // ContractEventResponse representa o modelo usado para retornar a resposta do histórico de alteração de do contrato.
 type ContractEventResponse struct {
-	ID           string                 `json:"id"`
-	DataEvento   time.Time              `json:"data_evento"`
-	EstadoAntigo entities.ContractState `json:"estado_antigo"`
-	EstadoNovo   entities.ContractState `json:"estado_novo"`
+	ID            string                     `json:"id"`
+	Tipo          entities.ContractEventType `json:"tipo"`
+	DataEvento    time.Time                  `json:"data_evento"`
+	EstadoAntigo  entities.ContractState     `json:"estado_antigo"`
+	EstadoNovo    entities.ContractState     `json:"estado_novo"`
+	ClienteAntigo string                     `json:"cliente_antigo,omitempty"`
+	ClienteNovo   string                     `json:"cliente_novo,omitempty"`
+	PlanoAntigo   string                     `json:"plano_antigo,omitempty"`
+	PlanoNovo     string                     `json:"plano_novo,omitempty"`
+	DataEfetiva   *time.Time                 `json:"data_efetiva,omitempty"`
+	Motivo        string                     `json:"motivo,omitempty"`
 }
 
 // CreateContractEventResponse cria a responsta modelada para a pesquisa do histórico de alteração de do contrato.
 func CreateContractEventResponse(contractEvent entities.ContratoEvento) ContractEventResponse {
 	contractEventResponse := ContractEventResponse{
-		ID:           contractEvent.ID,
-		DataEvento:   contractEvent.DataCriacao,
-		EstadoAntigo: contractEvent.EstadoAnterior,
-		EstadoNovo:   contractEvent.EstadoPosterior,
+		ID:            contractEvent.ID,
+		Tipo:          contractEvent.Tipo,
+		DataEvento:    contractEvent.DataCriacao,
+		EstadoAntigo:  contractEvent.EstadoAnterior,
+		EstadoNovo:    contractEvent.EstadoPosterior,
+		ClienteAntigo: contractEvent.ClienteAnteriorID,
+		ClienteNovo:   contractEvent.ClientePosteriorID,
+		PlanoAntigo:   contractEvent.PlanoAnteriorID,
+		PlanoNovo:     contractEvent.PlanoPosteriorID,
+		DataEfetiva:   contractEvent.DataEfetiva,
+		Motivo:        contractEvent.Motivo,
 	}
 
 	return contractEventResponse
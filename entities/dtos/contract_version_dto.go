@@ -0,0 +1,168 @@
+package dtos
+
+import (
+	"encoding/json"
+	"sort"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// ContractTerms representa os termos do contrato guardados em cada versão, com os valores em centavos.
+type ContractTerms struct {
+	PlanoID           string `json:"plano_id"`
+	PlanoNome         string `json:"plano_nome"`
+	Velocidade        int    `json:"velocidade"`
+	PrecoMensal       int64  `json:"preco_mensal"`
+	MesesFidelidade   int    `json:"meses_fidelidade"`
+	MultaFidelidade   int64  `json:"multa_fidelidade"`
+	DataAssinatura    string `json:"data_assinatura"`
+	DataAtivacao      string `json:"data_ativacao"`
+	DataFimFidelidade string `json:"data_fim_fidelidade"`
+	DataTermino       string `json:"data_termino"`
+}
+
+// ContractVersionCreateDTO representa o modelo usado para registrar uma nova versão do contrato.
+type ContractVersionCreateDTO struct {
+	ContratoID  string
+	Motivo      entities.ContractVersionReason
+	DataEfetiva time.Time
+	Termos      ContractTerms
+}
+
+// ContractRenewalDTO representa o modelo usado para renovar contratos.
+// DataEfetiva pode ser omitida para a renovação valer a partir de agora.
+type ContractRenewalDTO struct {
+	Base
+	DataTermino time.Time  `json:"data_termino" form:"data_termino" binding:"required"`
+	DataEfetiva *time.Time `json:"data_efetiva" form:"data_efetiva"`
+}
+
+// ContractVersionResponse representa o modelo usado para retornar as versões do contrato.
+type ContractVersionResponse struct {
+	ID               string                         `json:"id"`
+	ContratoID       string                         `json:"contrato_id"`
+	Numero           int                            `json:"numero"`
+	VersaoAnteriorID string                         `json:"versao_anterior_id,omitempty"`
+	Motivo           entities.ContractVersionReason `json:"motivo"`
+	DataEfetiva      time.Time                      `json:"data_efetiva"`
+	DataRegistro     time.Time                      `json:"data_registro"`
+	Termos           ContractTerms                  `json:"termos"`
+}
+
+// ContractVersionChange representa um termo alterado entre duas versões do contrato.
+type ContractVersionChange struct {
+	Campo     string      `json:"campo"`
+	Anterior  interface{} `json:"anterior"`
+	Posterior interface{} `json:"posterior"`
+}
+
+// ContractVersionDiffResponse representa o modelo usado para retornar as diferenças entre duas versões do contrato.
+type ContractVersionDiffResponse struct {
+	ContratoID string                  `json:"contrato_id"`
+	De         ContractVersionResponse `json:"de"`
+	Para       ContractVersionResponse `json:"para"`
+	Alteracoes []ContractVersionChange `json:"alteracoes"`
+}
+
+// CreateContractTerms cria os termos do contrato com o plano informado.
+func CreateContractTerms(contract entities.Contrato, plan entities.Plano) ContractTerms {
+	return ContractTerms{
+		PlanoID:           plan.ID,
+		PlanoNome:         plan.Nome,
+		Velocidade:        plan.Velocidade,
+		PrecoMensal:       plan.PrecoMensal,
+		MesesFidelidade:   plan.MesesFidelidade,
+		MultaFidelidade:   plan.MultaFidelidade,
+		DataAssinatura:    formatDate(contract.DataAssinatura),
+		DataAtivacao:      formatDate(contract.DataAtivacao),
+		DataFimFidelidade: formatDate(contract.DataFimFidelidade),
+		DataTermino:       formatDate(ContractTermination(contract)),
+	}
+}
+
+// ContractTermination retorna a data de termino da versão atual do contrato, alterada pelas renovações. Sem a
+// versão carregada é usada a data de termino do cadastro do contrato.
+func ContractTermination(contract entities.Contrato) *time.Time {
+	if contract.Versao == nil {
+		return contract.DataTermino
+	}
+
+	terms := ContractTerms{}
+	json.Unmarshal([]byte(contract.Versao.Termos), &terms)
+
+	termination, err := time.Parse(DateLayout, terms.DataTermino)
+	if err != nil {
+		return nil
+	}
+
+	return &termination
+}
+
+// CreateContractVersionResponse cria a responsta modelada para a pesquisa das versões do contrato.
+func CreateContractVersionResponse(contractVersion entities.ContratoVersao) ContractVersionResponse {
+	contractVersionResponse := ContractVersionResponse{
+		ID:               contractVersion.ID,
+		ContratoID:       contractVersion.ContratoID,
+		Numero:           contractVersion.Numero,
+		VersaoAnteriorID: contractVersion.VersaoAnteriorID,
+		Motivo:           contractVersion.Motivo,
+		DataEfetiva:      contractVersion.DataEfetiva,
+		DataRegistro:     contractVersion.DataCriacao,
+	}
+
+	json.Unmarshal([]byte(contractVersion.Termos), &contractVersionResponse.Termos)
+
+	return contractVersionResponse
+}
+
+// CreateContractVersionDiffResponse cria a responsta com os termos alterados da primeira para a segunda versão,
+// em ordem alfabetica dos campos.
+func CreateContractVersionDiffResponse(from entities.ContratoVersao, to entities.ContratoVersao) ContractVersionDiffResponse {
+	diffResponse := ContractVersionDiffResponse{
+		ContratoID: to.ContratoID,
+		De:         CreateContractVersionResponse(from),
+		Para:       CreateContractVersionResponse(to),
+		Alteracoes: []ContractVersionChange{},
+	}
+
+	fromTerms := map[string]interface{}{}
+	toTerms := map[string]interface{}{}
+
+	json.Unmarshal([]byte(from.Termos), &fromTerms)
+	json.Unmarshal([]byte(to.Termos), &toTerms)
+
+	fields := []string{}
+	for field := range toTerms {
+		fields = append(fields, field)
+	}
+
+	for field := range fromTerms {
+		if _, ok := toTerms[field]; !ok {
+			fields = append(fields, field)
+		}
+	}
+
+	sort.Strings(fields)
+
+	for _, field := range fields {
+		if fromTerms[field] != toTerms[field] {
+			diffResponse.Alteracoes = append(diffResponse.Alteracoes, ContractVersionChange{
+				Campo:     field,
+				Anterior:  fromTerms[field],
+				Posterior: toTerms[field],
+			})
+		}
+	}
+
+	return diffResponse
+}
+
+// formatDate formata a data informada no formato DateLayout, ou retorna vazio quando ela não existe.
+func formatDate(date *time.Time) string {
+	if date == nil {
+		return ""
+	}
+
+	return date.Format(DateLayout)
+}
@@ -0,0 +1,119 @@
+package dtos
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// Tamanhos dos documentos sem formatação.
+const (
+	cpfLength  = 11
+	cnpjLength = 14
+)
+
+// NormalizeDocument remove a formatação do documento (pontos, barras, traços e espaços) e converte as letras para maiusculas.
+func NormalizeDocument(document string) string {
+	var builder strings.Builder
+
+	for _, char := range strings.ToUpper(document) {
+		if (char >= '0' && char <= '9') || (char >= 'A' && char <= 'Z') {
+			builder.WriteRune(char)
+		}
+	}
+
+	return builder.String()
+}
+
+// IsValidDocument verifica se o documento normalizado é valido para o tipo de cliente:
+// CPF para fisico, CNPJ para juridico e qualquer um dos dois para especial.
+func IsValidDocument(clientType entities.ClientType, document string) bool {
+	switch clientType {
+	case entities.FISICO:
+		return IsValidCPF(document)
+	case entities.JURIDICO:
+		return IsValidCNPJ(document)
+	case entities.ESPECIAL:
+		return IsValidCPF(document) || IsValidCNPJ(document)
+	}
+
+	return false
+}
+
+// IsValidCPF verifica os digitos verificadores de um CPF normalizado.
+func IsValidCPF(cpf string) bool {
+	if len(cpf) != cpfLength || hasOnlyRepeatedChars(cpf) {
+		return false
+	}
+
+	for _, char := range cpf {
+		if !unicode.IsDigit(char) {
+			return false
+		}
+	}
+
+	for length := 9; length < cpfLength; length++ {
+		sum := 0
+		for i := 0; i < length; i++ {
+			sum += int(cpf[i]-'0') * (length + 1 - i)
+		}
+
+		digit := sum * 10 % 11
+		if digit == 10 {
+			digit = 0
+		}
+
+		if digit != int(cpf[length]-'0') {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsValidCNPJ verifica os digitos verificadores de um CNPJ normalizado, aceitando tambem o formato alfanumerico,
+// em que os 12 primeiros caracteres podem ser letras e somente os 2 digitos verificadores são numericos.
+func IsValidCNPJ(cnpj string) bool {
+	if len(cnpj) != cnpjLength || hasOnlyRepeatedChars(cnpj) {
+		return false
+	}
+
+	for i, char := range cnpj {
+		isDigit := char >= '0' && char <= '9'
+		isLetter := char >= 'A' && char <= 'Z'
+
+		if !isDigit && (i >= 12 || !isLetter) {
+			return false
+		}
+	}
+
+	for length := 12; length < cnpjLength; length++ {
+		sum := 0
+		weight := length - 7
+		for i := 0; i < length; i++ {
+			// O valor de cada caractere é o seu codigo ASCII menos 48, o que mantem os digitos de 0 a 9.
+			sum += int(cnpj[i]-'0') * weight
+
+			weight--
+			if weight < 2 {
+				weight = 9
+			}
+		}
+
+		digit := 11 - sum%11
+		if digit >= 10 {
+			digit = 0
+		}
+
+		if digit != int(cnpj[length]-'0') {
+			return false
+		}
+	}
+
+	return true
+}
+
+func hasOnlyRepeatedChars(text string) bool {
+	return strings.Count(text, text[:1]) == len(text)
+}
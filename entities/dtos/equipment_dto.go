@@ -0,0 +1,100 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// EquipmentCreateDTO representa o modelo usado para cadastrar os equipamentos no estoque.
+type EquipmentCreateDTO struct {
+	Serial string `json:"serial" form:"serial" binding:"required,max=64"`
+	Modelo string `json:"modelo" form:"modelo" binding:"required,max=128"`
+	Mac    string `json:"mac" form:"mac" binding:"required"`
+}
+
+// EquipmentMovementDTO representa o modelo usado para movimentar os equipamentos.
+// PontoID é obrigatorio apenas para a instalação.
+type EquipmentMovementDTO struct {
+	Base
+	Estado  entities.EquipmentStatus `json:"estado" form:"estado" binding:"required,eq=estoque|eq=instalado|eq=defeito|eq=extraviado"`
+	PontoID string                   `json:"ponto_id" form:"ponto_id"`
+	Motivo  string                   `json:"motivo" form:"motivo" binding:"max=256"`
+}
+
+// EquipmentFilter representa os filtros da pesquisa dos equipamentos.
+type EquipmentFilter struct {
+	PontoID           string
+	Estado            entities.EquipmentStatus
+	DevolucaoPendente *bool
+}
+
+// EquipmentResponse representa o modelo usado para retornar os equipamentos.
+type EquipmentResponse struct {
+	ID                string                   `json:"id"`
+	Serial            string                   `json:"serial"`
+	Modelo            string                   `json:"modelo"`
+	Mac               string                   `json:"mac"`
+	Estado            entities.EquipmentStatus `json:"estado"`
+	PontoID           string                   `json:"ponto_id,omitempty"`
+	DevolucaoPendente bool                     `json:"devolucao_pendente"`
+}
+
+// EquipmentReturnResponse representa o modelo usado para retornar o relatorio dos equipamentos a recolher.
+type EquipmentReturnResponse struct {
+	EquipmentResponse
+	ClienteID   string `json:"cliente_id"`
+	ClienteNome string `json:"cliente_nome"`
+}
+
+// EquipmentMovementResponse representa o modelo usado para retornar o historico de movimentações do equipamento.
+type EquipmentMovementResponse struct {
+	ID            string                   `json:"id"`
+	DataMovimento time.Time                `json:"data_movimento"`
+	EstadoAntigo  entities.EquipmentStatus `json:"estado_antigo,omitempty"`
+	EstadoNovo    entities.EquipmentStatus `json:"estado_novo"`
+	PontoAntigo   string                   `json:"ponto_antigo,omitempty"`
+	PontoNovo     string                   `json:"ponto_novo,omitempty"`
+	Motivo        string                   `json:"motivo,omitempty"`
+}
+
+// CreateEquipmentResponse cria a responsta modelada para a pesquisa dos equipamentos.
+func CreateEquipmentResponse(equipment entities.Equipamento) EquipmentResponse {
+	equipmentResponse := EquipmentResponse{
+		ID:                equipment.ID,
+		Serial:            equipment.Serial,
+		Modelo:            equipment.Modelo,
+		Mac:               equipment.Mac,
+		Estado:            equipment.Estado,
+		PontoID:           equipment.PontoID,
+		DevolucaoPendente: equipment.DevolucaoPendente,
+	}
+
+	return equipmentResponse
+}
+
+// CreateEquipmentReturnResponse cria a responsta modelada para o relatorio dos equipamentos a recolher.
+func CreateEquipmentReturnResponse(equipment entities.Equipamento) EquipmentReturnResponse {
+	equipmentReturnResponse := EquipmentReturnResponse{
+		EquipmentResponse: CreateEquipmentResponse(equipment),
+		ClienteID:         equipment.Ponto.ClienteID,
+		ClienteNome:       equipment.Ponto.Cliente.Nome,
+	}
+
+	return equipmentReturnResponse
+}
+
+// CreateEquipmentMovementResponse cria a responsta modelada para a pesquisa do historico de movimentações do equipamento.
+func CreateEquipmentMovementResponse(movement entities.EquipamentoMovimentacao) EquipmentMovementResponse {
+	equipmentMovementResponse := EquipmentMovementResponse{
+		ID:            movement.ID,
+		DataMovimento: movement.DataCriacao,
+		EstadoAntigo:  movement.EstadoAnterior,
+		EstadoNovo:    movement.EstadoPosterior,
+		PontoAntigo:   movement.PontoAnteriorID,
+		PontoNovo:     movement.PontoPosteriorID,
+		Motivo:        movement.Motivo,
+	}
+
+	return equipmentMovementResponse
+}
@@ -0,0 +1,185 @@
+package dtos
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// earthRadius raio medio da Terra em metros.
+const earthRadius = 6371008.8
+
+// Limites do raio da pesquisa de pontos, em metros.
+const (
+	DefaultSearchRadius = 2000
+	MaxSearchRadius     = 100000
+)
+
+// Coordinate representa uma coordenada geografica em graus.
+type Coordinate struct {
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// BoundingBox representa o retangulo de latitudes e longitudes que contem a area pesquisada.
+type BoundingBox struct {
+	MinLatitude  float64
+	MaxLatitude  float64
+	MinLongitude float64
+	MaxLongitude float64
+}
+
+// PointGeoFilter representa o filtro da pesquisa de pontos por raio ou por poligono.
+// Quando somente o poligono é informado, Perto é usado apenas para ordenar os pontos pela distancia.
+type PointGeoFilter struct {
+	Perto    *Coordinate
+	Raio     float64
+	Poligono []Coordinate
+}
+
+// PointGeoResponse representa o modelo usado para retornar os pontos da pesquisa geografica.
+type PointGeoResponse struct {
+	PointResponse
+	Distancia      *float64 `json:"distancia"`
+	ContratoEstado string   `json:"contrato_estado"`
+}
+
+// BoundingBoxes retorna os retangulos que contem a area pesquisada, usados para pré-filtrar os pontos no banco de dados.
+func (filter PointGeoFilter) BoundingBoxes() []BoundingBox {
+	boxes := []BoundingBox{}
+
+	if filter.Perto != nil && filter.Raio > 0 {
+		boxes = append(boxes, RadiusBoundingBox(*filter.Perto, filter.Raio))
+	}
+
+	if len(filter.Poligono) != 0 {
+		boxes = append(boxes, PolygonBoundingBox(filter.Poligono))
+	}
+
+	return boxes
+}
+
+// Matches verifica se a coordenada está dentro do raio e do poligono do filtro.
+func (filter PointGeoFilter) Matches(point Coordinate) bool {
+	if filter.Perto != nil && filter.Raio > 0 && Distance(*filter.Perto, point) > filter.Raio {
+		return false
+	}
+
+	if len(filter.Poligono) != 0 && !IsInsidePolygon(point, filter.Poligono) {
+		return false
+	}
+
+	return true
+}
+
+// ParseCoordinate converte o texto "lat,lng" em uma coordenada valida.
+func ParseCoordinate(text string) (Coordinate, bool) {
+	latitudeText, longitudeText, ok := strings.Cut(text, ",")
+	if !ok {
+		return Coordinate{}, false
+	}
+
+	latitude, err := strconv.ParseFloat(strings.TrimSpace(latitudeText), 64)
+	if err != nil {
+		return Coordinate{}, false
+	}
+
+	longitude, err := strconv.ParseFloat(strings.TrimSpace(longitudeText), 64)
+	if err != nil {
+		return Coordinate{}, false
+	}
+
+	if !IsValidCoordinates(&latitude, &longitude) {
+		return Coordinate{}, false
+	}
+
+	return Coordinate{Latitude: latitude, Longitude: longitude}, true
+}
+
+// ParsePolygon converte o texto "lat,lng;lat,lng;lat,lng" em um poligono com pelo menos 3 vertices.
+func ParsePolygon(text string) ([]Coordinate, bool) {
+	polygon := []Coordinate{}
+
+	for _, vertexText := range strings.Split(text, ";") {
+		vertex, ok := ParseCoordinate(vertexText)
+		if !ok {
+			return nil, false
+		}
+
+		polygon = append(polygon, vertex)
+	}
+
+	// O poligono pode ser informado fechado, repetindo o primeiro vertice no final.
+	if len(polygon) > 1 && polygon[0] == polygon[len(polygon)-1] {
+		polygon = polygon[:len(polygon)-1]
+	}
+
+	return polygon, len(polygon) >= 3
+}
+
+// Distance calcula a distancia em metros entre duas coordenadas pela formula de haversine.
+func Distance(from Coordinate, to Coordinate) float64 {
+	fromLatitude := from.Latitude * math.Pi / 180
+	toLatitude := to.Latitude * math.Pi / 180
+	deltaLatitude := (to.Latitude - from.Latitude) * math.Pi / 180
+	deltaLongitude := (to.Longitude - from.Longitude) * math.Pi / 180
+
+	a := math.Sin(deltaLatitude/2)*math.Sin(deltaLatitude/2) +
+		math.Cos(fromLatitude)*math.Cos(toLatitude)*math.Sin(deltaLongitude/2)*math.Sin(deltaLongitude/2)
+
+	return 2 * earthRadius * math.Asin(math.Min(1, math.Sqrt(a)))
+}
+
+// IsInsidePolygon verifica se a coordenada está dentro do poligono pelo algoritmo de ray casting.
+func IsInsidePolygon(point Coordinate, polygon []Coordinate) bool {
+	inside := false
+
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		first, second := polygon[i], polygon[j]
+
+		if (first.Latitude > point.Latitude) != (second.Latitude > point.Latitude) &&
+			point.Longitude < (second.Longitude-first.Longitude)*(point.Latitude-first.Latitude)/
+				(second.Latitude-first.Latitude)+first.Longitude {
+			inside = !inside
+		}
+	}
+
+	return inside
+}
+
+// RadiusBoundingBox calcula o retangulo que contem o circulo de raio em metros ao redor da coordenada.
+func RadiusBoundingBox(center Coordinate, radius float64) BoundingBox {
+	deltaLatitude := radius / earthRadius * 180 / math.Pi
+
+	deltaLongitude := 180.0
+	if cos := math.Cos(center.Latitude * math.Pi / 180); cos > 1e-9 {
+		deltaLongitude = math.Min(180, deltaLatitude/cos)
+	}
+
+	return BoundingBox{
+		MinLatitude:  math.Max(-90, center.Latitude-deltaLatitude),
+		MaxLatitude:  math.Min(90, center.Latitude+deltaLatitude),
+		MinLongitude: math.Max(-180, center.Longitude-deltaLongitude),
+		MaxLongitude: math.Min(180, center.Longitude+deltaLongitude),
+	}
+}
+
+// PolygonBoundingBox calcula o retangulo que contem o poligono.
+func PolygonBoundingBox(polygon []Coordinate) BoundingBox {
+	box := BoundingBox{MinLatitude: 90, MaxLatitude: -90, MinLongitude: 180, MaxLongitude: -180}
+
+	for _, vertex := range polygon {
+		box.MinLatitude = math.Min(box.MinLatitude, vertex.Latitude)
+		box.MaxLatitude = math.Max(box.MaxLatitude, vertex.Latitude)
+		box.MinLongitude = math.Min(box.MinLongitude, vertex.Longitude)
+		box.MaxLongitude = math.Max(box.MaxLongitude, vertex.Longitude)
+	}
+
+	return box
+}
+
+// Contains verifica se a coordenada está dentro do retangulo.
+func (box BoundingBox) Contains(point Coordinate) bool {
+	return point.Latitude >= box.MinLatitude && point.Latitude <= box.MaxLatitude &&
+		point.Longitude >= box.MinLongitude && point.Longitude <= box.MaxLongitude
+}
@@ -0,0 +1,72 @@
+package dtos
+
+import "github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+
+// ImportColumns campos aceitos na importação em lote, que são também os nomes padrão das colunas do CSV.
+var ImportColumns = []string{
+	"cliente_nome", "cliente_tipo", "cliente_documento",
+	"cep", "cidade", "uf", "logradouro", "bairro", "numero", "complemento",
+	"plano", "data_assinatura", "data_ativacao", "data_termino",
+	"contato_responsavel_tipo", "contato_responsavel_valor",
+}
+
+// ImportRequiredColumns campos obrigatorios no cabeçalho do CSV da importação em lote.
+var ImportRequiredColumns = []string{"cliente_nome", "cliente_tipo", "cep", "numero"}
+
+// ImportOptions representa as opções da importação em lote. Mapeamento associa os campos da importação às
+// colunas do CSV, e os campos ausentes usam a coluna com o proprio nome do campo. Na Previa as linhas são
+// apenas validadas, sem gravar os registros.
+type ImportOptions struct {
+	Previa     bool              `json:"previa"`
+	Mapeamento map[string]string `json:"mapeamento"`
+}
+
+// ImportAction representa o type ImportAction.
+type ImportAction string
+
+// Constantes que representam o que a importação faz com cada registro da linha.
+const (
+	IMPORTACAO_CRIAR     ImportAction = "criar"
+	IMPORTACAO_RESTAURAR ImportAction = "restaurar"
+	IMPORTACAO_EXISTENTE ImportAction = "existente"
+)
+
+// ImportRowPlan representa os registros de uma linha valida da importação, com os ids já resolvidos pelas chaves
+// naturais. Os registros existentes não são alterados, e o contrato é opcional, assim como o seu contato
+// responsavel e a ordem de serviço de instalação do contrato pendente.
+type ImportRowPlan struct {
+	Linha           int
+	Cliente         entities.Cliente
+	AcaoCliente     ImportAction
+	Endereco        entities.Endereco
+	AcaoEndereco    ImportAction
+	Ponto           entities.Ponto
+	AcaoPonto       ImportAction
+	Contato         *entities.Contato
+	AcaoContato     ImportAction
+	Contrato        *entities.Contrato
+	ContratoEventos []entities.ContratoEvento
+	ContratoVersao  *entities.ContratoVersao
+	OrdemServico    *entities.OrdemServico
+}
+
+// ImportRowError representa o erro de uma linha rejeitada na importação.
+type ImportRowError struct {
+	Linha int    `json:"linha"`
+	Erro  string `json:"erro"`
+}
+
+// ImportResult representa o modelo usado para retornar o relatorio da importação em lote. Os registros
+// restaurados são contados como criados e, na prévia, os totais informam o que seria gravado.
+type ImportResult struct {
+	Previa           bool             `json:"previa"`
+	Linhas           int              `json:"linhas"`
+	Importadas       int              `json:"importadas"`
+	Rejeitadas       int              `json:"rejeitadas"`
+	ClientesCriados  int              `json:"clientes_criados"`
+	EnderecosCriados int              `json:"enderecos_criados"`
+	PontosCriados    int              `json:"pontos_criados"`
+	ContatosCriados  int              `json:"contatos_criados"`
+	ContratosCriados int              `json:"contratos_criados"`
+	Erros            []ImportRowError `json:"erros"`
+}
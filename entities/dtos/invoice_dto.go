@@ -0,0 +1,97 @@
+package dtos
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// CompetenciaLayout representa o formato das competencias do faturamento, como 2026-10.
+const CompetenciaLayout = "2006-01"
+
+// DateLayout representa o formato das datas do faturamento, como 2026-10-10.
+const DateLayout = "2006-01-02"
+
+// InvoiceLine representa um periodo de um contrato cobrado na fatura, com os dias no mesmo estado e plano.
+type InvoiceLine struct {
+	ContratoID  string                 `json:"contrato_id"`
+	PontoID     string                 `json:"ponto_id"`
+	PlanoID     string                 `json:"plano_id"`
+	PlanoNome   string                 `json:"plano_nome"`
+	Estado      entities.ContractState `json:"estado"`
+	Inicio      string                 `json:"inicio"`
+	Fim         string                 `json:"fim"`
+	Dias        int                    `json:"dias"`
+	DiasNoMes   int                    `json:"dias_no_mes"`
+	PrecoMensal int64                  `json:"preco_mensal"`
+	Percentual  float64                `json:"percentual"`
+	Valor       int64                  `json:"valor"`
+}
+
+// InvoiceResponse representa o modelo usado para retornar as faturas, com os valores em centavos.
+type InvoiceResponse struct {
+	ID          string                 `json:"id"`
+	ClienteID   string                 `json:"cliente_id"`
+	Competencia string                 `json:"competencia"`
+	DataEmissao time.Time              `json:"data_emissao"`
+	Vencimento  string                 `json:"vencimento"`
+	Estado      entities.InvoiceStatus `json:"estado"`
+	Total       int64                  `json:"total"`
+	ValorPago   int64                  `json:"valor_pago"`
+	Itens       []InvoiceLine          `json:"itens"`
+}
+
+// BillingRunResponse representa o resultado da execução do faturamento de uma competencia.
+type BillingRunResponse struct {
+	Competencia string            `json:"competencia"`
+	Geradas     int               `json:"geradas"`
+	Existentes  int               `json:"existentes"`
+	Faturas     []InvoiceResponse `json:"faturas"`
+}
+
+// CreateInvoiceResponse cria a resposta modelada das faturas.
+func CreateInvoiceResponse(invoice entities.Fatura) InvoiceResponse {
+	invoiceResponse := InvoiceResponse{
+		ID:          invoice.ID,
+		ClienteID:   invoice.ClienteID,
+		Competencia: invoice.Competencia,
+		DataEmissao: invoice.DataCriacao,
+		Vencimento:  invoice.Vencimento.Format(DateLayout),
+		Estado:      invoice.Estado,
+		Total:       invoice.Total,
+		ValorPago:   invoice.ValorPago,
+		Itens:       []InvoiceLine{},
+	}
+
+	json.Unmarshal([]byte(invoice.Detalhes), &invoiceResponse.Itens)
+
+	return invoiceResponse
+}
+
+// InvoiceState retorna o estado da fatura pelo valor pago e pelo vencimento, no momento informado.
+func InvoiceState(invoice entities.Fatura, now time.Time) entities.InvoiceStatus {
+	switch {
+	case invoice.ValorPago >= invoice.Total:
+		return entities.PAGA
+	case !now.Before(overdueFrom(invoice, now.Location())):
+		return entities.VENCIDA
+	case invoice.ValorPago > 0:
+		return entities.PARCIAL
+	default:
+		return entities.ABERTA
+	}
+}
+
+// IsDelinquent verifica se a fatura continua sem pagamento após os dias de carencia do vencimento.
+func IsDelinquent(invoice entities.Fatura, now time.Time, graceDays int) bool {
+	return invoice.ValorPago < invoice.Total && !now.Before(overdueFrom(invoice, now.Location()).AddDate(0, 0, graceDays))
+}
+
+// overdueFrom retorna o inicio do dia seguinte ao vencimento, a partir do qual a fatura está vencida. O dia é
+// lido sem o fuso, pois as datas do tipo date retornam do banco em UTC.
+func overdueFrom(invoice entities.Fatura, location *time.Location) time.Time {
+	year, month, day := invoice.Vencimento.Date()
+
+	return time.Date(year, month, day+1, 0, 0, 0, 0, location)
+}
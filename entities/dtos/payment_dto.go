@@ -0,0 +1,51 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// PaymentCreateDTO representa o modelo usado para registrar os pagamentos das faturas, com o valor em centavos.
+type PaymentCreateDTO struct {
+	FaturaID      string     `json:"-" form:"-"`
+	Valor         int64      `json:"valor" form:"valor" binding:"required,min=1"`
+	DataPagamento *time.Time `json:"data_pagamento" form:"data_pagamento"`
+	Identificador string     `json:"identificador" form:"identificador" binding:"max=20"`
+}
+
+// PaymentResponse representa o modelo usado para retornar os pagamentos.
+type PaymentResponse struct {
+	ID            string                 `json:"id"`
+	FaturaID      string                 `json:"fatura_id"`
+	Valor         int64                  `json:"valor"`
+	DataPagamento time.Time              `json:"data_pagamento"`
+	Origem        entities.PaymentOrigin `json:"origem"`
+	Identificador string                 `json:"identificador,omitempty"`
+}
+
+// PaymentImportResult representa o modelo usado para retornar o resultado da importação do arquivo de retorno.
+type PaymentImportResult struct {
+	Importados int      `json:"importados"`
+	Ignorados  int      `json:"ignorados"`
+	Erros      []string `json:"erros"`
+}
+
+// DelinquencyResult representa o resultado da rotina de suspensão dos contratos por inadimplencia.
+type DelinquencyResult struct {
+	FaturasVencidas    int      `json:"faturas_vencidas"`
+	ContratosSuspensos []string `json:"contratos_suspensos"`
+	ContratosIsentos   []string `json:"contratos_isentos"`
+}
+
+// CreatePaymentResponse cria a resposta modelada dos pagamentos.
+func CreatePaymentResponse(payment entities.Pagamento) PaymentResponse {
+	return PaymentResponse{
+		ID:            payment.ID,
+		FaturaID:      payment.FaturaID,
+		Valor:         payment.Valor,
+		DataPagamento: payment.DataPagamento,
+		Origem:        payment.Origem,
+		Identificador: payment.Identificador,
+	}
+}
@@ -0,0 +1,56 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// PlanCreateDTO representa o modelo usado para cadastrar planos. A velocidade é em Mbps, e o preço e a multa
+// de fidelidade, cobrada integralmente no inicio da fidelidade, em centavos.
+type PlanCreateDTO struct {
+	Nome            string `json:"nome" form:"nome" binding:"required,min=3,max=128"`
+	Velocidade      int    `json:"velocidade" form:"velocidade" binding:"required,min=1"`
+	PrecoMensal     int64  `json:"preco_mensal" form:"preco_mensal" binding:"min=0"`
+	MesesFidelidade int    `json:"meses_fidelidade" form:"meses_fidelidade" binding:"min=0"`
+	MultaFidelidade int64  `json:"multa_fidelidade" form:"multa_fidelidade" binding:"min=0"`
+}
+
+// PlanUpdateDTO representa o modelo usado para atualizar planos.
+type PlanUpdateDTO struct {
+	Base
+	Nome            string `json:"nome" form:"nome"`
+	Velocidade      *int   `json:"velocidade" form:"velocidade"`
+	PrecoMensal     *int64 `json:"preco_mensal" form:"preco_mensal"`
+	MesesFidelidade *int   `json:"meses_fidelidade" form:"meses_fidelidade"`
+	MultaFidelidade *int64 `json:"multa_fidelidade" form:"multa_fidelidade"`
+}
+
+// ContractPlanChangeDTO representa o modelo usado para trocar o plano de um contrato.
+type ContractPlanChangeDTO struct {
+	Base
+	PlanoID     string     `json:"plano_id" form:"plano_id" binding:"required"`
+	DataEfetiva *time.Time `json:"data_efetiva" form:"data_efetiva"`
+}
+
+// PlanResponse representa o modelo usado para retornar os planos.
+type PlanResponse struct {
+	ID              string `json:"id"`
+	Nome            string `json:"nome"`
+	Velocidade      int    `json:"velocidade"`
+	PrecoMensal     int64  `json:"preco_mensal"`
+	MesesFidelidade int    `json:"meses_fidelidade"`
+	MultaFidelidade int64  `json:"multa_fidelidade"`
+}
+
+// CreatePlanResponse cria a resposta modelada dos planos.
+func CreatePlanResponse(plan entities.Plano) PlanResponse {
+	return PlanResponse{
+		ID:              plan.ID,
+		Nome:            plan.Nome,
+		Velocidade:      plan.Velocidade,
+		PrecoMensal:     plan.PrecoMensal,
+		MesesFidelidade: plan.MesesFidelidade,
+		MultaFidelidade: plan.MultaFidelidade,
+	}
+}
@@ -1,6 +1,10 @@
 package dtos
 
-import "github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+import (
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
 
 // PointCreateDTO representa o modelo usado para cadastrar pontos.
 type PointCreateDTO struct {
@@ -15,29 +19,72 @@ type PointUpdateDTO struct {
 	EnderecoID string `json:"endereco_id" form:"endereco_id"`
 }
 
+// PointTransferDTO representa o modelo usado para transferir o ponto para outro cliente. ContatoResponsavelID é o
+// contato do novo cliente que passa a ser o responsavel pelo contrato do ponto, e sem ele o contrato fica sem
+// contato responsavel.
+type PointTransferDTO struct {
+	Base
+	ClienteID            string `json:"cliente_id" form:"cliente_id" binding:"required"`
+	ContatoResponsavelID string `json:"contato_responsavel_id" form:"contato_responsavel_id"`
+}
+
 // PointResponse representa o modelo usado para retornar a resposta da pesquisa dos pontos.
 type PointResponse struct {
-	ID                 string              `json:"id"`
-	ClienteID          string              `json:"cliente_id"`
-	ClienteNome        string              `json:"cliente_nome"`
-	ClienteTipo        entities.ClientType `json:"cliente_tipo"`
-	EnderecoID         string              `json:"endereco_id"`
-	EnderecoLogradouro string              `json:"endereco_logradouro"`
-	EnderecoBairro     string              `json:"endereco_bairro"`
-	EnderecoNumero     int                 `json:"endereco_numero"`
+	ID                  string              `json:"id"`
+	ClienteID           string              `json:"cliente_id"`
+	ClienteNome         string              `json:"cliente_nome"`
+	ClienteTipo         entities.ClientType `json:"cliente_tipo"`
+	EnderecoID          string              `json:"endereco_id"`
+	EnderecoCep         string              `json:"endereco_cep"`
+	EnderecoCidade      string              `json:"endereco_cidade"`
+	EnderecoUf          string              `json:"endereco_uf"`
+	EnderecoLogradouro  string              `json:"endereco_logradouro"`
+	EnderecoBairro      string              `json:"endereco_bairro"`
+	EnderecoNumero      int                 `json:"endereco_numero"`
+	EnderecoComplemento string              `json:"endereco_complemento"`
+	EnderecoLatitude    *float64            `json:"endereco_latitude"`
+	EnderecoLongitude   *float64            `json:"endereco_longitude"`
+	ContratoAtual       *PointContract      `json:"contrato_atual,omitempty"`
+}
+
+// PointContract representa o resumo do contrato atual retornado na pesquisa dos pontos.
+type PointContract struct {
+	ID             string                 `json:"id"`
+	Estado         entities.ContractState `json:"estado"`
+	PlanoID        string                 `json:"plano_id"`
+	DataAssinatura *time.Time             `json:"data_assinatura"`
+	DataAtivacao   *time.Time             `json:"data_ativacao"`
+	DataTermino    *time.Time             `json:"data_termino"`
 }
 
 // CreatePointResponse cria a responsta modelada para a pesquisa de pontos.
 func CreatePointResponse(point entities.Ponto) PointResponse {
 	pointResponse := PointResponse{
-		ID:                 point.ID,
-		ClienteID:          point.ClienteID,
-		ClienteNome:        point.Cliente.Nome,
-		ClienteTipo:        point.Cliente.Tipo,
-		EnderecoID:         point.EnderecoID,
-		EnderecoLogradouro: point.Endereco.Logradouro,
-		EnderecoBairro:     point.Endereco.Bairro,
-		EnderecoNumero:     point.Endereco.Numero}
+		ID:                  point.ID,
+		ClienteID:           point.ClienteID,
+		ClienteNome:         point.Cliente.Nome,
+		ClienteTipo:         point.Cliente.Tipo,
+		EnderecoID:          point.EnderecoID,
+		EnderecoCep:         point.Endereco.Cep,
+		EnderecoCidade:      point.Endereco.Cidade,
+		EnderecoUf:          point.Endereco.Uf,
+		EnderecoLogradouro:  point.Endereco.Logradouro,
+		EnderecoBairro:      point.Endereco.Bairro,
+		EnderecoNumero:      point.Endereco.Numero,
+		EnderecoComplemento: point.Endereco.Complemento,
+		EnderecoLatitude:    point.Endereco.Latitude,
+		EnderecoLongitude:   point.Endereco.Longitude}
+
+	if point.ContratoAtual != nil {
+		pointResponse.ContratoAtual = &PointContract{
+			ID:             point.ContratoAtual.ID,
+			Estado:         point.ContratoAtual.Estado,
+			PlanoID:        point.ContratoAtual.PlanoID,
+			DataAssinatura: point.ContratoAtual.DataAssinatura,
+			DataAtivacao:   point.ContratoAtual.DataAtivacao,
+			DataTermino:    ContractTermination(*point.ContratoAtual),
+		}
+	}
 
 	return pointResponse
 }
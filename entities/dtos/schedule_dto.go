@@ -0,0 +1,91 @@
+package dtos
+
+import (
+	"math"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// TechnicianCreateDTO representa o modelo usado para cadastrar os tecnicos com os bairros atendidos.
+// O expediente padrão é das 8 às 18 horas.
+type TechnicianCreateDTO struct {
+	Nome             string   `json:"nome" form:"nome" binding:"required,max=128"`
+	Bairros          []string `json:"bairros" form:"bairros" binding:"required,min=1,dive,required,max=128"`
+	InicioExpediente *int     `json:"inicio_expediente" form:"inicio_expediente" binding:"omitempty,min=0,max=23"`
+	FimExpediente    *int     `json:"fim_expediente" form:"fim_expediente" binding:"omitempty,min=1,max=24"`
+}
+
+// TechnicianResponse representa o modelo usado para retornar os tecnicos.
+type TechnicianResponse struct {
+	ID               string   `json:"id"`
+	Nome             string   `json:"nome"`
+	Bairros          []string `json:"bairros"`
+	InicioExpediente int      `json:"inicio_expediente"`
+	FimExpediente    int      `json:"fim_expediente"`
+}
+
+// ScheduleSlot representa um horario da agenda do tecnico, ocupado quando há uma ordem de serviço na janela.
+type ScheduleSlot struct {
+	Inicio         time.Time `json:"inicio"`
+	Fim            time.Time `json:"fim"`
+	Livre          bool      `json:"livre"`
+	OrdemServicoID string    `json:"ordem_servico_id,omitempty"`
+}
+
+// AgendaResponse representa o modelo usado para retornar a agenda e a carga do dia do tecnico.
+type AgendaResponse struct {
+	Tecnico    string         `json:"tecnico"`
+	Data       string         `json:"data"`
+	Capacidade int            `json:"capacidade"`
+	Ocupados   int            `json:"ocupados"`
+	Carga      float64        `json:"carga"`
+	Horarios   []ScheduleSlot `json:"horarios"`
+}
+
+// SlotSuggestionResponse representa o modelo usado para retornar os horarios livres sugeridos para o ponto.
+type SlotSuggestionResponse struct {
+	Tecnico string    `json:"tecnico"`
+	Inicio  time.Time `json:"inicio"`
+	Fim     time.Time `json:"fim"`
+}
+
+// CreateTechnicianResponse cria a responsta modelada para a pesquisa dos tecnicos.
+func CreateTechnicianResponse(technician entities.Tecnico) TechnicianResponse {
+	technicianResponse := TechnicianResponse{
+		ID:               technician.ID,
+		Nome:             technician.Nome,
+		Bairros:          []string{},
+		InicioExpediente: technician.InicioExpediente,
+		FimExpediente:    technician.FimExpediente,
+	}
+
+	for _, neighborhood := range technician.Bairros {
+		technicianResponse.Bairros = append(technicianResponse.Bairros, neighborhood.Bairro)
+	}
+
+	return technicianResponse
+}
+
+// CreateAgendaResponse cria a responsta modelada para a agenda do dia do tecnico, com a carga de 0 a 1.
+func CreateAgendaResponse(technician entities.Tecnico, date time.Time, slots []ScheduleSlot) AgendaResponse {
+	agendaResponse := AgendaResponse{
+		Tecnico:    technician.Nome,
+		Data:       date.Format(DateLayout),
+		Capacidade: len(slots),
+		Horarios:   slots,
+	}
+
+	for _, slot := range slots {
+		if !slot.Livre {
+			agendaResponse.Ocupados++
+		}
+	}
+
+	if agendaResponse.Capacidade > 0 {
+		load := float64(agendaResponse.Ocupados) / float64(agendaResponse.Capacidade)
+		agendaResponse.Carga = math.Round(load*100) / 100
+	}
+
+	return agendaResponse
+}
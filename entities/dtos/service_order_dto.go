@@ -0,0 +1,104 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// ServiceOrderCreateDTO representa o modelo usado para abrir ordens de serviço.
+// Com a janela e o tecnico informados, a ordem já é aberta agendada.
+type ServiceOrderCreateDTO struct {
+	Tipo         entities.ServiceOrderType `json:"tipo" form:"tipo" binding:"required,eq=instalacao|eq=reparo|eq=retirada"`
+	PontoID      string                    `json:"ponto_id" form:"ponto_id" binding:"required"`
+	ContratoID   string                    `json:"contrato_id" form:"contrato_id"`
+	Descricao    string                    `json:"descricao" form:"descricao" binding:"max=512"`
+	InicioJanela *time.Time                `json:"inicio_janela" form:"inicio_janela"`
+	FimJanela    *time.Time                `json:"fim_janela" form:"fim_janela"`
+	Tecnico      string                    `json:"tecnico" form:"tecnico" binding:"max=128"`
+}
+
+// ServiceOrderScheduleDTO representa o modelo usado para agendar, ou reagendar, as ordens de serviço.
+type ServiceOrderScheduleDTO struct {
+	Base
+	InicioJanela time.Time `json:"inicio_janela" form:"inicio_janela" binding:"required"`
+	FimJanela    time.Time `json:"fim_janela" form:"fim_janela" binding:"required"`
+	Tecnico      string    `json:"tecnico" form:"tecnico" binding:"required,max=128"`
+}
+
+// ServiceOrderStatusDTO representa o modelo usado para iniciar a execução ou cancelar as ordens de serviço.
+type ServiceOrderStatusDTO struct {
+	Base
+	Estado entities.ServiceOrderStatus `json:"estado" form:"estado" binding:"required,eq=em_execucao|eq=cancelada"`
+}
+
+// ServiceOrderCompleteDTO representa o modelo usado para concluir as ordens de serviço com o relatorio do tecnico.
+type ServiceOrderCompleteDTO struct {
+	Base
+	Relatorio string `json:"relatorio" form:"relatorio" binding:"required,max=4096"`
+}
+
+// ServiceOrderFilter representa os filtros da pesquisa das ordens de serviço.
+// De e Ate selecionam as ordens cuja janela se sobrepõe ao periodo.
+type ServiceOrderFilter struct {
+	PontoID    string
+	ContratoID string
+	Tecnico    string
+	Tipo       entities.ServiceOrderType
+	Estado     entities.ServiceOrderStatus
+	De         *time.Time
+	Ate        *time.Time
+}
+
+// ServiceOrderResponse representa o modelo usado para retornar as ordens de serviço.
+type ServiceOrderResponse struct {
+	ID            string                      `json:"id"`
+	Tipo          entities.ServiceOrderType   `json:"tipo"`
+	Estado        entities.ServiceOrderStatus `json:"estado"`
+	PontoID       string                      `json:"ponto_id"`
+	ContratoID    string                      `json:"contrato_id,omitempty"`
+	Descricao     string                      `json:"descricao"`
+	InicioJanela  *time.Time                  `json:"inicio_janela"`
+	FimJanela     *time.Time                  `json:"fim_janela"`
+	Tecnico       string                      `json:"tecnico"`
+	Relatorio     string                      `json:"relatorio,omitempty"`
+	DataAbertura  time.Time                   `json:"data_abertura"`
+	DataConclusao *time.Time                  `json:"data_conclusao,omitempty"`
+}
+
+// IsServiceOrderChangeAuthorized verifica se a alteração de estado da ordem de serviço é valida. As ordens
+// concluidas e canceladas são finais, e apenas as ordens agendadas podem entrar em execução ou ser concluidas.
+func IsServiceOrderChangeAuthorized(oldStatus entities.ServiceOrderStatus, newStatus entities.ServiceOrderStatus) bool {
+	switch {
+	case oldStatus == entities.ORDEM_CONCLUIDA || oldStatus == entities.ORDEM_CANCELADA:
+		return false
+	case newStatus == entities.ORDEM_EM_EXECUCAO:
+		return oldStatus == entities.ORDEM_AGENDADA
+	case newStatus == entities.ORDEM_CONCLUIDA:
+		return oldStatus == entities.ORDEM_AGENDADA || oldStatus == entities.ORDEM_EM_EXECUCAO
+	case newStatus == entities.ORDEM_AGENDADA:
+		return oldStatus == entities.ORDEM_ABERTA || oldStatus == entities.ORDEM_AGENDADA
+	default:
+		return newStatus == entities.ORDEM_CANCELADA
+	}
+}
+
+// CreateServiceOrderResponse cria a responsta modelada para a pesquisa das ordens de serviço.
+func CreateServiceOrderResponse(serviceOrder entities.OrdemServico) ServiceOrderResponse {
+	serviceOrderResponse := ServiceOrderResponse{
+		ID:            serviceOrder.ID,
+		Tipo:          serviceOrder.Tipo,
+		Estado:        serviceOrder.Estado,
+		PontoID:       serviceOrder.PontoID,
+		ContratoID:    serviceOrder.ContratoID,
+		Descricao:     serviceOrder.Descricao,
+		InicioJanela:  serviceOrder.InicioJanela,
+		FimJanela:     serviceOrder.FimJanela,
+		Tecnico:       serviceOrder.Tecnico,
+		Relatorio:     serviceOrder.Relatorio,
+		DataAbertura:  serviceOrder.DataCriacao,
+		DataConclusao: serviceOrder.DataConclusao,
+	}
+
+	return serviceOrderResponse
+}
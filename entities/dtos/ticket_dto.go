@@ -0,0 +1,129 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+)
+
+// TicketCreateDTO representa o modelo usado para abrir os chamados do cliente.
+// PontoID e ContratoID são opcionais e devem pertencer ao cliente.
+type TicketCreateDTO struct {
+	ClienteID  string                  `json:"cliente_id" form:"cliente_id" binding:"required"`
+	PontoID    string                  `json:"ponto_id" form:"ponto_id"`
+	ContratoID string                  `json:"contrato_id" form:"contrato_id"`
+	Categoria  entities.TicketCategory `json:"categoria" form:"categoria" binding:"required,eq=reclamacao|eq=solicitacao|eq=suporte_tecnico|eq=financeiro"`
+	Prioridade entities.TicketPriority `json:"prioridade" form:"prioridade" binding:"required,eq=baixa|eq=media|eq=alta|eq=critica"`
+	Assunto    string                  `json:"assunto" form:"assunto" binding:"required,max=256"`
+	Descricao  string                  `json:"descricao" form:"descricao"`
+}
+
+// TicketStatusDTO representa o modelo usado para alterar o estado dos chamados.
+type TicketStatusDTO struct {
+	Base
+	Estado entities.TicketStatus `json:"estado" form:"estado" binding:"required,eq=aberto|eq=em_atendimento|eq=aguardando_cliente|eq=resolvido|eq=fechado"`
+}
+
+// TicketCommentDTO representa o modelo usado para adicionar os comentarios dos chamados.
+type TicketCommentDTO struct {
+	Base
+	Autor    string `json:"autor" form:"autor" binding:"required,max=128"`
+	Mensagem string `json:"mensagem" form:"mensagem" binding:"required"`
+}
+
+// TicketFilter representa os filtros da pesquisa dos chamados. Abertos retorna apenas os chamados não
+// resolvidos e Atrasados os chamados não resolvidos com o prazo do SLA vencido.
+type TicketFilter struct {
+	ClienteID  string
+	Estado     entities.TicketStatus
+	Prioridade entities.TicketPriority
+	Categoria  entities.TicketCategory
+	Abertos    bool
+	Atrasados  bool
+}
+
+// TicketCommentResponse representa o modelo usado para retornar os comentarios dos chamados.
+type TicketCommentResponse struct {
+	ID          string    `json:"id"`
+	DataCriacao time.Time `json:"data_criacao"`
+	Autor       string    `json:"autor"`
+	Mensagem    string    `json:"mensagem"`
+}
+
+// TicketResponse representa o modelo usado para retornar os chamados.
+type TicketResponse struct {
+	ID            string                  `json:"id"`
+	ClienteID     string                  `json:"cliente_id"`
+	PontoID       string                  `json:"ponto_id,omitempty"`
+	ContratoID    string                  `json:"contrato_id,omitempty"`
+	Categoria     entities.TicketCategory `json:"categoria"`
+	Prioridade    entities.TicketPriority `json:"prioridade"`
+	Estado        entities.TicketStatus   `json:"estado"`
+	Assunto       string                  `json:"assunto"`
+	Descricao     string                  `json:"descricao,omitempty"`
+	DataAbertura  time.Time               `json:"data_abertura"`
+	PrazoSLA      time.Time               `json:"prazo_sla"`
+	DataResolucao *time.Time              `json:"data_resolucao,omitempty"`
+	Atrasado      bool                    `json:"atrasado"`
+	Comentarios   []TicketCommentResponse `json:"comentarios,omitempty"`
+}
+
+// ClientDetailResponse representa o modelo usado para retornar o cliente com os chamados em aberto.
+type ClientDetailResponse struct {
+	entities.Cliente
+	ChamadosAbertos []TicketResponse `json:"chamados_abertos"`
+}
+
+// IsTicketClosed verifica se o chamado já foi resolvido ou fechado.
+func IsTicketClosed(status entities.TicketStatus) bool {
+	return status == entities.CHAMADO_RESOLVIDO || status == entities.CHAMADO_FECHADO
+}
+
+// IsTicketOverdue verifica se o chamado ainda não resolvido passou do prazo do SLA.
+func IsTicketOverdue(ticket entities.Chamado, now time.Time) bool {
+	return !IsTicketClosed(ticket.Estado) && now.After(ticket.PrazoSLA)
+}
+
+// CreateTicketResponse cria a responsta modelada para a pesquisa dos chamados.
+func CreateTicketResponse(ticket entities.Chamado) TicketResponse {
+	ticketResponse := TicketResponse{
+		ID:            ticket.ID,
+		ClienteID:     ticket.ClienteID,
+		PontoID:       ticket.PontoID,
+		ContratoID:    ticket.ContratoID,
+		Categoria:     ticket.Categoria,
+		Prioridade:    ticket.Prioridade,
+		Estado:        ticket.Estado,
+		Assunto:       ticket.Assunto,
+		Descricao:     ticket.Descricao,
+		DataAbertura:  ticket.DataCriacao,
+		PrazoSLA:      ticket.PrazoSLA,
+		DataResolucao: ticket.DataResolucao,
+		Atrasado:      IsTicketOverdue(ticket, time.Now()),
+	}
+
+	for _, comment := range ticket.Comentarios {
+		ticketResponse.Comentarios = append(ticketResponse.Comentarios, TicketCommentResponse{
+			ID:          comment.ID,
+			DataCriacao: comment.DataCriacao,
+			Autor:       comment.Autor,
+			Mensagem:    comment.Mensagem,
+		})
+	}
+
+	return ticketResponse
+}
+
+// CreateClientDetailResponse cria a responsta modelada para a pesquisa do cliente pelo id.
+func CreateClientDetailResponse(client entities.Cliente, openTickets []entities.Chamado) ClientDetailResponse {
+	clientDetailResponse := ClientDetailResponse{
+		Cliente:         client,
+		ChamadosAbertos: []TicketResponse{},
+	}
+
+	for _, ticket := range openTickets {
+		clientDetailResponse.ChamadosAbertos = append(clientDetailResponse.ChamadosAbertos, CreateTicketResponse(ticket))
+	}
+
+	return clientDetailResponse
+}
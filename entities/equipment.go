@@ -0,0 +1,44 @@
+package entities
+
+// EquipmentStatus representa o type EquipmentStatus.
+type EquipmentStatus string
+
+// Constantes que representam os estados do equipamento.
+const (
+	ESTOQUE    EquipmentStatus = "estoque"
+	INSTALADO  EquipmentStatus = "instalado"
+	DEFEITO    EquipmentStatus = "defeito"
+	EXTRAVIADO EquipmentStatus = "extraviado"
+)
+
+// Constantes que representam os motivos das movimentações feitas pelas rotinas da API.
+const (
+	EQUIPAMENTO_CADASTRADO = "cadastro"
+	CONTRATO_CANCELADO     = "contrato_cancelado"
+	PONTO_REMOVIDO         = "ponto_removido"
+)
+
+// Equipamento representa a tabela t_equipamento no banco de dados, com os equipamentos emprestados aos clientes.
+type Equipamento struct {
+	Base
+	Serial            string          `json:"serial" gorm:"type:varchar(64);unique;not null"`
+	Modelo            string          `json:"modelo" gorm:"type:varchar(128);not null"`
+	Mac               string          `json:"mac" gorm:"type:varchar(17);not null;default:'';index"`
+	Estado            EquipmentStatus `json:"estado" gorm:"type:text;not null;default:'estoque';index"`
+	PontoID           string          `json:"ponto_id" gorm:"type:text;not null;default:'';index"`
+	DevolucaoPendente bool            `json:"devolucao_pendente" gorm:"not null;default:false;index"`
+	Ponto             Ponto           `json:"-" gorm:"foreignKey:PontoID;-:migration"`
+}
+
+// EquipamentoMovimentacao representa a tabela t_equipamento_movimentacao no banco de dados, com o historico
+// dos estados e dos pontos do equipamento.
+type EquipamentoMovimentacao struct {
+	Base
+	EquipamentoID    string          `json:"equipamento_id" gorm:"type:uuid;not null;index"`
+	EstadoAnterior   EquipmentStatus `json:"estado_anterior" gorm:"type:text;not null;default:''"`
+	EstadoPosterior  EquipmentStatus `json:"estado_posterior" gorm:"type:text;not null"`
+	PontoAnteriorID  string          `json:"ponto_anterior_id" gorm:"type:text;not null;default:''"`
+	PontoPosteriorID string          `json:"ponto_posterior_id" gorm:"type:text;not null;default:''"`
+	Motivo           string          `json:"motivo" gorm:"type:text;not null;default:''"`
+	Equipamento      Equipamento     `json:"-" gorm:"foreignKey:EquipamentoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
@@ -0,0 +1,27 @@
+package entities
+
+import "time"
+
+// InvoiceStatus representa o type InvoiceStatus.
+type InvoiceStatus string
+
+// Constantes que representam os estados da fatura.
+const (
+	ABERTA  InvoiceStatus = "aberta"
+	PAGA    InvoiceStatus = "paga"
+	VENCIDA InvoiceStatus = "vencida"
+	PARCIAL InvoiceStatus = "parcial"
+)
+
+// Fatura representa a tabela t_fatura no banco de dados, com uma fatura por cliente em cada competencia.
+type Fatura struct {
+	Base
+	ClienteID   string        `json:"cliente_id" gorm:"type:uuid;not null;uniqueIndex:idx_fatura_cliente_competencia"`
+	Competencia string        `json:"competencia" gorm:"type:varchar(7);not null;uniqueIndex:idx_fatura_cliente_competencia"`
+	Total       int64         `json:"total" gorm:"not null"`
+	ValorPago   int64         `json:"valor_pago" gorm:"not null;default:0"`
+	Estado      InvoiceStatus `json:"estado" gorm:"type:text;not null;default:'aberta';index"`
+	Vencimento  time.Time     `json:"vencimento" gorm:"type:date;not null;default:CURRENT_DATE"`
+	Detalhes    string        `json:"detalhes" gorm:"type:text;not null"`
+	Cliente     Cliente       `json:"-" gorm:"foreignKey:ClienteID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
@@ -0,0 +1,23 @@
+package entities
+
+import "time"
+
+// PaymentOrigin representa o type PaymentOrigin.
+type PaymentOrigin string
+
+// Constantes que representam a origem do pagamento.
+const (
+	MANUAL  PaymentOrigin = "manual"
+	RETORNO PaymentOrigin = "retorno"
+)
+
+// Pagamento representa a tabela t_pagamento no banco de dados.
+type Pagamento struct {
+	Base
+	FaturaID      string        `json:"fatura_id" gorm:"type:uuid;not null;index"`
+	Valor         int64         `json:"valor" gorm:"not null"`
+	DataPagamento time.Time     `json:"data_pagamento" gorm:"not null"`
+	Origem        PaymentOrigin `json:"origem" gorm:"type:text;not null"`
+	Identificador string        `json:"identificador" gorm:"type:text;not null;default:'';uniqueIndex:idx_pagamento_identificador,where:identificador <> ''"`
+	Fatura        Fatura        `json:"-" gorm:"foreignKey:FaturaID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
@@ -0,0 +1,14 @@
+package entities
+
+import "gorm.io/gorm"
+
+// Plano representa a tabela t_plano no banco de dados.
+type Plano struct {
+	Base
+	Nome            string         `json:"nome" gorm:"type:text;size:128;not null;uniqueIndex"`
+	Velocidade      int            `json:"velocidade" gorm:"not null"`
+	PrecoMensal     int64          `json:"preco_mensal" gorm:"not null"`
+	MesesFidelidade int            `json:"meses_fidelidade" gorm:"not null;default:0"`
+	MultaFidelidade int64          `json:"multa_fidelidade" gorm:"not null;default:0"`
+	DataRemocao     gorm.DeletedAt `json:"-" gorm:"index"`
+}
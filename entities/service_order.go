@@ -0,0 +1,41 @@
+package entities
+
+import "time"
+
+// ServiceOrderType representa o type ServiceOrderType.
+type ServiceOrderType string
+
+// Constantes que representam os tipos de ordem de serviço.
+const (
+	INSTALACAO ServiceOrderType = "instalacao"
+	REPARO     ServiceOrderType = "reparo"
+	RETIRADA   ServiceOrderType = "retirada"
+)
+
+// ServiceOrderStatus representa o type ServiceOrderStatus.
+type ServiceOrderStatus string
+
+// Constantes que representam os estados da ordem de serviço.
+const (
+	ORDEM_ABERTA      ServiceOrderStatus = "aberta"
+	ORDEM_AGENDADA    ServiceOrderStatus = "agendada"
+	ORDEM_EM_EXECUCAO ServiceOrderStatus = "em_execucao"
+	ORDEM_CONCLUIDA   ServiceOrderStatus = "concluida"
+	ORDEM_CANCELADA   ServiceOrderStatus = "cancelada"
+)
+
+// OrdemServico representa a tabela t_ordem_servico no banco de dados, com o trabalho fisico no ponto.
+type OrdemServico struct {
+	Base
+	Tipo          ServiceOrderType   `json:"tipo" gorm:"type:text;not null"`
+	Estado        ServiceOrderStatus `json:"estado" gorm:"type:text;not null;default:'aberta';index"`
+	PontoID       string             `json:"ponto_id" gorm:"type:uuid;not null;index"`
+	ContratoID    string             `json:"contrato_id" gorm:"type:text;not null;default:'';index"`
+	Descricao     string             `json:"descricao" gorm:"type:text;not null;default:''"`
+	InicioJanela  *time.Time         `json:"inicio_janela"`
+	FimJanela     *time.Time         `json:"fim_janela"`
+	Tecnico       string             `json:"tecnico" gorm:"type:text;not null;default:'';index"`
+	Relatorio     string             `json:"relatorio" gorm:"type:text;not null;default:''"`
+	DataConclusao *time.Time         `json:"data_conclusao"`
+	Ponto         Ponto              `json:"-" gorm:"foreignKey:PontoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
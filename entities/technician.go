@@ -0,0 +1,19 @@
+package entities
+
+// Tecnico representa a tabela t_tecnico no banco de dados, com o expediente usado na agenda das ordens de serviço.
+type Tecnico struct {
+	Base
+	Nome             string          `json:"nome" gorm:"type:text;size:128;unique;not null"`
+	InicioExpediente int             `json:"inicio_expediente" gorm:"not null;default:8"`
+	FimExpediente    int             `json:"fim_expediente" gorm:"not null;default:18"`
+	Bairros          []TecnicoBairro `json:"bairros" gorm:"foreignKey:TecnicoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// TecnicoBairro representa a tabela t_tecnico_bairro no banco de dados, com os bairros atendidos pelo tecnico.
+// BairroNormalizado é usado para encontrar os tecnicos do bairro do ponto.
+type TecnicoBairro struct {
+	Base
+	TecnicoID         string `json:"tecnico_id" gorm:"type:uuid;not null;uniqueIndex:idx_tecnico_bairro"`
+	Bairro            string `json:"bairro" gorm:"type:text;size:128;not null"`
+	BairroNormalizado string `json:"-" gorm:"type:text;not null;uniqueIndex:idx_tecnico_bairro;index"`
+}
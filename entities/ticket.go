@@ -0,0 +1,62 @@
+package entities
+
+import "time"
+
+// TicketCategory representa o type TicketCategory.
+type TicketCategory string
+
+// Constantes que representam as categorias dos chamados.
+const (
+	RECLAMACAO      TicketCategory = "reclamacao"
+	SOLICITACAO     TicketCategory = "solicitacao"
+	SUPORTE_TECNICO TicketCategory = "suporte_tecnico"
+	FINANCEIRO      TicketCategory = "financeiro"
+)
+
+// TicketPriority representa o type TicketPriority.
+type TicketPriority string
+
+// Constantes que representam as prioridades dos chamados.
+const (
+	PRIORIDADE_BAIXA   TicketPriority = "baixa"
+	PRIORIDADE_MEDIA   TicketPriority = "media"
+	PRIORIDADE_ALTA    TicketPriority = "alta"
+	PRIORIDADE_CRITICA TicketPriority = "critica"
+)
+
+// TicketStatus representa o type TicketStatus.
+type TicketStatus string
+
+// Constantes que representam os estados dos chamados.
+const (
+	CHAMADO_ABERTO             TicketStatus = "aberto"
+	CHAMADO_EM_ATENDIMENTO     TicketStatus = "em_atendimento"
+	CHAMADO_AGUARDANDO_CLIENTE TicketStatus = "aguardando_cliente"
+	CHAMADO_RESOLVIDO          TicketStatus = "resolvido"
+	CHAMADO_FECHADO            TicketStatus = "fechado"
+)
+
+// Chamado representa a tabela t_chamado no banco de dados, com as reclamações e solicitações dos clientes.
+type Chamado struct {
+	Base
+	ClienteID     string              `json:"cliente_id" gorm:"type:uuid;not null;index"`
+	PontoID       string              `json:"ponto_id" gorm:"type:text;not null;default:'';index"`
+	ContratoID    string              `json:"contrato_id" gorm:"type:text;not null;default:'';index"`
+	Categoria     TicketCategory      `json:"categoria" gorm:"type:text;not null"`
+	Prioridade    TicketPriority      `json:"prioridade" gorm:"type:text;not null"`
+	Estado        TicketStatus        `json:"estado" gorm:"type:text;not null;default:'aberto';index"`
+	Assunto       string              `json:"assunto" gorm:"type:text;size:256;not null"`
+	Descricao     string              `json:"descricao" gorm:"type:text;not null;default:''"`
+	PrazoSLA      time.Time           `json:"prazo_sla" gorm:"column:prazo_sla;not null;index"`
+	DataResolucao *time.Time          `json:"data_resolucao"`
+	Cliente       Cliente             `json:"-" gorm:"foreignKey:ClienteID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+	Comentarios   []ChamadoComentario `json:"comentarios" gorm:"foreignKey:ChamadoID;constraint:OnUpdate:CASCADE,OnDelete:CASCADE;"`
+}
+
+// ChamadoComentario representa a tabela t_chamado_comentario no banco de dados, com o historico de mensagens do chamado.
+type ChamadoComentario struct {
+	Base
+	ChamadoID string `json:"chamado_id" gorm:"type:uuid;not null;index"`
+	Autor     string `json:"autor" gorm:"type:text;size:128;not null"`
+	Mensagem  string `json:"mensagem" gorm:"type:text;not null"`
+}
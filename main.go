@@ -1,9 +1,15 @@
 package main
 
 import (
+	"flag"
+	"os"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/commands"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/database"
 	_ "github.com/ThiagoRDS-042/Recrutamento-API-GO/docs"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/server"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/telemetry"
 )
 
 func main() {
@@ -23,10 +29,57 @@ func main() {
 	// @host localhost:2222
 	// @BasePath /api/v1
 
+	importCEPs := flag.String("importar-ceps", "", "importa o arquivo CSV informado para o diretorio de CEPs e encerra")
+	runBilling := flag.String("faturar", "", "executa o faturamento da competencia informada, como 2026-10, e encerra")
+	suspendDelinquents := flag.Bool("suspender-inadimplentes", false, "executa a rotina diaria de inadimplencia e encerra")
+	applyPlanChanges := flag.Bool("aplicar-trocas-plano", false, "aplica as trocas de plano agendadas que passaram a valer e encerra")
+	importRecords := flag.String("importar", "", "importa o arquivo CSV informado de clientes, endereços, pontos e contratos e encerra")
+	importMapping := flag.String("mapeamento", "", "arquivo JSON com a coluna do CSV de cada campo da importação")
+	importDryRun := flag.Bool("previa", false, "apenas valida as linhas da importação, sem gravar os registros")
+	flag.Parse()
+
+	if *importCEPs != "" {
+		database.ConnectDB()
+		code := commands.ImportCEPs(*importCEPs, logger.New())
+		database.CloseDB()
+		os.Exit(code)
+	}
+
+	if *runBilling != "" {
+		database.ConnectDB()
+		code := commands.RunBilling(*runBilling, logger.New())
+		database.CloseDB()
+		os.Exit(code)
+	}
+
+	if *importRecords != "" {
+		database.ConnectDB()
+		code := commands.ImportRecords(*importRecords, *importMapping, *importDryRun, logger.New())
+		database.CloseDB()
+		os.Exit(code)
+	}
+
+	if *suspendDelinquents {
+		database.ConnectDB()
+		code := commands.SuspendDelinquentContracts(logger.New())
+		database.CloseDB()
+		os.Exit(code)
+	}
+
+	if *applyPlanChanges {
+		database.ConnectDB()
+		code := commands.ApplyScheduledPlanChanges(logger.New())
+		database.CloseDB()
+		os.Exit(code)
+	}
+
+	telemetry.StartTracer()
+	defer telemetry.ShutdownTracer()
+
 	database.ConnectDB()
 	defer database.CloseDB()
 
-	server := server.NewServer()
+	server := server.NewServer(logger.New())
 
 	server.Run()
 }
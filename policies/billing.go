@@ -0,0 +1,64 @@
+package policies
+
+import (
+	"log"
+	"os"
+	"strconv"
+
+	"github.com/joho/godotenv"
+)
+
+// BillingPolicy representa as regras do faturamento mensal e da cobrança.
+type BillingPolicy struct {
+	// TaxaSuspensao fração do preço cobrada nos dias em Desativado Temporario, 0 não cobra esses dias.
+	TaxaSuspensao float64
+	// DiaVencimento dia do mês seguinte à competencia em que a fatura vence.
+	DiaVencimento int
+	// DiasCarencia dias após o vencimento antes da suspensão dos contratos por inadimplencia.
+	DiasCarencia int
+}
+
+// DefaultBilling retorna a politica de faturamento usada quando as variaveis BILLING_* não estão definidas.
+func DefaultBilling() BillingPolicy {
+	return BillingPolicy{
+		DiaVencimento: 10,
+		DiasCarencia:  15,
+	}
+}
+
+// LoadBilling carrega a politica de faturamento, lendo a taxa dos dias suspensos de BILLING_SUSPENDED_RATE,
+// o dia de vencimento de BILLING_DUE_DAY e a carencia de BILLING_GRACE_DAYS.
+func LoadBilling() BillingPolicy {
+	godotenv.Load()
+
+	policy := DefaultBilling()
+
+	if rate := os.Getenv("BILLING_SUSPENDED_RATE"); rate != "" {
+		suspendedRate, err := strconv.ParseFloat(rate, 64)
+		if err != nil || suspendedRate < 0 || suspendedRate > 1 {
+			log.Fatalf("invalid BILLING_SUSPENDED_RATE, must be between 0 and 1: %v", rate)
+		}
+
+		policy.TaxaSuspensao = suspendedRate
+	}
+
+	if day := os.Getenv("BILLING_DUE_DAY"); day != "" {
+		dueDay, err := strconv.Atoi(day)
+		if err != nil || dueDay < 1 || dueDay > 28 {
+			log.Fatalf("invalid BILLING_DUE_DAY, must be between 1 and 28: %v", day)
+		}
+
+		policy.DiaVencimento = dueDay
+	}
+
+	if days := os.Getenv("BILLING_GRACE_DAYS"); days != "" {
+		graceDays, err := strconv.Atoi(days)
+		if err != nil || graceDays < 0 {
+			log.Fatalf("invalid BILLING_GRACE_DAYS, must not be negative: %v", days)
+		}
+
+		policy.DiasCarencia = graceDays
+	}
+
+	return policy
+}
@@ -0,0 +1,142 @@
+package policies
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/joho/godotenv"
+)
+
+// ErrPolicyViolation erro retornado quando uma operação viola a politica do tipo de cliente.
+var ErrPolicyViolation = errors.New("Policy violation")
+
+// ClientPolicy representa as regras de negocio aplicadas aos clientes de um tipo.
+type ClientPolicy struct {
+	// MaxPontos limita a quantidade de pontos ativos do cliente, 0 não possui limite.
+	MaxPontos int `json:"max_pontos"`
+	// IsentoSuspensaoAutomatica impede que os contratos do cliente sejam suspensos automaticamente.
+	IsentoSuspensaoAutomatica bool `json:"isento_suspensao_automatica"`
+	// ExigeContatoResponsavel exige um contato responsavel nos contratos do cliente.
+	ExigeContatoResponsavel bool `json:"exige_contato_responsavel"`
+	// SLAHoras prazo, em horas, para resolver os chamados de cada prioridade. As prioridades ausentes usam DefaultSLA.
+	SLAHoras map[entities.TicketPriority]int `json:"sla_horas"`
+}
+
+// DefaultSLA retorna os prazos, em horas, dos chamados de cada prioridade quando a politica não os define.
+func DefaultSLA() map[entities.TicketPriority]int {
+	return map[entities.TicketPriority]int{
+		entities.PRIORIDADE_BAIXA:   72,
+		entities.PRIORIDADE_MEDIA:   48,
+		entities.PRIORIDADE_ALTA:    24,
+		entities.PRIORIDADE_CRITICA: 8,
+	}
+}
+
+// ClientPolicies representa as politicas de cada tipo de cliente.
+type ClientPolicies map[entities.ClientType]ClientPolicy
+
+// Default retorna as politicas usadas quando CLIENT_POLICIES_FILE não está definido. Os clientes não possuem limite
+// de pontos nem exigem o contato responsavel, e apenas os clientes especiais são isentos da suspensão automatica e
+// possuem prazos menores nos chamados.
+func Default() ClientPolicies {
+	return ClientPolicies{
+		entities.ESPECIAL: {
+			IsentoSuspensaoAutomatica: true,
+			SLAHoras: map[entities.TicketPriority]int{
+				entities.PRIORIDADE_BAIXA:   24,
+				entities.PRIORIDADE_MEDIA:   12,
+				entities.PRIORIDADE_ALTA:    6,
+				entities.PRIORIDADE_CRITICA: 2,
+			},
+		},
+	}
+}
+
+// Load carrega as politicas do arquivo JSON definido em CLIENT_POLICIES_FILE. Os tipos
+// ausentes no arquivo mantêm a politica padrão.
+func Load() ClientPolicies {
+	godotenv.Load()
+
+	policies := Default()
+
+	path := os.Getenv("CLIENT_POLICIES_FILE")
+	if path == "" {
+		return policies
+	}
+
+	content, err := os.ReadFile(path)
+	if err != nil {
+		log.Fatalf("error to read client policies: %v", err)
+	}
+
+	filePolicies := ClientPolicies{}
+
+	err = json.Unmarshal(content, &filePolicies)
+	if err != nil {
+		log.Fatalf("error to parse client policies: %v", err)
+	}
+
+	for clientType, policy := range filePolicies {
+		if clientType != entities.FISICO && clientType != entities.ESPECIAL && clientType != entities.JURIDICO {
+			log.Fatalf("invalid client type in client policies: %v", clientType)
+		}
+
+		if policy.MaxPontos < 0 {
+			log.Fatalf("invalid max_pontos in client policies: %v", policy.MaxPontos)
+		}
+
+		for priority, hours := range policy.SLAHoras {
+			if _, ok := DefaultSLA()[priority]; !ok || hours <= 0 {
+				log.Fatalf("invalid sla_horas in client policies: %v=%v", priority, hours)
+			}
+		}
+
+		policies[clientType] = policy
+	}
+
+	return policies
+}
+
+// CheckPointLimit verifica se o cliente, que já possui a quantidade de pontos informada, pode receber mais um ponto.
+func (policies ClientPolicies) CheckPointLimit(clientType entities.ClientType, points int) error {
+	policy := policies[clientType]
+
+	if policy.MaxPontos > 0 && points >= policy.MaxPontos {
+		return fmt.Errorf("%w: %v clients may have at most %d points", ErrPolicyViolation, clientType, policy.MaxPontos)
+	}
+
+	return nil
+}
+
+// CheckResponsibleContact verifica se o contrato possui o contato responsavel exigido pelo tipo de cliente.
+func (policies ClientPolicies) CheckResponsibleContact(clientType entities.ClientType, contactID string) error {
+	if policies[clientType].ExigeContatoResponsavel && contactID == "" {
+		return fmt.Errorf("%w: %v contracts require a responsible contact (contato_responsavel_id)", ErrPolicyViolation, clientType)
+	}
+
+	return nil
+}
+
+// CheckAutomaticSuspension verifica se os contratos do tipo de cliente podem ser suspensos automaticamente.
+func (policies ClientPolicies) CheckAutomaticSuspension(clientType entities.ClientType) error {
+	if policies[clientType].IsentoSuspensaoAutomatica {
+		return fmt.Errorf("%w: %v clients are exempt from automatic suspension", ErrPolicyViolation, clientType)
+	}
+
+	return nil
+}
+
+// SLADeadline calcula o prazo para resolver o chamado da prioridade informada, aberto na data informada.
+func (policies ClientPolicies) SLADeadline(clientType entities.ClientType, priority entities.TicketPriority, openedAt time.Time) time.Time {
+	hours, ok := policies[clientType].SLAHoras[priority]
+	if !ok {
+		hours = DefaultSLA()[priority]
+	}
+
+	return openedAt.Add(time.Duration(hours) * time.Hour)
+}
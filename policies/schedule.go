@@ -0,0 +1,54 @@
+package policies
+
+import (
+	"log"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/joho/godotenv"
+)
+
+// SchedulePolicy representa as regras da agenda dos tecnicos.
+type SchedulePolicy struct {
+	// DuracaoSlot duração de cada horario da agenda dos tecnicos.
+	DuracaoSlot time.Duration
+	// DiasSugestao dias pesquisados a partir da data informada na sugestão de horarios.
+	DiasSugestao int
+}
+
+// DefaultSchedule retorna a politica da agenda usada quando as variaveis SCHEDULE_* não estão definidas.
+func DefaultSchedule() SchedulePolicy {
+	return SchedulePolicy{
+		DuracaoSlot:  2 * time.Hour,
+		DiasSugestao: 14,
+	}
+}
+
+// LoadSchedule carrega a politica da agenda, lendo a duração dos horarios em minutos de SCHEDULE_SLOT_MINUTES
+// e os dias pesquisados na sugestão de horarios de SCHEDULE_SEARCH_DAYS.
+func LoadSchedule() SchedulePolicy {
+	godotenv.Load()
+
+	policy := DefaultSchedule()
+
+	if minutes := os.Getenv("SCHEDULE_SLOT_MINUTES"); minutes != "" {
+		slotMinutes, err := strconv.Atoi(minutes)
+		if err != nil || slotMinutes < 15 || slotMinutes > 12*60 {
+			log.Fatalf("invalid SCHEDULE_SLOT_MINUTES, must be between 15 and 720: %v", minutes)
+		}
+
+		policy.DuracaoSlot = time.Duration(slotMinutes) * time.Minute
+	}
+
+	if days := os.Getenv("SCHEDULE_SEARCH_DAYS"); days != "" {
+		searchDays, err := strconv.Atoi(days)
+		if err != nil || searchDays < 1 || searchDays > 90 {
+			log.Fatalf("invalid SCHEDULE_SEARCH_DAYS, must be between 1 and 90: %v", days)
+		}
+
+		policy.DiasSugestao = searchDays
+	}
+
+	return policy
+}
@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"strconv"
 	"strings"
 	"time"
@@ -17,7 +18,7 @@ type addressConnectionFake struct {
 	connection *[]entities.Endereco
 }
 
-func (db *addressConnectionFake) CreateAddress(address entities.Endereco) (entities.Endereco, error) {
+func (db *addressConnectionFake) CreateAddress(ctx context.Context, address entities.Endereco) (entities.Endereco, error) {
 	addressID, _ := uuid.NewV4()
 
 	address.ID = addressID.String()
@@ -29,7 +30,7 @@ func (db *addressConnectionFake) CreateAddress(address entities.Endereco) (entit
 	return address, nil
 }
 
-func (db *addressConnectionFake) UpdateAddress(address entities.Endereco) (entities.Endereco, error) {
+func (db *addressConnectionFake) UpdateAddress(ctx context.Context, address entities.Endereco) (entities.Endereco, error) {
 	address.DataAtualizacao = time.Now()
 	address.DataRemocao.Valid = false
 
@@ -42,7 +43,11 @@ func (db *addressConnectionFake) UpdateAddress(address entities.Endereco) (entit
 	return address, nil
 }
 
-func (db *addressConnectionFake) FindAddressByID(addressID string) entities.Endereco {
+func (db *addressConnectionFake) FindAddressByID(ctx context.Context, addressID string) (entities.Endereco, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Endereco{}, err
+	}
+
 	address := entities.Endereco{}
 
 	for _, addressValue := range *db.connection {
@@ -51,22 +56,34 @@ func (db *addressConnectionFake) FindAddressByID(addressID string) entities.Ende
 		}
 	}
 
-	return address
+	if address.ID == "" {
+		return entities.Endereco{}, repositories.ErrNotFound
+	}
+
+	return address, nil
 }
 
-func (db *addressConnectionFake) FindAddressByFields(street string, neighborhood string, number int) entities.Endereco {
+func (db *addressConnectionFake) FindAddressByFields(ctx context.Context, fields entities.Endereco) (entities.Endereco, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Endereco{}, err
+	}
+
 	address := entities.Endereco{}
 
 	for _, addressValue := range *db.connection {
-		if addressValue.Logradouro == street && addressValue.Bairro == neighborhood && addressValue.Numero == number {
+		if addressValue.ChaveNormalizada == fields.ChaveNormalizada {
 			address = addressValue
 		}
 	}
 
-	return address
+	if address.ID == "" {
+		return entities.Endereco{}, repositories.ErrNotFound
+	}
+
+	return address, nil
 }
 
-func (db *addressConnectionFake) DeleteAddress(address entities.Endereco) error {
+func (db *addressConnectionFake) DeleteAddress(ctx context.Context, address entities.Endereco) error {
 	for i, addressValue := range *db.connection {
 		if addressValue.ID == address.ID {
 			(*db.connection)[i].DataRemocao.Scan(time.Now())
@@ -76,7 +93,11 @@ func (db *addressConnectionFake) DeleteAddress(address entities.Endereco) error
 	return nil
 }
 
-func (db *addressConnectionFake) FindAddresses(street string, neighborhood string, number string) []entities.Endereco {
+func (db *addressConnectionFake) FindAddresses(ctx context.Context, street string, neighborhood string, number string) ([]entities.Endereco, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	address := []entities.Endereco{}
 
 	if street != "" && neighborhood != "" && number != "" {
@@ -146,7 +167,23 @@ func (db *addressConnectionFake) FindAddresses(street string, neighborhood strin
 		}
 	}
 
-	return address
+	return address, nil
+}
+
+func (db *addressConnectionFake) FindAddressesByCEPAndNumber(ctx context.Context, cep string, number int) ([]entities.Endereco, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	addresses := []entities.Endereco{}
+
+	for _, addressValue := range *db.connection {
+		if addressValue.Cep == cep && addressValue.Numero == number && !addressValue.DataRemocao.Valid {
+			addresses = append(addresses, addressValue)
+		}
+	}
+
+	return addresses, nil
 }
 
 // NewAddressRepositoryFake cria uma nova instancia de AddressRepository para os testes.
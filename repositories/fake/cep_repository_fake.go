@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBCep banco de dados fake do diretorio de CEPs para os testes
+var DBCep = &[]entities.Cep{}
+
+type cepConnectionFake struct {
+	connection *[]entities.Cep
+}
+
+func (db *cepConnectionFake) SaveCEPs(ctx context.Context, ceps []entities.Cep) error {
+	// Assim como no postgres, o mesmo comando não pode atualizar o mesmo CEP duas vezes.
+	codes := map[string]bool{}
+
+	for _, cep := range ceps {
+		if codes[cep.Codigo] {
+			return errors.New("ON CONFLICT DO UPDATE command cannot affect row a second time")
+		}
+
+		codes[cep.Codigo] = true
+	}
+
+	for _, cep := range ceps {
+		updated := false
+
+		for i, cepValue := range *db.connection {
+			if cepValue.Codigo == cep.Codigo {
+				cep.ID = cepValue.ID
+				cep.DataCriacao = cepValue.DataCriacao
+				cep.DataAtualizacao = time.Now()
+				(*db.connection)[i] = cep
+				updated = true
+			}
+		}
+
+		if !updated {
+			cepID, _ := uuid.NewV4()
+
+			cep.ID = cepID.String()
+			cep.DataCriacao = time.Now()
+			cep.DataAtualizacao = time.Now()
+
+			*db.connection = append(*db.connection, cep)
+		}
+	}
+
+	return nil
+}
+
+func (db *cepConnectionFake) FindCEPByCode(ctx context.Context, code string) (entities.Cep, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Cep{}, err
+	}
+
+	for _, cepValue := range *db.connection {
+		if cepValue.Codigo == code {
+			return cepValue, nil
+		}
+	}
+
+	return entities.Cep{}, repositories.ErrNotFound
+}
+
+// NewCEPRepositoryFake cria uma nova instancia de CEPRepository para os testes.
+func NewCEPRepositoryFake(database *[]entities.Cep) repositories.CEPRepository {
+	return &cepConnectionFake{
+		connection: database,
+	}
+}
@@ -0,0 +1,154 @@
+package repositories
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBClientMerge banco de dados fake das mesclagens de clientes para os testes
+var DBClientMerge = &[]entities.ClienteMesclagem{}
+
+type clientMergeConnectionFake struct {
+	connection             *[]entities.ClienteMesclagem
+	connectionClient       *[]entities.Cliente
+	connectionPoint        *[]entities.Ponto
+	connectionContract     *[]entities.Contrato
+	connectionContact      *[]entities.Contato
+	connectionEquipment    *[]entities.Equipamento
+	connectionServiceOrder *[]entities.OrdemServico
+	connectionTicket       *[]entities.Chamado
+}
+
+func (db *clientMergeConnectionFake) MergeClients(ctx context.Context, plan dtos.ClientMergePlan, clientMerge entities.ClienteMesclagem) (entities.ClienteMesclagem, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.ClienteMesclagem{}, err
+	}
+
+	for _, transfer := range plan.PontosTransferidos {
+		for i, pointValue := range *db.connectionPoint {
+			if pointValue.ID == transfer.PontoID {
+				(*db.connectionPoint)[i].ClienteID = plan.SobreviventeID
+			}
+		}
+	}
+
+	for _, unification := range plan.PontosUnificados {
+		for i, contractValue := range *db.connectionContract {
+			if contractValue.PontoID == unification.PontoID {
+				(*db.connectionContract)[i].PontoID = unification.PontoSobreviventeID
+			}
+		}
+
+		for i, equipmentValue := range *db.connectionEquipment {
+			if equipmentValue.PontoID == unification.PontoID {
+				(*db.connectionEquipment)[i].PontoID = unification.PontoSobreviventeID
+			}
+		}
+
+		for i, serviceOrderValue := range *db.connectionServiceOrder {
+			if serviceOrderValue.PontoID == unification.PontoID {
+				(*db.connectionServiceOrder)[i].PontoID = unification.PontoSobreviventeID
+			}
+		}
+
+		for i, ticketValue := range *db.connectionTicket {
+			if ticketValue.PontoID == unification.PontoID {
+				(*db.connectionTicket)[i].PontoID = unification.PontoSobreviventeID
+			}
+		}
+
+		for i, pointValue := range *db.connectionPoint {
+			if pointValue.ID == unification.PontoID {
+				(*db.connectionPoint)[i].DataRemocao.Scan(time.Now())
+			}
+		}
+	}
+
+	for _, transfer := range plan.ContatosTransferidos {
+		for i, contactValue := range *db.connectionContact {
+			if contactValue.ID == transfer.ContatoID {
+				(*db.connectionContact)[i].ClienteID = plan.SobreviventeID
+				(*db.connectionContact)[i].Principal = transfer.Principal
+			}
+		}
+	}
+
+	for i, contractValue := range *db.connectionContract {
+		if survivorContactID, ok := plan.ContatosSubstituidos[contractValue.ContatoResponsavelID]; ok {
+			(*db.connectionContract)[i].ContatoResponsavelID = survivorContactID
+		}
+	}
+
+	for i, contactValue := range *db.connectionContact {
+		if slices.Contains(plan.ContatosDescartados, contactValue.ID) {
+			(*db.connectionContact)[i].DataRemocao.Scan(time.Now())
+		}
+	}
+
+	for i, ticketValue := range *db.connectionTicket {
+		if slices.Contains(plan.DuplicadosIDs, ticketValue.ClienteID) {
+			(*db.connectionTicket)[i].ClienteID = plan.SobreviventeID
+		}
+	}
+
+	for i, clientValue := range *db.connectionClient {
+		switch {
+		case clientValue.ID == plan.SobreviventeID && slices.Contains(plan.DuplicadosIDs, clientValue.MatrizID):
+			(*db.connectionClient)[i].MatrizID = ""
+		case slices.Contains(plan.DuplicadosIDs, clientValue.MatrizID):
+			(*db.connectionClient)[i].MatrizID = plan.SobreviventeID
+		}
+	}
+
+	for i, clientValue := range *db.connectionClient {
+		if slices.Contains(plan.DuplicadosIDs, clientValue.ID) {
+			(*db.connectionClient)[i].DataRemocao.Scan(time.Now())
+		}
+	}
+
+	clientMergeID, _ := uuid.NewV4()
+
+	clientMerge.ID = clientMergeID.String()
+	clientMerge.DataCriacao = time.Now()
+	clientMerge.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, clientMerge)
+
+	return clientMerge, nil
+}
+
+func (db *clientMergeConnectionFake) FindClientMergesByClientID(ctx context.Context, clientID string) ([]entities.ClienteMesclagem, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	clientMerges := []entities.ClienteMesclagem{}
+
+	for _, clientMergeValue := range *db.connection {
+		if clientMergeValue.SobreviventeID == clientID {
+			clientMerges = append(clientMerges, clientMergeValue)
+		}
+	}
+
+	return clientMerges, nil
+}
+
+// NewClientMergeRepositoryFake cria uma nova instancia de ClientMergeRepository para os testes.
+func NewClientMergeRepositoryFake(database *[]entities.ClienteMesclagem, connectionClient *[]entities.Cliente, connectionPoint *[]entities.Ponto, connectionContract *[]entities.Contrato, connectionContact *[]entities.Contato, connectionEquipment *[]entities.Equipamento, connectionServiceOrder *[]entities.OrdemServico, connectionTicket *[]entities.Chamado) repositories.ClientMergeRepository {
+	return &clientMergeConnectionFake{
+		connection:             database,
+		connectionClient:       connectionClient,
+		connectionPoint:        connectionPoint,
+		connectionContract:     connectionContract,
+		connectionContact:      connectionContact,
+		connectionEquipment:    connectionEquipment,
+		connectionServiceOrder: connectionServiceOrder,
+		connectionTicket:       connectionTicket,
+	}
+}
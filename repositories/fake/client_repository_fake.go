@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"strings"
 	"time"
 
@@ -16,7 +17,7 @@ type clientConnectionFake struct {
 	connection *[]entities.Cliente
 }
 
-func (db *clientConnectionFake) CreateClient(client entities.Cliente) (entities.Cliente, error) {
+func (db *clientConnectionFake) CreateClient(ctx context.Context, client entities.Cliente) (entities.Cliente, error) {
 	clientID, _ := uuid.NewV4()
 
 	client.ID = clientID.String()
@@ -28,7 +29,7 @@ func (db *clientConnectionFake) CreateClient(client entities.Cliente) (entities.
 	return client, nil
 }
 
-func (db *clientConnectionFake) UpdateClient(client entities.Cliente) (entities.Cliente, error) {
+func (db *clientConnectionFake) UpdateClient(ctx context.Context, client entities.Cliente) (entities.Cliente, error) {
 	client.DataAtualizacao = time.Now()
 	client.DataRemocao.Valid = false
 
@@ -41,7 +42,11 @@ func (db *clientConnectionFake) UpdateClient(client entities.Cliente) (entities.
 	return client, nil
 }
 
-func (db *clientConnectionFake) FindClientByID(clientID string) entities.Cliente {
+func (db *clientConnectionFake) FindClientByID(ctx context.Context, clientID string) (entities.Cliente, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Cliente{}, err
+	}
+
 	client := entities.Cliente{}
 
 	for _, clientValue := range *db.connection {
@@ -50,10 +55,18 @@ func (db *clientConnectionFake) FindClientByID(clientID string) entities.Cliente
 		}
 	}
 
-	return client
+	if client.ID == "" {
+		return entities.Cliente{}, repositories.ErrNotFound
+	}
+
+	return client, nil
 }
 
-func (db *clientConnectionFake) FindClientByName(name string) entities.Cliente {
+func (db *clientConnectionFake) FindClientByName(ctx context.Context, name string) (entities.Cliente, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Cliente{}, err
+	}
+
 	client := entities.Cliente{}
 
 	for _, clientValue := range *db.connection {
@@ -62,10 +75,34 @@ func (db *clientConnectionFake) FindClientByName(name string) entities.Cliente {
 		}
 	}
 
-	return client
+	if client.ID == "" {
+		return entities.Cliente{}, repositories.ErrNotFound
+	}
+
+	return client, nil
 }
 
-func (db *clientConnectionFake) DeleteClient(client entities.Cliente) error {
+func (db *clientConnectionFake) FindClientByDocument(ctx context.Context, document string) (entities.Cliente, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Cliente{}, err
+	}
+
+	client := entities.Cliente{}
+
+	for _, clientValue := range *db.connection {
+		if clientValue.Documento == document {
+			client = clientValue
+		}
+	}
+
+	if client.ID == "" {
+		return entities.Cliente{}, repositories.ErrNotFound
+	}
+
+	return client, nil
+}
+
+func (db *clientConnectionFake) DeleteClient(ctx context.Context, client entities.Cliente) error {
 	for i, clientValue := range *db.connection {
 		if clientValue.ID == client.ID {
 			(*db.connection)[i].DataRemocao.Scan(time.Now())
@@ -75,7 +112,11 @@ func (db *clientConnectionFake) DeleteClient(client entities.Cliente) error {
 	return nil
 }
 
-func (db *clientConnectionFake) FindClients(clientName string, clientType entities.ClientType) []entities.Cliente {
+func (db *clientConnectionFake) FindClients(ctx context.Context, clientName string, clientType entities.ClientType, document string) ([]entities.Cliente, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	clients := []entities.Cliente{}
 
 	if clientName != "" && clientType != entities.ClientType("") {
@@ -105,7 +146,51 @@ func (db *clientConnectionFake) FindClients(clientName string, clientType entiti
 		}
 	}
 
-	return clients
+	if document != "" {
+		clientsByDocument := []entities.Cliente{}
+
+		for _, clientValue := range clients {
+			if clientValue.Documento == document {
+				clientsByDocument = append(clientsByDocument, clientValue)
+			}
+		}
+
+		clients = clientsByDocument
+	}
+
+	return clients, nil
+}
+
+func (db *clientConnectionFake) FindClientsByParentID(ctx context.Context, parentID string) ([]entities.Cliente, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	clients := []entities.Cliente{}
+
+	for _, clientValue := range *db.connection {
+		if clientValue.MatrizID == parentID && !clientValue.DataRemocao.Valid {
+			clients = append(clients, clientValue)
+		}
+	}
+
+	return clients, nil
+}
+
+func (db *clientConnectionFake) FindClientsByName(ctx context.Context, name string) ([]entities.Cliente, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	clients := []entities.Cliente{}
+
+	for _, clientValue := range *db.connection {
+		if clientValue.Nome == name {
+			clients = append(clients, clientValue)
+		}
+	}
+
+	return clients, nil
 }
 
 // NewClientRepositoryFake cria uma nova instancia de ClientRepository para os testes.
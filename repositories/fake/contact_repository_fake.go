@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBContact banco de dados fake de contatos para os testes
+var DBContact = &[]entities.Contato{}
+
+type contactConnectionFake struct {
+	connection *[]entities.Contato
+}
+
+func (db *contactConnectionFake) CreateContact(ctx context.Context, contact entities.Contato) (entities.Contato, error) {
+	contactID, _ := uuid.NewV4()
+
+	contact.ID = contactID.String()
+	contact.DataCriacao = time.Now()
+	contact.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, contact)
+
+	return contact, nil
+}
+
+func (db *contactConnectionFake) UpdateContact(ctx context.Context, contact entities.Contato) (entities.Contato, error) {
+	contact.DataAtualizacao = time.Now()
+	contact.DataRemocao.Valid = false
+
+	for i, contactValue := range *db.connection {
+		if contactValue.ID == contact.ID {
+			(*db.connection)[i] = contact
+		}
+	}
+
+	return contact, nil
+}
+
+func (db *contactConnectionFake) FindContactByID(ctx context.Context, contactID string) (entities.Contato, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Contato{}, err
+	}
+
+	contact := entities.Contato{}
+
+	for _, contactValue := range *db.connection {
+		if contactValue.ID == contactID && !contactValue.DataRemocao.Valid {
+			contact = contactValue
+		}
+	}
+
+	if contact.ID == "" {
+		return entities.Contato{}, repositories.ErrNotFound
+	}
+
+	return contact, nil
+}
+
+func (db *contactConnectionFake) FindContactByClientIDAndValue(ctx context.Context, clientID string, contactType entities.ContactType, value string) (entities.Contato, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Contato{}, err
+	}
+
+	contact := entities.Contato{}
+
+	for _, contactValue := range *db.connection {
+		if contactValue.ClienteID == clientID && contactValue.Tipo == contactType && contactValue.Valor == value {
+			contact = contactValue
+		}
+	}
+
+	if contact.ID == "" {
+		return entities.Contato{}, repositories.ErrNotFound
+	}
+
+	return contact, nil
+}
+
+func (db *contactConnectionFake) FindContactsByClientID(ctx context.Context, clientID string) ([]entities.Contato, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contacts := []entities.Contato{}
+
+	for _, contactValue := range *db.connection {
+		if contactValue.ClienteID == clientID && !contactValue.DataRemocao.Valid {
+			contacts = append(contacts, contactValue)
+		}
+	}
+
+	return contacts, nil
+}
+
+func (db *contactConnectionFake) DeleteContact(ctx context.Context, contact entities.Contato) error {
+	for i, contactValue := range *db.connection {
+		if contactValue.ID == contact.ID {
+			(*db.connection)[i].DataRemocao.Scan(time.Now())
+		}
+	}
+
+	return nil
+}
+
+// NewContactRepositoryFake cria uma nova instancia de ContactRepository para os testes.
+func NewContactRepositoryFake(database *[]entities.Contato) repositories.ContactRepository {
+	return &contactConnectionFake{
+		connection: database,
+	}
+}
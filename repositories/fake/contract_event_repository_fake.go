@@ -1,6 +1,7 @@
 package repositories
 
 import (
+	"context"
 	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
@@ -15,7 +16,7 @@ type contractEventConnectionFake struct {
 	connection *[]entities.ContratoEvento
 }
 
-func (db *contractEventConnectionFake) CreateContractEvent(contractEvent entities.ContratoEvento) (entities.ContratoEvento, error) {
+func (db *contractEventConnectionFake) CreateContractEvent(ctx context.Context, contractEvent entities.ContratoEvento) (entities.ContratoEvento, error) {
 	contractEventID, _ := uuid.NewV4()
 
 	contractEvent.ID = contractEventID.String()
@@ -27,20 +28,57 @@ func (db *contractEventConnectionFake) CreateContractEvent(contractEvent entitie
 	return contractEvent, nil
 }
 
-func (db *contractEventConnectionFake) FindContractEventsByContractID(contractID string) []entities.ContratoEvento {
+func (db *contractEventConnectionFake) FindContractEventsByContractID(ctx context.Context, contractID string) ([]entities.ContratoEvento, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contractsEvent := []entities.ContratoEvento{}
+
+	for _, contractsEventValue := range *db.connection {
+		if contractsEventValue.ContratoID == contractID {
+			contractsEvent = append(contractsEvent, contractsEventValue)
+		}
+	}
+
+	return contractsEvent, nil
+}
+
+func (db *contractEventConnectionFake) FindContractEventsByContractIDs(ctx context.Context, contractIDs []string) ([]entities.ContratoEvento, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	contractsEvent := []entities.ContratoEvento{}
 
-	if contractID != "" {
-		for _, contractsEventValue := range *db.connection {
+	for _, contractsEventValue := range *db.connection {
+		for _, contractID := range contractIDs {
 			if contractsEventValue.ContratoID == contractID {
 				contractsEvent = append(contractsEvent, contractsEventValue)
 			}
 		}
-	} else {
-		contractsEvent = append(contractsEvent, *db.connection...)
 	}
 
-	return contractsEvent
+	return contractsEvent, nil
+}
+
+func (db *contractEventConnectionFake) FindScheduledPlanEvents(ctx context.Context, until time.Time) ([]entities.ContratoEvento, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contractsEvent := []entities.ContratoEvento{}
+
+	for _, contractsEventValue := range *db.connection {
+		effectiveDate := contractsEventValue.DataEfetiva
+
+		if contractsEventValue.Tipo == entities.PLANO && effectiveDate != nil &&
+			effectiveDate.After(contractsEventValue.DataCriacao) && !effectiveDate.After(until) {
+			contractsEvent = append(contractsEvent, contractsEventValue)
+		}
+	}
+
+	return contractsEvent, nil
 }
 
 // NewContractEventRepositoryFake cria uma nova instancia de ContractEventRepository para os testes.
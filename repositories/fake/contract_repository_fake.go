@@ -1,9 +1,13 @@
 package repositories
 
 import (
+	"context"
+	"errors"
+	"sort"
 	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
 	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
 	"github.com/gofrs/uuid"
 )
@@ -16,9 +20,17 @@ type contractConnectionFake struct {
 	connectionClient  *[]entities.Cliente
 	connectionAddress *[]entities.Endereco
 	connectionPoint   *[]entities.Ponto
+	connectionPlan    *[]entities.Plano
+	connectionVersion *[]entities.ContratoVersao
 }
 
-func (db *contractConnectionFake) CreateContract(contract entities.Contrato) (entities.Contrato, error) {
+func (db *contractConnectionFake) CreateContract(ctx context.Context, contract entities.Contrato) (entities.Contrato, error) {
+	for _, contractValue := range *db.connection {
+		if contractValue.PontoID == contract.PontoID && contractValue.Estado != entities.CANCELADO && !contractValue.DataRemocao.Valid {
+			return contract, repositories.ErrAlreadyExists
+		}
+	}
+
 	contractID, _ := uuid.NewV4()
 
 	contract.ID = contractID.String()
@@ -30,7 +42,7 @@ func (db *contractConnectionFake) CreateContract(contract entities.Contrato) (en
 	return contract, nil
 }
 
-func (db *contractConnectionFake) UpdateContract(contract entities.Contrato) (entities.Contrato, error) {
+func (db *contractConnectionFake) UpdateContract(ctx context.Context, contract entities.Contrato) (entities.Contrato, error) {
 	contract.DataAtualizacao = time.Now()
 	contract.DataRemocao.Valid = false
 
@@ -43,7 +55,11 @@ func (db *contractConnectionFake) UpdateContract(contract entities.Contrato) (en
 	return contract, nil
 }
 
-func (db *contractConnectionFake) FindContractByID(contractID string) entities.Contrato {
+func (db *contractConnectionFake) FindContractByID(ctx context.Context, contractID string) (entities.Contrato, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Contrato{}, err
+	}
+
 	contract := entities.Contrato{}
 
 	for _, contractValue := range *db.connection {
@@ -69,22 +85,85 @@ func (db *contractConnectionFake) FindContractByID(contractID string) entities.C
 		}
 	}
 
-	return contract
+	contract.Plano = db.findPlan(contract.PlanoID)
+	contract.Versao = db.findVersion(contract.VersaoID)
+
+	if contract.ID == "" {
+		return entities.Contrato{}, repositories.ErrNotFound
+	}
+
+	return contract, nil
 }
 
-func (db *contractConnectionFake) FindContractByPontoID(pontoID string) entities.Contrato {
+func (db *contractConnectionFake) FindContractByPontoID(ctx context.Context, pontoID string) (entities.Contrato, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Contrato{}, err
+	}
+
 	contract := entities.Contrato{}
 
 	for _, contractValue := range *db.connection {
-		if contractValue.PontoID == pontoID {
+		if contractValue.PontoID == pontoID && contractValue.Estado != entities.CANCELADO && !contractValue.DataRemocao.Valid {
 			contract = contractValue
 		}
 	}
 
-	return contract
+	if contract.ID == "" {
+		return entities.Contrato{}, repositories.ErrNotFound
+	}
+
+	contract.Versao = db.findVersion(contract.VersaoID)
+
+	return contract, nil
+}
+
+func (db *contractConnectionFake) FindContractsByPontoID(ctx context.Context, pontoID string) ([]entities.Contrato, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contracts := []entities.Contrato{}
+
+	for _, contractValue := range *db.connection {
+		if contractValue.PontoID != pontoID || contractValue.DataRemocao.Valid {
+			continue
+		}
+
+		contract, err := db.FindContractByID(ctx, contractValue.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, contract)
+	}
+
+	return contracts, nil
+}
+
+func (db *contractConnectionFake) FindCurrentContractsByPontoIDs(ctx context.Context, pontoIDs []string) ([]entities.Contrato, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contracts := []entities.Contrato{}
+
+	for _, pontoID := range pontoIDs {
+		contract, err := db.FindContractByPontoID(ctx, pontoID)
+		if errors.Is(err, repositories.ErrNotFound) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		contracts = append(contracts, contract)
+	}
+
+	return contracts, nil
 }
 
-func (db *contractConnectionFake) DeleteContract(contract entities.Contrato) error {
+func (db *contractConnectionFake) DeleteContract(ctx context.Context, contract entities.Contrato) error {
 	for i, contractValue := range *db.connection {
 		if contractValue.ID == contract.ID {
 			(*db.connection)[i].DataRemocao.Scan(time.Now())
@@ -94,104 +173,195 @@ func (db *contractConnectionFake) DeleteContract(contract entities.Contrato) err
 	return nil
 }
 
-func (db *contractConnectionFake) FindContracts(clientID string, addressID string) []entities.Contrato {
-	contracts := []entities.Contrato{}
+func (db *contractConnectionFake) FindContracts(ctx context.Context, clientID string, addressID string, includeBranches bool) ([]entities.Contrato, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	clientIDs := map[string]bool{clientID: true}
 
-	if clientID != "" && addressID != "" {
-		for _, contractValue := range *db.connection {
-			for _, point := range *db.connectionPoint {
-				if contractValue.PontoID == point.ID && !contractValue.DataRemocao.Valid {
-
-					for _, client := range *db.connectionClient {
-						if client.ID == clientID {
-							contractValue.Ponto.Cliente = client
-							contracts = append(contracts, contractValue)
-						}
-					}
-
-					for _, address := range *db.connectionAddress {
-						if address.ID == addressID {
-							contractValue.Ponto.Endereco = address
-							contracts = append(contracts, contractValue)
-						}
-					}
+	if clientID != "" && includeBranches {
+		queue := []string{clientID}
+
+		for len(queue) != 0 {
+			parentID := queue[0]
+			queue = queue[1:]
+
+			for _, client := range *db.connectionClient {
+				if client.MatrizID == parentID && !client.DataRemocao.Valid && !clientIDs[client.ID] {
+					clientIDs[client.ID] = true
+					queue = append(queue, client.ID)
 				}
 			}
 		}
-	} else if clientID != "" {
-		for _, contractValue := range *db.connection {
-			for _, point := range *db.connectionPoint {
-				if contractValue.PontoID == point.ID && !contractValue.DataRemocao.Valid {
-
-					for _, client := range *db.connectionClient {
-						if client.ID == clientID {
-							contractValue.Ponto.Cliente = client
-							contracts = append(contracts, contractValue)
-						}
-					}
-
-					for _, address := range *db.connectionAddress {
-						if address.ID == point.EnderecoID {
-							contractValue.Ponto.Endereco = address
-							contracts = append(contracts, contractValue)
-						}
-					}
+	}
+
+	contracts := []entities.Contrato{}
+
+	for _, contractValue := range *db.connection {
+		if contractValue.DataRemocao.Valid {
+			continue
+		}
+
+		for _, point := range *db.connectionPoint {
+			if contractValue.PontoID != point.ID {
+				continue
+			}
+
+			if clientID != "" && !clientIDs[point.ClienteID] {
+				continue
+			}
+
+			if addressID != "" && point.EnderecoID != addressID {
+				continue
+			}
+
+			contractValue.Ponto = point
+			contractValue.Plano = db.findPlan(contractValue.PlanoID)
+			contractValue.Versao = db.findVersion(contractValue.VersaoID)
+
+			for _, client := range *db.connectionClient {
+				if client.ID == point.ClienteID {
+					contractValue.Ponto.Cliente = client
 				}
 			}
-		}
-	} else if addressID != "" {
-		for _, contractValue := range *db.connection {
-			for _, point := range *db.connectionPoint {
-				if contractValue.PontoID == point.ID && !contractValue.DataRemocao.Valid {
-
-					for _, client := range *db.connectionClient {
-						if client.ID == point.ClienteID {
-							contractValue.Ponto.Cliente = client
-							contracts = append(contracts, contractValue)
-						}
-					}
-
-					for _, address := range *db.connectionAddress {
-						if address.ID == addressID {
-							contractValue.Ponto.Endereco = address
-							contracts = append(contracts, contractValue)
-						}
-					}
+
+			for _, address := range *db.connectionAddress {
+				if address.ID == point.EnderecoID {
+					contractValue.Ponto.Endereco = address
 				}
 			}
+
+			contracts = append(contracts, contractValue)
 		}
-	} else {
-		for _, contractValue := range *db.connection {
-			for _, point := range *db.connectionPoint {
-				if contractValue.PontoID == point.ID && !contractValue.DataRemocao.Valid {
-
-					for _, client := range *db.connectionClient {
-						if client.ID == point.ClienteID {
-							contractValue.Ponto.Cliente = client
-							contracts = append(contracts, contractValue)
-						}
-					}
-
-					for _, address := range *db.connectionAddress {
-						if address.ID == point.EnderecoID {
-							contractValue.Ponto.Endereco = address
-							contracts = append(contracts, contractValue)
-						}
-					}
-				}
+	}
+
+	return contracts, nil
+}
+
+func (db *contractConnectionFake) FindContractsForBilling(ctx context.Context, from time.Time) ([]entities.Contrato, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contracts := []entities.Contrato{}
+
+	for _, contractValue := range *db.connection {
+		if contractValue.DataRemocao.Valid && contractValue.DataRemocao.Time.Before(from) {
+			continue
+		}
+
+		for _, point := range *db.connectionPoint {
+			if point.ID == contractValue.PontoID {
+				contractValue.Ponto = point
 			}
 		}
+
+		contractValue.Plano = db.findPlan(contractValue.PlanoID)
+
+		contracts = append(contracts, contractValue)
+	}
+
+	return contracts, nil
+}
+
+func (db *contractConnectionFake) CountActiveContractsByPlanID(ctx context.Context, planID string) (int64, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+
+	var count int64
+
+	for _, contractValue := range *db.connection {
+		if contractValue.PlanoID == planID && contractValue.Estado != entities.CANCELADO && !contractValue.DataRemocao.Valid {
+			count++
+		}
+	}
+
+	return count, nil
+}
+
+func (db *contractConnectionFake) FindContractsEndingBetween(ctx context.Context, from time.Time, to time.Time) ([]entities.Contrato, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
 	}
 
-	return contracts
+	contracts := []entities.Contrato{}
+
+	for _, contractValue := range *db.connection {
+		if contractValue.DataRemocao.Valid || contractValue.Estado != entities.VIGOR {
+			continue
+		}
+
+		contract, err := db.FindContractByID(ctx, contractValue.ID)
+		if err != nil {
+			return nil, err
+		}
+
+		endDate := contractEndDate(contract)
+		if endDate == nil || endDate.Before(from) || endDate.After(to) {
+			continue
+		}
+
+		contracts = append(contracts, contract)
+	}
+
+	sort.SliceStable(contracts, func(i, j int) bool {
+		return contractEndDate(contracts[i]).Before(*contractEndDate(contracts[j]))
+	})
+
+	return contracts, nil
+}
+
+func (db *contractConnectionFake) UpdateCurrentVersion(ctx context.Context, contractID string, versionID string) error {
+	for i, contractValue := range *db.connection {
+		if contractValue.ID == contractID {
+			(*db.connection)[i].VersaoID = versionID
+		}
+	}
+
+	return nil
+}
+
+// contractEndDate retorna a data de termino do contrato ou, sem ela, o fim da fidelidade.
+func contractEndDate(contract entities.Contrato) *time.Time {
+	if termination := dtos.ContractTermination(contract); termination != nil {
+		return termination
+	}
+
+	return contract.DataFimFidelidade
+}
+
+// findPlan retorna o plano do contrato, inclusive removido, como o preload do repositorio.
+func (db *contractConnectionFake) findPlan(planID string) entities.Plano {
+	for _, plan := range *db.connectionPlan {
+		if plan.ID == planID {
+			return plan
+		}
+	}
+
+	return entities.Plano{}
+}
+
+// findVersion retorna a versão atual do contrato, como o preload do repositorio.
+func (db *contractConnectionFake) findVersion(versionID string) *entities.ContratoVersao {
+	for _, contractVersion := range *db.connectionVersion {
+		if contractVersion.ID == versionID {
+			return &contractVersion
+		}
+	}
+
+	return nil
 }
 
 // NewContractRepositoryFake cria uma nova instancia de ContractRepository para os testes.
-func NewContractRepositoryFake(database *[]entities.Contrato, connectionClient *[]entities.Cliente, connectionAddress *[]entities.Endereco, connectionPoint *[]entities.Ponto) repositories.ContractRepository {
+func NewContractRepositoryFake(database *[]entities.Contrato, connectionClient *[]entities.Cliente, connectionAddress *[]entities.Endereco, connectionPoint *[]entities.Ponto, connectionPlan *[]entities.Plano, connectionVersion *[]entities.ContratoVersao) repositories.ContractRepository {
 	return &contractConnectionFake{
 		connection:        database,
 		connectionClient:  connectionClient,
 		connectionAddress: connectionAddress,
 		connectionPoint:   connectionPoint,
+		connectionPlan:    connectionPlan,
+		connectionVersion: connectionVersion,
 	}
 }
@@ -0,0 +1,57 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBContractVersion banco de dados fake de versões de contratos para os testes
+var DBContractVersion = &[]entities.ContratoVersao{}
+
+type contractVersionConnectionFake struct {
+	connection *[]entities.ContratoVersao
+}
+
+func (db *contractVersionConnectionFake) CreateContractVersion(ctx context.Context, contractVersion entities.ContratoVersao) (entities.ContratoVersao, error) {
+	contractVersionID, _ := uuid.NewV4()
+
+	contractVersion.ID = contractVersionID.String()
+	contractVersion.DataCriacao = time.Now()
+	contractVersion.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, contractVersion)
+
+	return contractVersion, nil
+}
+
+func (db *contractVersionConnectionFake) FindContractVersionsByContractID(ctx context.Context, contractID string) ([]entities.ContratoVersao, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	contractVersions := []entities.ContratoVersao{}
+
+	for _, contractVersionValue := range *db.connection {
+		if contractVersionValue.ContratoID == contractID {
+			contractVersions = append(contractVersions, contractVersionValue)
+		}
+	}
+
+	sort.SliceStable(contractVersions, func(i, j int) bool {
+		return contractVersions[i].Numero < contractVersions[j].Numero
+	})
+
+	return contractVersions, nil
+}
+
+// NewContractVersionRepositoryFake cria uma nova instancia de ContractVersionRepository para os testes.
+func NewContractVersionRepositoryFake(database *[]entities.ContratoVersao) repositories.ContractVersionRepository {
+	return &contractVersionConnectionFake{
+		connection: database,
+	}
+}
@@ -0,0 +1,52 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBEquipmentMovement banco de dados fake de movimentações de equipamentos para os testes
+var DBEquipmentMovement = &[]entities.EquipamentoMovimentacao{}
+
+type equipmentMovementConnectionFake struct {
+	connection *[]entities.EquipamentoMovimentacao
+}
+
+func (db *equipmentMovementConnectionFake) CreateEquipmentMovement(ctx context.Context, movement entities.EquipamentoMovimentacao) (entities.EquipamentoMovimentacao, error) {
+	movementID, _ := uuid.NewV4()
+
+	movement.ID = movementID.String()
+	movement.DataCriacao = time.Now()
+	movement.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, movement)
+
+	return movement, nil
+}
+
+func (db *equipmentMovementConnectionFake) FindEquipmentMovementsByEquipmentID(ctx context.Context, equipmentID string) ([]entities.EquipamentoMovimentacao, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	movements := []entities.EquipamentoMovimentacao{}
+
+	for _, movementValue := range *db.connection {
+		if movementValue.EquipamentoID == equipmentID {
+			movements = append(movements, movementValue)
+		}
+	}
+
+	return movements, nil
+}
+
+// NewEquipmentMovementRepositoryFake cria uma nova instancia de EquipmentMovementRepository para os testes.
+func NewEquipmentMovementRepositoryFake(database *[]entities.EquipamentoMovimentacao) repositories.EquipmentMovementRepository {
+	return &equipmentMovementConnectionFake{
+		connection: database,
+	}
+}
@@ -0,0 +1,170 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBEquipment banco de dados fake de equipamentos para os testes
+var DBEquipment = &[]entities.Equipamento{}
+
+type equipmentConnectionFake struct {
+	connection         *[]entities.Equipamento
+	connectionContract *[]entities.Contrato
+	connectionPoint    *[]entities.Ponto
+	connectionClient   *[]entities.Cliente
+}
+
+func (db *equipmentConnectionFake) CreateEquipment(ctx context.Context, equipment entities.Equipamento) (entities.Equipamento, error) {
+	equipmentID, _ := uuid.NewV4()
+
+	equipment.ID = equipmentID.String()
+	equipment.DataCriacao = time.Now()
+	equipment.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, equipment)
+
+	return equipment, nil
+}
+
+func (db *equipmentConnectionFake) UpdateEquipment(ctx context.Context, equipment entities.Equipamento) (entities.Equipamento, error) {
+	equipment.DataAtualizacao = time.Now()
+
+	for i, equipmentValue := range *db.connection {
+		if equipmentValue.ID == equipment.ID {
+			(*db.connection)[i] = equipment
+		}
+	}
+
+	return equipment, nil
+}
+
+func (db *equipmentConnectionFake) FindEquipmentByID(ctx context.Context, equipmentID string) (entities.Equipamento, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Equipamento{}, err
+	}
+
+	for _, equipmentValue := range *db.connection {
+		if equipmentValue.ID == equipmentID {
+			return equipmentValue, nil
+		}
+	}
+
+	return entities.Equipamento{}, repositories.ErrNotFound
+}
+
+func (db *equipmentConnectionFake) FindEquipmentBySerial(ctx context.Context, serial string) (entities.Equipamento, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Equipamento{}, err
+	}
+
+	for _, equipmentValue := range *db.connection {
+		if equipmentValue.Serial == serial {
+			return equipmentValue, nil
+		}
+	}
+
+	return entities.Equipamento{}, repositories.ErrNotFound
+}
+
+func (db *equipmentConnectionFake) FindEquipments(ctx context.Context, filter dtos.EquipmentFilter) ([]entities.Equipamento, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	equipments := []entities.Equipamento{}
+
+	for _, equipmentValue := range *db.connection {
+		if (filter.PontoID != "" && equipmentValue.PontoID != filter.PontoID) ||
+			(filter.Estado != "" && equipmentValue.Estado != filter.Estado) ||
+			(filter.DevolucaoPendente != nil && equipmentValue.DevolucaoPendente != *filter.DevolucaoPendente) {
+			continue
+		}
+
+		equipments = append(equipments, equipmentValue)
+	}
+
+	sort.SliceStable(equipments, func(i, j int) bool {
+		return equipments[i].Serial < equipments[j].Serial
+	})
+
+	return equipments, nil
+}
+
+func (db *equipmentConnectionFake) FindEquipmentsToReturn(ctx context.Context) ([]entities.Equipamento, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	equipments := []entities.Equipamento{}
+
+	for _, equipmentValue := range *db.connection {
+		if equipmentValue.Estado != entities.INSTALADO {
+			continue
+		}
+
+		if !equipmentValue.DevolucaoPendente && !db.hasOnlyCancelledContracts(equipmentValue.PontoID) {
+			continue
+		}
+
+		// Os pontos e clientes removidos também são carregados, como o unscoped do repositorio.
+		for _, pointValue := range *db.connectionPoint {
+			if pointValue.ID == equipmentValue.PontoID {
+				equipmentValue.Ponto = pointValue
+			}
+		}
+
+		for _, clientValue := range *db.connectionClient {
+			if clientValue.ID == equipmentValue.Ponto.ClienteID {
+				equipmentValue.Ponto.Cliente = clientValue
+			}
+		}
+
+		equipments = append(equipments, equipmentValue)
+	}
+
+	sort.SliceStable(equipments, func(i, j int) bool {
+		if equipments[i].PontoID != equipments[j].PontoID {
+			return equipments[i].PontoID < equipments[j].PontoID
+		}
+
+		return equipments[i].Serial < equipments[j].Serial
+	})
+
+	return equipments, nil
+}
+
+// hasOnlyCancelledContracts verifica se o ponto tem contrato cancelado e nenhum contrato atual.
+func (db *equipmentConnectionFake) hasOnlyCancelledContracts(pointID string) bool {
+	cancelled := false
+
+	for _, contractValue := range *db.connectionContract {
+		if contractValue.PontoID != pointID || contractValue.DataRemocao.Valid {
+			continue
+		}
+
+		if contractValue.Estado != entities.CANCELADO {
+			return false
+		}
+
+		cancelled = true
+	}
+
+	return cancelled
+}
+
+// NewEquipmentRepositoryFake cria uma nova instancia de EquipmentRepository para os testes.
+func NewEquipmentRepositoryFake(database *[]entities.Equipamento, connectionContract *[]entities.Contrato, connectionPoint *[]entities.Ponto, connectionClient *[]entities.Cliente) repositories.EquipmentRepository {
+	return &equipmentConnectionFake{
+		connection:         database,
+		connectionContract: connectionContract,
+		connectionPoint:    connectionPoint,
+		connectionClient:   connectionClient,
+	}
+}
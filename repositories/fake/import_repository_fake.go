@@ -0,0 +1,115 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+)
+
+type importConnectionFake struct {
+	connectionClient          *[]entities.Cliente
+	connectionAddress         *[]entities.Endereco
+	connectionPoint           *[]entities.Ponto
+	connectionContact         *[]entities.Contato
+	connectionContract        *[]entities.Contrato
+	connectionContractEvent   *[]entities.ContratoEvento
+	connectionContractVersion *[]entities.ContratoVersao
+	connectionServiceOrder    *[]entities.OrdemServico
+}
+
+func (db *importConnectionFake) ImportRows(ctx context.Context, rows []dtos.ImportRowPlan) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+
+	for _, row := range rows {
+		row.Cliente.DataAtualizacao = now
+		row.Endereco.DataAtualizacao = now
+		row.Ponto.DataAtualizacao = now
+
+		switch row.AcaoCliente {
+		case dtos.IMPORTACAO_CRIAR:
+			row.Cliente.DataCriacao = now
+			*db.connectionClient = append(*db.connectionClient, row.Cliente)
+		case dtos.IMPORTACAO_RESTAURAR:
+			for i, clientValue := range *db.connectionClient {
+				if clientValue.ID == row.Cliente.ID {
+					(*db.connectionClient)[i] = row.Cliente
+				}
+			}
+		}
+
+		switch row.AcaoEndereco {
+		case dtos.IMPORTACAO_CRIAR:
+			row.Endereco.DataCriacao = now
+			*db.connectionAddress = append(*db.connectionAddress, row.Endereco)
+		case dtos.IMPORTACAO_RESTAURAR:
+			for i, addressValue := range *db.connectionAddress {
+				if addressValue.ID == row.Endereco.ID {
+					(*db.connectionAddress)[i] = row.Endereco
+				}
+			}
+		}
+
+		switch row.AcaoPonto {
+		case dtos.IMPORTACAO_CRIAR:
+			row.Ponto.DataCriacao = now
+			*db.connectionPoint = append(*db.connectionPoint, row.Ponto)
+		case dtos.IMPORTACAO_RESTAURAR:
+			for i, pointValue := range *db.connectionPoint {
+				if pointValue.ID == row.Ponto.ID {
+					(*db.connectionPoint)[i] = row.Ponto
+				}
+			}
+		}
+
+		if row.Contrato == nil {
+			continue
+		}
+
+		if row.Contato != nil {
+			row.Contato.DataAtualizacao = now
+
+			switch row.AcaoContato {
+			case dtos.IMPORTACAO_CRIAR:
+				row.Contato.DataCriacao = now
+				*db.connectionContact = append(*db.connectionContact, *row.Contato)
+			case dtos.IMPORTACAO_RESTAURAR:
+				for i, contactValue := range *db.connectionContact {
+					if contactValue.ID == row.Contato.ID {
+						(*db.connectionContact)[i] = *row.Contato
+					}
+				}
+			}
+		}
+
+		*db.connectionContract = append(*db.connectionContract, *row.Contrato)
+		*db.connectionContractEvent = append(*db.connectionContractEvent, row.ContratoEventos...)
+		*db.connectionContractVersion = append(*db.connectionContractVersion, *row.ContratoVersao)
+
+		if row.OrdemServico != nil {
+			*db.connectionServiceOrder = append(*db.connectionServiceOrder, *row.OrdemServico)
+		}
+	}
+
+	return nil
+}
+
+// NewImportRepositoryFake cria uma nova instancia de ImportRepository para os testes.
+func NewImportRepositoryFake(connectionClient *[]entities.Cliente, connectionAddress *[]entities.Endereco, connectionPoint *[]entities.Ponto, connectionContact *[]entities.Contato, connectionContract *[]entities.Contrato, connectionContractEvent *[]entities.ContratoEvento, connectionContractVersion *[]entities.ContratoVersao, connectionServiceOrder *[]entities.OrdemServico) repositories.ImportRepository {
+	return &importConnectionFake{
+		connectionClient:          connectionClient,
+		connectionAddress:         connectionAddress,
+		connectionPoint:           connectionPoint,
+		connectionContact:         connectionContact,
+		connectionContract:        connectionContract,
+		connectionContractEvent:   connectionContractEvent,
+		connectionContractVersion: connectionContractVersion,
+		connectionServiceOrder:    connectionServiceOrder,
+	}
+}
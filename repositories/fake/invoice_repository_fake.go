@@ -0,0 +1,125 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBInvoice banco de dados fake de faturas para os testes
+var DBInvoice = &[]entities.Fatura{}
+
+type invoiceConnectionFake struct {
+	connection *[]entities.Fatura
+}
+
+func (db *invoiceConnectionFake) CreateInvoice(ctx context.Context, invoice entities.Fatura) (entities.Fatura, error) {
+	invoiceID, _ := uuid.NewV4()
+
+	invoice.ID = invoiceID.String()
+	invoice.DataCriacao = time.Now()
+	invoice.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, invoice)
+
+	return invoice, nil
+}
+
+func (db *invoiceConnectionFake) FindInvoiceByID(ctx context.Context, invoiceID string) (entities.Fatura, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Fatura{}, err
+	}
+
+	for _, invoiceValue := range *db.connection {
+		if invoiceValue.ID == invoiceID {
+			return invoiceValue, nil
+		}
+	}
+
+	return entities.Fatura{}, repositories.ErrNotFound
+}
+
+func (db *invoiceConnectionFake) FindInvoiceByClientIDAndCompetencia(ctx context.Context, clientID string, competencia string) (entities.Fatura, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Fatura{}, err
+	}
+
+	for _, invoiceValue := range *db.connection {
+		if invoiceValue.ClienteID == clientID && invoiceValue.Competencia == competencia {
+			return invoiceValue, nil
+		}
+	}
+
+	return entities.Fatura{}, repositories.ErrNotFound
+}
+
+func (db *invoiceConnectionFake) FindInvoices(ctx context.Context, clientID string, competencia string) ([]entities.Fatura, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	invoices := []entities.Fatura{}
+
+	for _, invoiceValue := range *db.connection {
+		if (clientID == "" || invoiceValue.ClienteID == clientID) && (competencia == "" || invoiceValue.Competencia == competencia) {
+			invoices = append(invoices, invoiceValue)
+		}
+	}
+
+	sort.SliceStable(invoices, func(i, j int) bool {
+		if invoices[i].Competencia != invoices[j].Competencia {
+			return invoices[i].Competencia > invoices[j].Competencia
+		}
+
+		return invoices[i].ClienteID < invoices[j].ClienteID
+	})
+
+	return invoices, nil
+}
+
+func (db *invoiceConnectionFake) UpdateInvoice(ctx context.Context, invoice entities.Fatura) (entities.Fatura, error) {
+	invoice.DataAtualizacao = time.Now()
+
+	for i, invoiceValue := range *db.connection {
+		if invoiceValue.ID == invoice.ID {
+			(*db.connection)[i] = invoice
+		}
+	}
+
+	return invoice, nil
+}
+
+func (db *invoiceConnectionFake) FindUnpaidInvoicesDueBefore(ctx context.Context, date time.Time) ([]entities.Fatura, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	invoices := []entities.Fatura{}
+
+	for _, invoiceValue := range *db.connection {
+		if invoiceValue.Estado != entities.PAGA && invoiceValue.Vencimento.Before(date) {
+			invoices = append(invoices, invoiceValue)
+		}
+	}
+
+	sort.SliceStable(invoices, func(i, j int) bool {
+		if !invoices[i].Vencimento.Equal(invoices[j].Vencimento) {
+			return invoices[i].Vencimento.Before(invoices[j].Vencimento)
+		}
+
+		return invoices[i].ClienteID < invoices[j].ClienteID
+	})
+
+	return invoices, nil
+}
+
+// NewInvoiceRepositoryFake cria uma nova instancia de InvoiceRepository para os testes.
+func NewInvoiceRepositoryFake(database *[]entities.Fatura) repositories.InvoiceRepository {
+	return &invoiceConnectionFake{
+		connection: database,
+	}
+}
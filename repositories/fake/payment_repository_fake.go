@@ -0,0 +1,97 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBPayment banco de dados fake de pagamentos para os testes
+var DBPayment = &[]entities.Pagamento{}
+
+type paymentConnectionFake struct {
+	connection        *[]entities.Pagamento
+	connectionInvoice *[]entities.Fatura
+}
+
+func (db *paymentConnectionFake) CreatePayment(ctx context.Context, payment entities.Pagamento) (entities.Pagamento, entities.Fatura, error) {
+	for _, paymentValue := range *db.connection {
+		if payment.Identificador != "" && paymentValue.Identificador == payment.Identificador {
+			return payment, entities.Fatura{}, repositories.ErrAlreadyExists
+		}
+	}
+
+	for i, invoiceValue := range *db.connectionInvoice {
+		if invoiceValue.ID != payment.FaturaID {
+			continue
+		}
+
+		if invoiceValue.ValorPago >= invoiceValue.Total {
+			return payment, entities.Fatura{}, repositories.ErrInvoicePaid
+		}
+
+		paymentID, _ := uuid.NewV4()
+
+		payment.ID = paymentID.String()
+		payment.DataCriacao = time.Now()
+		payment.DataAtualizacao = time.Now()
+
+		*db.connection = append(*db.connection, payment)
+
+		invoiceValue.ValorPago += payment.Valor
+		invoiceValue.Estado = dtos.InvoiceState(invoiceValue, time.Now())
+		invoiceValue.DataAtualizacao = time.Now()
+		(*db.connectionInvoice)[i] = invoiceValue
+
+		return payment, invoiceValue, nil
+	}
+
+	return payment, entities.Fatura{}, repositories.ErrNotFound
+}
+
+func (db *paymentConnectionFake) FindPaymentByIdentifier(ctx context.Context, identifier string) (entities.Pagamento, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Pagamento{}, err
+	}
+
+	for _, paymentValue := range *db.connection {
+		if paymentValue.Identificador == identifier {
+			return paymentValue, nil
+		}
+	}
+
+	return entities.Pagamento{}, repositories.ErrNotFound
+}
+
+func (db *paymentConnectionFake) FindPaymentsByInvoiceID(ctx context.Context, invoiceID string) ([]entities.Pagamento, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	payments := []entities.Pagamento{}
+
+	for _, paymentValue := range *db.connection {
+		if paymentValue.FaturaID == invoiceID {
+			payments = append(payments, paymentValue)
+		}
+	}
+
+	sort.SliceStable(payments, func(i, j int) bool {
+		return payments[i].DataPagamento.Before(payments[j].DataPagamento)
+	})
+
+	return payments, nil
+}
+
+// NewPaymentRepositoryFake cria uma nova instancia de PaymentRepository para os testes.
+func NewPaymentRepositoryFake(database *[]entities.Pagamento, databaseInvoice *[]entities.Fatura) repositories.PaymentRepository {
+	return &paymentConnectionFake{
+		connection:        database,
+		connectionInvoice: databaseInvoice,
+	}
+}
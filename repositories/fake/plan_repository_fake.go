@@ -0,0 +1,130 @@
+package repositories
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBPlan banco de dados fake de planos para os testes
+var DBPlan = &[]entities.Plano{}
+
+type planConnectionFake struct {
+	connection *[]entities.Plano
+}
+
+func (db *planConnectionFake) CreatePlan(ctx context.Context, plan entities.Plano) (entities.Plano, error) {
+	planID, _ := uuid.NewV4()
+
+	plan.ID = planID.String()
+	plan.DataCriacao = time.Now()
+	plan.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, plan)
+
+	return plan, nil
+}
+
+func (db *planConnectionFake) UpdatePlan(ctx context.Context, plan entities.Plano) (entities.Plano, error) {
+	plan.DataAtualizacao = time.Now()
+	plan.DataRemocao.Valid = false
+
+	for i, planValue := range *db.connection {
+		if planValue.ID == plan.ID {
+			(*db.connection)[i] = plan
+		}
+	}
+
+	return plan, nil
+}
+
+func (db *planConnectionFake) FindPlanByID(ctx context.Context, planID string) (entities.Plano, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Plano{}, err
+	}
+
+	for _, planValue := range *db.connection {
+		if planValue.ID == planID && !planValue.DataRemocao.Valid {
+			return planValue, nil
+		}
+	}
+
+	return entities.Plano{}, repositories.ErrNotFound
+}
+
+func (db *planConnectionFake) FindPlanByName(ctx context.Context, name string) (entities.Plano, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Plano{}, err
+	}
+
+	for _, planValue := range *db.connection {
+		if planValue.Nome == name {
+			return planValue, nil
+		}
+	}
+
+	return entities.Plano{}, repositories.ErrNotFound
+}
+
+func (db *planConnectionFake) DeletePlan(ctx context.Context, plan entities.Plano) error {
+	for i, planValue := range *db.connection {
+		if planValue.ID == plan.ID {
+			(*db.connection)[i].DataRemocao.Scan(time.Now())
+		}
+	}
+
+	return nil
+}
+
+func (db *planConnectionFake) FindPlans(ctx context.Context, name string) ([]entities.Plano, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	plans := []entities.Plano{}
+
+	for _, planValue := range *db.connection {
+		if !planValue.DataRemocao.Valid && strings.Contains(strings.ToLower(planValue.Nome), strings.ToLower(name)) {
+			plans = append(plans, planValue)
+		}
+	}
+
+	sort.SliceStable(plans, func(i, j int) bool {
+		if plans[i].PrecoMensal != plans[j].PrecoMensal {
+			return plans[i].PrecoMensal < plans[j].PrecoMensal
+		}
+
+		return plans[i].Nome < plans[j].Nome
+	})
+
+	return plans, nil
+}
+
+func (db *planConnectionFake) FindPlansByIDs(ctx context.Context, planIDs []string) ([]entities.Plano, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	plans := []entities.Plano{}
+
+	for _, planValue := range *db.connection {
+		if slices.Contains(planIDs, planValue.ID) {
+			plans = append(plans, planValue)
+		}
+	}
+
+	return plans, nil
+}
+
+// NewPlanRepositoryFake cria uma nova instancia de PlanRepository para os testes.
+func NewPlanRepositoryFake(database *[]entities.Plano) repositories.PlanRepository {
+	return &planConnectionFake{
+		connection: database,
+	}
+}
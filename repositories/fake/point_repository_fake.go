@@ -1,9 +1,11 @@
 package repositories
 
 import (
+	"context"
 	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
 	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
 	"github.com/gofrs/uuid"
 )
@@ -12,12 +14,14 @@ import (
 var DBPoint = &[]entities.Ponto{}
 
 type pointConnectionFake struct {
-	connection        *[]entities.Ponto
-	connectionClient  *[]entities.Cliente
-	connectionAddress *[]entities.Endereco
+	connection         *[]entities.Ponto
+	connectionClient   *[]entities.Cliente
+	connectionAddress  *[]entities.Endereco
+	connectionEvent    *[]entities.ContratoEvento
+	connectionContract *[]entities.Contrato
 }
 
-func (db *pointConnectionFake) CreatePoint(point entities.Ponto) (entities.Ponto, error) {
+func (db *pointConnectionFake) CreatePoint(ctx context.Context, point entities.Ponto) (entities.Ponto, error) {
 	pointID, _ := uuid.NewV4()
 
 	point.ID = pointID.String()
@@ -29,7 +33,7 @@ func (db *pointConnectionFake) CreatePoint(point entities.Ponto) (entities.Ponto
 	return point, nil
 }
 
-func (db *pointConnectionFake) UpdatePoint(point entities.Ponto) (entities.Ponto, error) {
+func (db *pointConnectionFake) UpdatePoint(ctx context.Context, point entities.Ponto) (entities.Ponto, error) {
 	point.DataAtualizacao = time.Now()
 	point.DataRemocao.Valid = false
 
@@ -42,7 +46,31 @@ func (db *pointConnectionFake) UpdatePoint(point entities.Ponto) (entities.Ponto
 	return point, nil
 }
 
-func (db *pointConnectionFake) FindPointByID(pointID string) entities.Ponto {
+func (db *pointConnectionFake) TransferPoint(ctx context.Context, point entities.Ponto, contractEvent *entities.ContratoEvento, contactID string) (entities.Ponto, error) {
+	point, _ = db.UpdatePoint(ctx, point)
+
+	if contractEvent != nil {
+		for i, contractValue := range *db.connectionContract {
+			if contractValue.ID == contractEvent.ContratoID {
+				(*db.connectionContract)[i].ContatoResponsavelID = contactID
+			}
+		}
+
+		contractEventID, _ := uuid.NewV4()
+
+		contractEvent.ID = contractEventID.String()
+
+		*db.connectionEvent = append(*db.connectionEvent, *contractEvent)
+	}
+
+	return point, nil
+}
+
+func (db *pointConnectionFake) FindPointByID(ctx context.Context, pointID string) (entities.Ponto, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Ponto{}, err
+	}
+
 	point := entities.Ponto{}
 
 	for _, pointValue := range *db.connection {
@@ -51,10 +79,18 @@ func (db *pointConnectionFake) FindPointByID(pointID string) entities.Ponto {
 		}
 	}
 
-	return point
+	if point.ID == "" {
+		return entities.Ponto{}, repositories.ErrNotFound
+	}
+
+	return point, nil
 }
 
-func (db *pointConnectionFake) FindPointByClientIDAndAddressID(clientID string, addressID string) entities.Ponto {
+func (db *pointConnectionFake) FindPointByClientIDAndAddressID(ctx context.Context, clientID string, addressID string) (entities.Ponto, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Ponto{}, err
+	}
+
 	point := entities.Ponto{}
 
 	for _, pointValue := range *db.connection {
@@ -63,10 +99,18 @@ func (db *pointConnectionFake) FindPointByClientIDAndAddressID(clientID string,
 		}
 	}
 
-	return point
+	if point.ID == "" {
+		return entities.Ponto{}, repositories.ErrNotFound
+	}
+
+	return point, nil
 }
 
-func (db *pointConnectionFake) FindPointsByClientID(clientID string) []entities.Ponto {
+func (db *pointConnectionFake) FindPointsByClientID(ctx context.Context, clientID string) ([]entities.Ponto, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	points := []entities.Ponto{}
 
 	for _, pointValue := range *db.connection {
@@ -75,10 +119,14 @@ func (db *pointConnectionFake) FindPointsByClientID(clientID string) []entities.
 		}
 	}
 
-	return points
+	return points, nil
 }
 
-func (db *pointConnectionFake) FindPointsByAddressID(addressID string) []entities.Ponto {
+func (db *pointConnectionFake) FindPointsByAddressID(ctx context.Context, addressID string) ([]entities.Ponto, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	points := []entities.Ponto{}
 
 	for _, pointValue := range *db.connection {
@@ -87,10 +135,10 @@ func (db *pointConnectionFake) FindPointsByAddressID(addressID string) []entitie
 		}
 	}
 
-	return points
+	return points, nil
 }
 
-func (db *pointConnectionFake) DeletePoint(point entities.Ponto) error {
+func (db *pointConnectionFake) DeletePoint(ctx context.Context, point entities.Ponto) error {
 	for i, pointValue := range *db.connection {
 		if pointValue.ID == point.ID {
 			(*db.connection)[i].DataRemocao.Scan(time.Now())
@@ -100,7 +148,11 @@ func (db *pointConnectionFake) DeletePoint(point entities.Ponto) error {
 	return nil
 }
 
-func (db *pointConnectionFake) FindPoints(clientID string, addressID string) []entities.Ponto {
+func (db *pointConnectionFake) FindPoints(ctx context.Context, clientID string, addressID string) ([]entities.Ponto, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
 	points := []entities.Ponto{}
 
 	if clientID != "" && addressID != "" {
@@ -146,14 +198,59 @@ func (db *pointConnectionFake) FindPoints(clientID string, addressID string) []e
 		}
 	}
 
-	return points
+	return points, nil
+}
+
+func (db *pointConnectionFake) FindPointsInArea(ctx context.Context, filter dtos.PointGeoFilter) ([]entities.Ponto, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	points := []entities.Ponto{}
+
+	for _, pointValue := range *db.connection {
+		if pointValue.DataRemocao.Valid {
+			continue
+		}
+
+		for _, address := range *db.connectionAddress {
+			if address.ID != pointValue.EnderecoID || address.DataRemocao.Valid ||
+				address.Latitude == nil || address.Longitude == nil {
+				continue
+			}
+
+			coordinate := dtos.Coordinate{Latitude: *address.Latitude, Longitude: *address.Longitude}
+			inside := true
+
+			for _, box := range filter.BoundingBoxes() {
+				inside = inside && box.Contains(coordinate)
+			}
+
+			if inside {
+				pointValue.Endereco = address
+				points = append(points, pointValue)
+			}
+		}
+	}
+
+	for i, point := range points {
+		for _, client := range *db.connectionClient {
+			if point.ClienteID == client.ID {
+				points[i].Cliente = client
+			}
+		}
+	}
+
+	return points, nil
 }
 
 // NewPointRepositoryFake cria uma nova instancia de PointRepository para os testes.
-func NewPointRepositoryFake(database *[]entities.Ponto, connectionClient *[]entities.Cliente, connectionAddress *[]entities.Endereco) repositories.PointRepository {
+func NewPointRepositoryFake(database *[]entities.Ponto, connectionClient *[]entities.Cliente, connectionAddress *[]entities.Endereco, connectionEvent *[]entities.ContratoEvento, connectionContract *[]entities.Contrato) repositories.PointRepository {
 	return &pointConnectionFake{
-		connection:        database,
-		connectionClient:  connectionClient,
-		connectionAddress: connectionAddress,
+		connection:         database,
+		connectionClient:   connectionClient,
+		connectionAddress:  connectionAddress,
+		connectionEvent:    connectionEvent,
+		connectionContract: connectionContract,
 	}
 }
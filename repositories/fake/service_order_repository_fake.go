@@ -0,0 +1,143 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBServiceOrder banco de dados fake de ordens de serviço para os testes
+var DBServiceOrder = &[]entities.OrdemServico{}
+
+type serviceOrderConnectionFake struct {
+	connection *[]entities.OrdemServico
+	mutex      sync.Mutex
+}
+
+func (db *serviceOrderConnectionFake) CreateServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	return db.createServiceOrder(serviceOrder), nil
+}
+
+func (db *serviceOrderConnectionFake) UpdateServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	return db.updateServiceOrder(serviceOrder), nil
+}
+
+// createServiceOrder registra a ordem de serviço, com o mutex já bloqueado.
+func (db *serviceOrderConnectionFake) createServiceOrder(serviceOrder entities.OrdemServico) entities.OrdemServico {
+	serviceOrderID, _ := uuid.NewV4()
+
+	serviceOrder.ID = serviceOrderID.String()
+	serviceOrder.DataCriacao = time.Now()
+	serviceOrder.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, serviceOrder)
+
+	return serviceOrder
+}
+
+// updateServiceOrder altera a ordem de serviço, com o mutex já bloqueado.
+func (db *serviceOrderConnectionFake) updateServiceOrder(serviceOrder entities.OrdemServico) entities.OrdemServico {
+	serviceOrder.DataAtualizacao = time.Now()
+
+	for i, serviceOrderValue := range *db.connection {
+		if serviceOrderValue.ID == serviceOrder.ID {
+			(*db.connection)[i] = serviceOrder
+		}
+	}
+
+	return serviceOrder
+}
+
+func (db *serviceOrderConnectionFake) BookServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error) {
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	for _, serviceOrderValue := range *db.connection {
+		if serviceOrderValue.Tecnico != serviceOrder.Tecnico || serviceOrderValue.ID == serviceOrder.ID ||
+			serviceOrderValue.Estado == entities.ORDEM_CANCELADA || serviceOrderValue.InicioJanela == nil {
+			continue
+		}
+
+		if serviceOrderValue.InicioJanela.Before(*serviceOrder.FimJanela) && serviceOrderValue.FimJanela.After(*serviceOrder.InicioJanela) {
+			return serviceOrder, repositories.ErrScheduleConflict
+		}
+	}
+
+	if serviceOrder.ID == "" {
+		return db.createServiceOrder(serviceOrder), nil
+	}
+
+	return db.updateServiceOrder(serviceOrder), nil
+}
+
+func (db *serviceOrderConnectionFake) FindServiceOrderByID(ctx context.Context, serviceOrderID string) (entities.OrdemServico, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.OrdemServico{}, err
+	}
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	for _, serviceOrderValue := range *db.connection {
+		if serviceOrderValue.ID == serviceOrderID {
+			return serviceOrderValue, nil
+		}
+	}
+
+	return entities.OrdemServico{}, repositories.ErrNotFound
+}
+
+func (db *serviceOrderConnectionFake) FindServiceOrders(ctx context.Context, filter dtos.ServiceOrderFilter) ([]entities.OrdemServico, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	db.mutex.Lock()
+	defer db.mutex.Unlock()
+
+	serviceOrders := []entities.OrdemServico{}
+
+	for _, serviceOrderValue := range *db.connection {
+		if (filter.PontoID != "" && serviceOrderValue.PontoID != filter.PontoID) ||
+			(filter.ContratoID != "" && serviceOrderValue.ContratoID != filter.ContratoID) ||
+			(filter.Tecnico != "" && serviceOrderValue.Tecnico != filter.Tecnico) ||
+			(filter.Tipo != "" && serviceOrderValue.Tipo != filter.Tipo) ||
+			(filter.Estado != "" && serviceOrderValue.Estado != filter.Estado) ||
+			(filter.De != nil && (serviceOrderValue.FimJanela == nil || !serviceOrderValue.FimJanela.After(*filter.De))) ||
+			(filter.Ate != nil && (serviceOrderValue.InicioJanela == nil || !serviceOrderValue.InicioJanela.Before(*filter.Ate))) {
+			continue
+		}
+
+		serviceOrders = append(serviceOrders, serviceOrderValue)
+	}
+
+	// As ordens sem janela ficam por ultimo, como o NULLS LAST do repositorio.
+	sort.SliceStable(serviceOrders, func(i, j int) bool {
+		if serviceOrders[i].InicioJanela == nil || serviceOrders[j].InicioJanela == nil {
+			return serviceOrders[i].InicioJanela != nil && serviceOrders[j].InicioJanela == nil
+		}
+
+		return serviceOrders[i].InicioJanela.Before(*serviceOrders[j].InicioJanela)
+	})
+
+	return serviceOrders, nil
+}
+
+// NewServiceOrderRepositoryFake cria uma nova instancia de ServiceOrderRepository para os testes.
+func NewServiceOrderRepositoryFake(database *[]entities.OrdemServico) repositories.ServiceOrderRepository {
+	return &serviceOrderConnectionFake{
+		connection: database,
+	}
+}
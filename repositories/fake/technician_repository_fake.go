@@ -0,0 +1,87 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBTechnician banco de dados fake de tecnicos para os testes
+var DBTechnician = &[]entities.Tecnico{}
+
+type technicianConnectionFake struct {
+	connection *[]entities.Tecnico
+}
+
+func (db *technicianConnectionFake) CreateTechnician(ctx context.Context, technician entities.Tecnico) (entities.Tecnico, error) {
+	technicianID, _ := uuid.NewV4()
+
+	technician.ID = technicianID.String()
+	technician.DataCriacao = time.Now()
+	technician.DataAtualizacao = time.Now()
+
+	for i := range technician.Bairros {
+		neighborhoodID, _ := uuid.NewV4()
+
+		technician.Bairros[i].ID = neighborhoodID.String()
+		technician.Bairros[i].TecnicoID = technician.ID
+	}
+
+	*db.connection = append(*db.connection, technician)
+
+	return technician, nil
+}
+
+func (db *technicianConnectionFake) FindTechnicianByName(ctx context.Context, name string) (entities.Tecnico, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Tecnico{}, err
+	}
+
+	for _, technicianValue := range *db.connection {
+		if strings.EqualFold(technicianValue.Nome, name) {
+			return technicianValue, nil
+		}
+	}
+
+	return entities.Tecnico{}, repositories.ErrNotFound
+}
+
+func (db *technicianConnectionFake) FindTechnicians(ctx context.Context, neighborhoodKey string) ([]entities.Tecnico, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	technicians := []entities.Tecnico{}
+
+	for _, technicianValue := range *db.connection {
+		if neighborhoodKey == "" {
+			technicians = append(technicians, technicianValue)
+			continue
+		}
+
+		for _, neighborhood := range technicianValue.Bairros {
+			if neighborhood.BairroNormalizado == neighborhoodKey {
+				technicians = append(technicians, technicianValue)
+				break
+			}
+		}
+	}
+
+	sort.SliceStable(technicians, func(i, j int) bool {
+		return technicians[i].Nome < technicians[j].Nome
+	})
+
+	return technicians, nil
+}
+
+// NewTechnicianRepositoryFake cria uma nova instancia de TechnicianRepository para os testes.
+func NewTechnicianRepositoryFake(database *[]entities.Tecnico) repositories.TechnicianRepository {
+	return &technicianConnectionFake{
+		connection: database,
+	}
+}
@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/gofrs/uuid"
+)
+
+// DBTicket banco de dados fake de chamados para os testes
+var DBTicket = &[]entities.Chamado{}
+
+type ticketConnectionFake struct {
+	connection *[]entities.Chamado
+}
+
+func (db *ticketConnectionFake) CreateTicket(ctx context.Context, ticket entities.Chamado) (entities.Chamado, error) {
+	ticketID, _ := uuid.NewV4()
+
+	ticket.ID = ticketID.String()
+	ticket.DataCriacao = time.Now()
+	ticket.DataAtualizacao = time.Now()
+
+	*db.connection = append(*db.connection, ticket)
+
+	return ticket, nil
+}
+
+func (db *ticketConnectionFake) UpdateTicket(ctx context.Context, ticket entities.Chamado) (entities.Chamado, error) {
+	ticket.DataAtualizacao = time.Now()
+
+	for i, ticketValue := range *db.connection {
+		if ticketValue.ID == ticket.ID {
+			// Os comentarios são gravados apenas por CreateTicketComment, como o Omit do repositorio.
+			ticket.Comentarios = ticketValue.Comentarios
+			(*db.connection)[i] = ticket
+		}
+	}
+
+	return ticket, nil
+}
+
+func (db *ticketConnectionFake) FindTicketByID(ctx context.Context, ticketID string) (entities.Chamado, error) {
+	if err := ctx.Err(); err != nil {
+		return entities.Chamado{}, err
+	}
+
+	for _, ticketValue := range *db.connection {
+		if ticketValue.ID == ticketID {
+			ticketValue.Comentarios = append([]entities.ChamadoComentario{}, ticketValue.Comentarios...)
+
+			return ticketValue, nil
+		}
+	}
+
+	return entities.Chamado{}, repositories.ErrNotFound
+}
+
+func (db *ticketConnectionFake) FindTickets(ctx context.Context, filter dtos.TicketFilter) ([]entities.Chamado, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	tickets := []entities.Chamado{}
+	now := time.Now()
+
+	for _, ticketValue := range *db.connection {
+		if (filter.ClienteID != "" && ticketValue.ClienteID != filter.ClienteID) ||
+			(filter.Estado != "" && ticketValue.Estado != filter.Estado) ||
+			(filter.Prioridade != "" && ticketValue.Prioridade != filter.Prioridade) ||
+			(filter.Categoria != "" && ticketValue.Categoria != filter.Categoria) ||
+			(filter.Abertos && dtos.IsTicketClosed(ticketValue.Estado)) ||
+			(filter.Atrasados && !dtos.IsTicketOverdue(ticketValue, now)) {
+			continue
+		}
+
+		ticketValue.Comentarios = nil
+
+		tickets = append(tickets, ticketValue)
+	}
+
+	sort.SliceStable(tickets, func(i, j int) bool {
+		return tickets[i].PrazoSLA.Before(tickets[j].PrazoSLA)
+	})
+
+	return tickets, nil
+}
+
+func (db *ticketConnectionFake) CreateTicketComment(ctx context.Context, comment entities.ChamadoComentario) (entities.ChamadoComentario, error) {
+	commentID, _ := uuid.NewV4()
+
+	comment.ID = commentID.String()
+	comment.DataCriacao = time.Now()
+	comment.DataAtualizacao = time.Now()
+
+	for i, ticketValue := range *db.connection {
+		if ticketValue.ID == comment.ChamadoID {
+			(*db.connection)[i].Comentarios = append(ticketValue.Comentarios, comment)
+		}
+	}
+
+	return comment, nil
+}
+
+// NewTicketRepositoryFake cria uma nova instancia de TicketRepository para os testes.
+func NewTicketRepositoryFake(database *[]entities.Chamado) repositories.TicketRepository {
+	return &ticketConnectionFake{
+		connection: database,
+	}
+}
@@ -1,8 +1,9 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"gorm.io/gorm"
@@ -10,20 +11,25 @@ import (
 
 // AddressRepository representa o contracto de AddressRepository.
 type AddressRepository interface {
-	CreateAddress(address entities.Endereco) (entities.Endereco, error)
-	UpdateAddress(address entities.Endereco) (entities.Endereco, error)
-	FindAddressByID(addressID string) entities.Endereco
-	FindAddressByFields(street string, neighborhood string, number int) entities.Endereco
-	DeleteAddress(address entities.Endereco) error
-	FindAddresses(street string, neighborhood string, number string) []entities.Endereco
+	CreateAddress(ctx context.Context, address entities.Endereco) (entities.Endereco, error)
+	UpdateAddress(ctx context.Context, address entities.Endereco) (entities.Endereco, error)
+	FindAddressByID(ctx context.Context, addressID string) (entities.Endereco, error)
+	FindAddressByFields(ctx context.Context, fields entities.Endereco) (entities.Endereco, error)
+	DeleteAddress(ctx context.Context, address entities.Endereco) error
+	FindAddresses(ctx context.Context, street string, neighborhood string, number string) ([]entities.Endereco, error)
+	FindAddressesByCEPAndNumber(ctx context.Context, cep string, number int) ([]entities.Endereco, error)
 }
 
 type addressConnection struct {
 	connection *gorm.DB
+	logger     *slog.Logger
 }
 
-func (db *addressConnection) CreateAddress(address entities.Endereco) (entities.Endereco, error) {
-	err := db.connection.Create(&address).Error
+func (db *addressConnection) CreateAddress(ctx context.Context, address entities.Endereco) (entities.Endereco, error) {
+	ctx, span := tracer.Start(ctx, "AddressRepository.CreateAddress")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&address).Error
 	if err != nil {
 		return address, err
 	}
@@ -31,8 +37,11 @@ func (db *addressConnection) CreateAddress(address entities.Endereco) (entities.
 	return address, nil
 }
 
-func (db *addressConnection) UpdateAddress(address entities.Endereco) (entities.Endereco, error) {
-	err := db.connection.Save(&address).Error
+func (db *addressConnection) UpdateAddress(ctx context.Context, address entities.Endereco) (entities.Endereco, error) {
+	ctx, span := tracer.Start(ctx, "AddressRepository.UpdateAddress")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&address).Error
 	if err != nil {
 		return address, err
 	}
@@ -40,31 +49,39 @@ func (db *addressConnection) UpdateAddress(address entities.Endereco) (entities.
 	return address, nil
 }
 
-func (db *addressConnection) FindAddressByID(addressID string) entities.Endereco {
+func (db *addressConnection) FindAddressByID(ctx context.Context, addressID string) (entities.Endereco, error) {
+	ctx, span := tracer.Start(ctx, "AddressRepository.FindAddressByID")
+	defer span.End()
+
 	address := entities.Endereco{}
 
-	err := db.connection.First(&address, "id = ?", addressID).Error
+	err := db.connection.WithContext(ctx).First(&address, "id = ?", addressID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return entities.Endereco{}, queryError(ctx, db.logger, "failed to find address by id", err)
 	}
 
-	return address
+	return address, nil
 }
 
-func (db *addressConnection) FindAddressByFields(street string, neighborhood string, number int) entities.Endereco {
+func (db *addressConnection) FindAddressByFields(ctx context.Context, fields entities.Endereco) (entities.Endereco, error) {
+	ctx, span := tracer.Start(ctx, "AddressRepository.FindAddressByFields")
+	defer span.End()
+
 	address := entities.Endereco{}
 
-	err := db.connection.Unscoped().First(&address, "logradouro = ? AND bairro = ? AND numero = ?",
-		street, neighborhood, number).Error
+	err := db.connection.WithContext(ctx).Unscoped().First(&address, "chave_normalizada = ?", fields.ChaveNormalizada).Error
 	if err != nil {
-		log.Println(err.Error())
+		return entities.Endereco{}, queryError(ctx, db.logger, "failed to find address by fields", err)
 	}
 
-	return address
+	return address, nil
 }
 
-func (db *addressConnection) DeleteAddress(address entities.Endereco) error {
-	err := db.connection.Delete(&address).Error
+func (db *addressConnection) DeleteAddress(ctx context.Context, address entities.Endereco) error {
+	ctx, span := tracer.Start(ctx, "AddressRepository.DeleteAddress")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Delete(&address).Error
 	if err != nil {
 		return err
 	}
@@ -72,7 +89,10 @@ func (db *addressConnection) DeleteAddress(address entities.Endereco) error {
 	return nil
 }
 
-func (db *addressConnection) FindAddresses(street string, neighborhood string, number string) []entities.Endereco {
+func (db *addressConnection) FindAddresses(ctx context.Context, street string, neighborhood string, number string) ([]entities.Endereco, error) {
+	ctx, span := tracer.Start(ctx, "AddressRepository.FindAddresses")
+	defer span.End()
+
 	addresses := []entities.Endereco{}
 
 	var sqlQuery string
@@ -97,18 +117,32 @@ func (db *addressConnection) FindAddresses(street string, neighborhood string, n
 		sqlQuery += "AND NOT numero IS NULL"
 	}
 
-	err := db.connection.Find(&addresses, sqlQuery).Error
+	err := db.connection.WithContext(ctx).Find(&addresses, sqlQuery).Error
 	if err != nil {
-		log.Println(err.Error())
+		return nil, queryError(ctx, db.logger, "failed to find addresses", err)
 	}
 
-	return addresses
+	return addresses, nil
+}
+
+func (db *addressConnection) FindAddressesByCEPAndNumber(ctx context.Context, cep string, number int) ([]entities.Endereco, error) {
+	ctx, span := tracer.Start(ctx, "AddressRepository.FindAddressesByCEPAndNumber")
+	defer span.End()
+
+	addresses := []entities.Endereco{}
+
+	err := db.connection.WithContext(ctx).Find(&addresses, "cep = ? AND numero = ?", cep, number).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find addresses by cep and number", err)
+	}
 
+	return addresses, nil
 }
 
 // NewAddressRepository cria uma nova instancia de AddressRepository.
-func NewAddressRepository(database *gorm.DB) AddressRepository {
+func NewAddressRepository(database *gorm.DB, logger *slog.Logger) AddressRepository {
 	return &addressConnection{
 		connection: database,
+		logger:     logger,
 	}
 }
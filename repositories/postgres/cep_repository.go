@@ -0,0 +1,58 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// CEPRepository representa o contracto de CEPRepository.
+type CEPRepository interface {
+	SaveCEPs(ctx context.Context, ceps []entities.Cep) error
+	FindCEPByCode(ctx context.Context, code string) (entities.Cep, error)
+}
+
+type cepConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *cepConnection) SaveCEPs(ctx context.Context, ceps []entities.Cep) error {
+	ctx, span := tracer.Start(ctx, "CEPRepository.SaveCEPs")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "codigo"}},
+		DoUpdates: clause.AssignmentColumns([]string{"logradouro", "bairro", "cidade", "uf", "data_atualizacao"}),
+	}).Create(&ceps).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *cepConnection) FindCEPByCode(ctx context.Context, code string) (entities.Cep, error) {
+	ctx, span := tracer.Start(ctx, "CEPRepository.FindCEPByCode")
+	defer span.End()
+
+	cep := entities.Cep{}
+
+	err := db.connection.WithContext(ctx).First(&cep, "codigo = ?", code).Error
+	if err != nil {
+		return entities.Cep{}, queryError(ctx, db.logger, "failed to find cep by code", err)
+	}
+
+	return cep, nil
+}
+
+// NewCEPRepository cria uma nova instancia de CEPRepository.
+func NewCEPRepository(database *gorm.DB, logger *slog.Logger) CEPRepository {
+	return &cepConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,131 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"gorm.io/gorm"
+)
+
+// ClientMergeRepository representa o contracto de ClientMergeRepository.
+type ClientMergeRepository interface {
+	MergeClients(ctx context.Context, plan dtos.ClientMergePlan, clientMerge entities.ClienteMesclagem) (entities.ClienteMesclagem, error)
+	FindClientMergesByClientID(ctx context.Context, clientID string) ([]entities.ClienteMesclagem, error)
+}
+
+type clientMergeConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *clientMergeConnection) MergeClients(ctx context.Context, plan dtos.ClientMergePlan, clientMerge entities.ClienteMesclagem) (entities.ClienteMesclagem, error) {
+	ctx, span := tracer.Start(ctx, "ClientMergeRepository.MergeClients")
+	defer span.End()
+
+	// Todas as alterações da mesclagem são feitas na mesma transação.
+	err := db.connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, transfer := range plan.PontosTransferidos {
+			err := tx.Model(&entities.Ponto{}).Where("id = ?", transfer.PontoID).
+				Update("cliente_id", plan.SobreviventeID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, unification := range plan.PontosUnificados {
+			// A sequencia de contratos, os equipamentos, as ordens de serviço e os chamados do ponto duplicado,
+			// incluindo os removidos, passam para o ponto do sobrevivente.
+			for _, model := range []interface{}{&entities.Contrato{}, &entities.Equipamento{}, &entities.OrdemServico{}, &entities.Chamado{}} {
+				err := tx.Unscoped().Model(model).Where("ponto_id = ?", unification.PontoID).
+					Update("ponto_id", unification.PontoSobreviventeID).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			err := tx.Delete(&entities.Ponto{}, "id = ?", unification.PontoID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for _, transfer := range plan.ContatosTransferidos {
+			err := tx.Model(&entities.Contato{}).Where("id = ?", transfer.ContatoID).
+				Updates(map[string]interface{}{"cliente_id": plan.SobreviventeID, "principal": transfer.Principal}).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		for contactID, survivorContactID := range plan.ContatosSubstituidos {
+			err := tx.Unscoped().Model(&entities.Contrato{}).Where("contato_responsavel_id = ?", contactID).
+				Update("contato_responsavel_id", survivorContactID).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(plan.ContatosDescartados) != 0 {
+			err := tx.Delete(&entities.Contato{}, "id IN ?", plan.ContatosDescartados).Error
+			if err != nil {
+				return err
+			}
+		}
+
+		err := tx.Model(&entities.Chamado{}).Where("cliente_id IN ?", plan.DuplicadosIDs).
+			Update("cliente_id", plan.SobreviventeID).Error
+		if err != nil {
+			return err
+		}
+
+		// As filiais dos duplicados passam a ser filiais do sobrevivente, que deixa de ser filial quando a sua
+		// matriz é um dos duplicados.
+		err = tx.Unscoped().Model(&entities.Cliente{}).Where("matriz_id IN ? AND id <> ?", plan.DuplicadosIDs, plan.SobreviventeID).
+			Update("matriz_id", plan.SobreviventeID).Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Model(&entities.Cliente{}).Where("id = ? AND matriz_id IN ?", plan.SobreviventeID, plan.DuplicadosIDs).
+			Update("matriz_id", "").Error
+		if err != nil {
+			return err
+		}
+
+		err = tx.Delete(&entities.Cliente{}, "id IN ?", plan.DuplicadosIDs).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(&clientMerge).Error
+	})
+	if err != nil {
+		return entities.ClienteMesclagem{}, err
+	}
+
+	return clientMerge, nil
+}
+
+func (db *clientMergeConnection) FindClientMergesByClientID(ctx context.Context, clientID string) ([]entities.ClienteMesclagem, error) {
+	ctx, span := tracer.Start(ctx, "ClientMergeRepository.FindClientMergesByClientID")
+	defer span.End()
+
+	clientMerges := []entities.ClienteMesclagem{}
+
+	err := db.connection.WithContext(ctx).Order("data_criacao").Find(&clientMerges, "sobrevivente_id = ?", clientID).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find client merges by client id", err)
+	}
+
+	return clientMerges, nil
+}
+
+// NewClientMergeRepository cria uma nova instancia de ClientMergeRepository.
+func NewClientMergeRepository(database *gorm.DB, logger *slog.Logger) ClientMergeRepository {
+	return &clientMergeConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
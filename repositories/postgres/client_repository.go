@@ -1,8 +1,9 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"gorm.io/gorm"
@@ -10,20 +11,27 @@ import (
 
 // ClientRepository representa o contracto de ClientRepository.
 type ClientRepository interface {
-	CreateClient(client entities.Cliente) (entities.Cliente, error)
-	UpdateClient(client entities.Cliente) (entities.Cliente, error)
-	FindClientByID(clientID string) entities.Cliente
-	FindClientByName(name string) entities.Cliente
-	DeleteClient(client entities.Cliente) error
-	FindClients(clientName string, clientType entities.ClientType) []entities.Cliente
+	CreateClient(ctx context.Context, client entities.Cliente) (entities.Cliente, error)
+	UpdateClient(ctx context.Context, client entities.Cliente) (entities.Cliente, error)
+	FindClientByID(ctx context.Context, clientID string) (entities.Cliente, error)
+	FindClientByName(ctx context.Context, name string) (entities.Cliente, error)
+	FindClientByDocument(ctx context.Context, document string) (entities.Cliente, error)
+	DeleteClient(ctx context.Context, client entities.Cliente) error
+	FindClients(ctx context.Context, clientName string, clientType entities.ClientType, document string) ([]entities.Cliente, error)
+	FindClientsByParentID(ctx context.Context, parentID string) ([]entities.Cliente, error)
+	FindClientsByName(ctx context.Context, name string) ([]entities.Cliente, error)
 }
 
 type clientConnection struct {
 	connection *gorm.DB
+	logger     *slog.Logger
 }
 
-func (db *clientConnection) CreateClient(client entities.Cliente) (entities.Cliente, error) {
-	err := db.connection.Create(&client).Error
+func (db *clientConnection) CreateClient(ctx context.Context, client entities.Cliente) (entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.CreateClient")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&client).Error
 	if err != nil {
 		return client, err
 	}
@@ -31,8 +39,11 @@ func (db *clientConnection) CreateClient(client entities.Cliente) (entities.Clie
 	return client, nil
 }
 
-func (db *clientConnection) UpdateClient(client entities.Cliente) (entities.Cliente, error) {
-	err := db.connection.Save(&client).Error
+func (db *clientConnection) UpdateClient(ctx context.Context, client entities.Cliente) (entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.UpdateClient")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&client).Error
 	if err != nil {
 		return client, err
 	}
@@ -40,31 +51,53 @@ func (db *clientConnection) UpdateClient(client entities.Cliente) (entities.Clie
 	return client, nil
 }
 
-func (db *clientConnection) FindClientByID(clientID string) entities.Cliente {
+func (db *clientConnection) FindClientByID(ctx context.Context, clientID string) (entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.FindClientByID")
+	defer span.End()
+
 	client := entities.Cliente{}
 
-	err := db.connection.First(&client, "id = ?", clientID).Error
+	err := db.connection.WithContext(ctx).First(&client, "id = ?", clientID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return entities.Cliente{}, queryError(ctx, db.logger, "failed to find client by id", err)
 	}
 
-	return client
+	return client, nil
 }
 
-func (db *clientConnection) FindClientByName(name string) entities.Cliente {
+func (db *clientConnection) FindClientByName(ctx context.Context, name string) (entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.FindClientByName")
+	defer span.End()
+
 	client := entities.Cliente{}
 
-	err := db.connection.Unscoped().First(&client, "nome = ?", name).Error
+	err := db.connection.WithContext(ctx).Unscoped().First(&client, "nome = ?", name).Error
 	if err != nil {
-		log.Println(err.Error())
+		return entities.Cliente{}, queryError(ctx, db.logger, "failed to find client by name", err)
 	}
 
-	return client
+	return client, nil
 }
 
-func (db *clientConnection) DeleteClient(client entities.Cliente) error {
+func (db *clientConnection) FindClientByDocument(ctx context.Context, document string) (entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.FindClientByDocument")
+	defer span.End()
+
+	client := entities.Cliente{}
 
-	err := db.connection.Delete(&client).Error
+	err := db.connection.WithContext(ctx).Unscoped().First(&client, "documento = ?", document).Error
+	if err != nil {
+		return entities.Cliente{}, queryError(ctx, db.logger, "failed to find client by document", err)
+	}
+
+	return client, nil
+}
+
+func (db *clientConnection) DeleteClient(ctx context.Context, client entities.Cliente) error {
+	ctx, span := tracer.Start(ctx, "ClientRepository.DeleteClient")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Delete(&client).Error
 	if err != nil {
 		return err
 	}
@@ -72,7 +105,10 @@ func (db *clientConnection) DeleteClient(client entities.Cliente) error {
 	return nil
 }
 
-func (db *clientConnection) FindClients(clientName string, clientType entities.ClientType) []entities.Cliente {
+func (db *clientConnection) FindClients(ctx context.Context, clientName string, clientType entities.ClientType, document string) ([]entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.FindClients")
+	defer span.End()
+
 	clients := []entities.Cliente{}
 
 	var sqlQuery string
@@ -90,17 +126,51 @@ func (db *clientConnection) FindClients(clientName string, clientType entities.C
 		sqlQuery += "AND NOT tipo IS NULL"
 	}
 
-	err := db.connection.Find(&clients, sqlQuery).Error
+	if document != "" {
+		sqlQuery += fmt.Sprintf(" AND documento = '%v'", document)
+	}
+
+	err := db.connection.WithContext(ctx).Find(&clients, sqlQuery).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find clients", err)
+	}
+
+	return clients, nil
+}
+
+func (db *clientConnection) FindClientsByParentID(ctx context.Context, parentID string) ([]entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.FindClientsByParentID")
+	defer span.End()
+
+	clients := []entities.Cliente{}
+
+	err := db.connection.WithContext(ctx).Order("nome").Find(&clients, "matriz_id = ?", parentID).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find clients by parent", err)
+	}
+
+	return clients, nil
+}
+
+// FindClientsByName busca todos os clientes com o nome informado, incluindo os removidos, ordenados pelo cadastro.
+func (db *clientConnection) FindClientsByName(ctx context.Context, name string) ([]entities.Cliente, error) {
+	ctx, span := tracer.Start(ctx, "ClientRepository.FindClientsByName")
+	defer span.End()
+
+	clients := []entities.Cliente{}
+
+	err := db.connection.WithContext(ctx).Unscoped().Order("data_criacao").Find(&clients, "nome = ?", name).Error
 	if err != nil {
-		log.Println(err.Error())
+		return nil, queryError(ctx, db.logger, "failed to find clients by name", err)
 	}
 
-	return clients
+	return clients, nil
 }
 
 // NewClientRepository cria uma nova instancia de ClientRepository.
-func NewClientRepository(database *gorm.DB) ClientRepository {
+func NewClientRepository(database *gorm.DB, logger *slog.Logger) ClientRepository {
 	return &clientConnection{
 		connection: database,
+		logger:     logger,
 	}
 }
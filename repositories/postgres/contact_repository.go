@@ -0,0 +1,114 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+// ContactRepository representa o contracto de ContactRepository.
+type ContactRepository interface {
+	CreateContact(ctx context.Context, contact entities.Contato) (entities.Contato, error)
+	UpdateContact(ctx context.Context, contact entities.Contato) (entities.Contato, error)
+	FindContactByID(ctx context.Context, contactID string) (entities.Contato, error)
+	FindContactByClientIDAndValue(ctx context.Context, clientID string, contactType entities.ContactType, value string) (entities.Contato, error)
+	FindContactsByClientID(ctx context.Context, clientID string) ([]entities.Contato, error)
+	DeleteContact(ctx context.Context, contact entities.Contato) error
+}
+
+type contactConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *contactConnection) CreateContact(ctx context.Context, contact entities.Contato) (entities.Contato, error) {
+	ctx, span := tracer.Start(ctx, "ContactRepository.CreateContact")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&contact).Error
+	if err != nil {
+		return contact, err
+	}
+
+	return contact, nil
+}
+
+func (db *contactConnection) UpdateContact(ctx context.Context, contact entities.Contato) (entities.Contato, error) {
+	ctx, span := tracer.Start(ctx, "ContactRepository.UpdateContact")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&contact).Error
+	if err != nil {
+		return contact, err
+	}
+
+	return contact, nil
+}
+
+func (db *contactConnection) FindContactByID(ctx context.Context, contactID string) (entities.Contato, error) {
+	ctx, span := tracer.Start(ctx, "ContactRepository.FindContactByID")
+	defer span.End()
+
+	contact := entities.Contato{}
+
+	err := db.connection.WithContext(ctx).First(&contact, "id = ?", contactID).Error
+	if err != nil {
+		return entities.Contato{}, queryError(ctx, db.logger, "failed to find contact by id", err)
+	}
+
+	return contact, nil
+}
+
+func (db *contactConnection) FindContactByClientIDAndValue(ctx context.Context, clientID string, contactType entities.ContactType, value string) (entities.Contato, error) {
+	ctx, span := tracer.Start(ctx, "ContactRepository.FindContactByClientIDAndValue")
+	defer span.End()
+
+	contact := entities.Contato{}
+
+	err := db.connection.WithContext(ctx).Unscoped().
+		First(&contact, "cliente_id = ? AND tipo = ? AND valor = ?", clientID, contactType, value).Error
+	if err != nil {
+		return entities.Contato{}, queryError(ctx, db.logger, "failed to find contact by client id and value", err)
+	}
+
+	return contact, nil
+}
+
+func (db *contactConnection) FindContactsByClientID(ctx context.Context, clientID string) ([]entities.Contato, error) {
+	ctx, span := tracer.Start(ctx, "ContactRepository.FindContactsByClientID")
+	defer span.End()
+
+	contacts := []entities.Contato{}
+
+	err := db.connection.WithContext(ctx).Order("tipo, data_criacao").Find(&contacts, "cliente_id = ?", clientID).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find contacts by client id", err)
+	}
+
+	return contacts, nil
+}
+
+func (db *contactConnection) DeleteContact(ctx context.Context, contact entities.Contato) error {
+	ctx, span := tracer.Start(ctx, "ContactRepository.DeleteContact",
+		trace.WithAttributes(attribute.String("contato.id", contact.ID)))
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Delete(&contact).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// NewContactRepository cria uma nova instancia de ContactRepository.
+func NewContactRepository(database *gorm.DB, logger *slog.Logger) ContactRepository {
+	return &contactConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
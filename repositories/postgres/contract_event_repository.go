@@ -1,7 +1,9 @@
 package repositories
 
 import (
-	"log"
+	"context"
+	"log/slog"
+	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"gorm.io/gorm"
@@ -9,39 +11,86 @@ import (
 
 // ContractEventRepository representa o contracto de ContractEventRepository.
 type ContractEventRepository interface {
-	CreateContractEvent(contractEvent entities.ContratoEvento) (entities.ContratoEvento, error)
-	FindContractEventsByContractID(contractID string) []entities.ContratoEvento
+	CreateContractEvent(ctx context.Context, contractEvent entities.ContratoEvento) (entities.ContratoEvento, error)
+	FindContractEventsByContractID(ctx context.Context, contractID string) ([]entities.ContratoEvento, error)
+	FindContractEventsByContractIDs(ctx context.Context, contractIDs []string) ([]entities.ContratoEvento, error)
+	FindScheduledPlanEvents(ctx context.Context, until time.Time) ([]entities.ContratoEvento, error)
 }
 
 type contractEventConnection struct {
 	connection *gorm.DB
+	logger     *slog.Logger
 }
 
-func (db *contractEventConnection) CreateContractEvent(contractEvent entities.ContratoEvento) (entities.ContratoEvento, error) {
-	err := db.connection.Create(&contractEvent).Error
+func (db *contractEventConnection) CreateContractEvent(ctx context.Context, contractEvent entities.ContratoEvento) (entities.ContratoEvento, error) {
+	ctx, span := tracer.Start(ctx, "ContractEventRepository.CreateContractEvent")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&contractEvent).Error
 	if err != nil {
 		return contractEvent, err
 	}
 
-	log.Println(contractEvent.DataCriacao)
-
 	return contractEvent, nil
 }
 
-func (db *contractEventConnection) FindContractEventsByContractID(contractID string) []entities.ContratoEvento {
+func (db *contractEventConnection) FindContractEventsByContractID(ctx context.Context, contractID string) ([]entities.ContratoEvento, error) {
+	ctx, span := tracer.Start(ctx, "ContractEventRepository.FindContractEventsByContractID")
+	defer span.End()
+
+	contractEvents := []entities.ContratoEvento{}
+
+	err := db.connection.WithContext(ctx).Find(&contractEvents, "contrato_id = ?", contractID).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find contract events by contract id", err)
+	}
+
+	return contractEvents, nil
+}
+
+func (db *contractEventConnection) FindContractEventsByContractIDs(ctx context.Context, contractIDs []string) ([]entities.ContratoEvento, error) {
+	ctx, span := tracer.Start(ctx, "ContractEventRepository.FindContractEventsByContractIDs")
+	defer span.End()
+
+	contractEvents := []entities.ContratoEvento{}
+
+	if len(contractIDs) == 0 {
+		return contractEvents, nil
+	}
+
+	err := db.connection.WithContext(ctx).Find(&contractEvents, "contrato_id IN ?", contractIDs).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find contract events by contract ids", err)
+	}
+
+	return contractEvents, nil
+}
+
+// FindScheduledPlanEvents pesquisa as trocas de plano agendadas, registradas antes da sua data efetiva, que
+// passaram a valer até a data informada em contratos não cancelados que ainda não estão no novo plano.
+func (db *contractEventConnection) FindScheduledPlanEvents(ctx context.Context, until time.Time) ([]entities.ContratoEvento, error) {
+	ctx, span := tracer.Start(ctx, "ContractEventRepository.FindScheduledPlanEvents")
+	defer span.End()
+
 	contractEvents := []entities.ContratoEvento{}
 
-	err := db.connection.Find(&contractEvents, "contrato_id = ?", contractID).Error
+	err := db.connection.WithContext(ctx).
+		Joins("JOIN t_contrato ON t_contrato.id = t_contrato_evento.contrato_id AND t_contrato.data_remocao IS NULL").
+		Where("t_contrato_evento.tipo = ? AND t_contrato_evento.data_efetiva > t_contrato_evento.data_criacao", entities.PLANO).
+		Where("t_contrato_evento.data_efetiva <= ? AND t_contrato.estado <> ?", until, entities.CANCELADO).
+		Where("t_contrato.plano_id <> t_contrato_evento.plano_posterior_id").
+		Order("t_contrato_evento.data_efetiva").Find(&contractEvents).Error
 	if err != nil {
-		log.Println(err.Error())
+		return nil, queryError(ctx, db.logger, "failed to find scheduled plan events", err)
 	}
 
-	return contractEvents
+	return contractEvents, nil
 }
 
 // NewContractEventRepository cria uma nova instancia de ContractEventRepository.
-func NewContractEventRepository(database *gorm.DB) ContractEventRepository {
+func NewContractEventRepository(database *gorm.DB, logger *slog.Logger) ContractEventRepository {
 	return &contractEventConnection{
 		connection: database,
+		logger:     logger,
 	}
 }
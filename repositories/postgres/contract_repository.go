@@ -1,8 +1,10 @@
 package repositories
 
 import (
-	"fmt"
-	"log"
+	"context"
+	"errors"
+	"log/slog"
+	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"gorm.io/gorm"
@@ -10,20 +12,34 @@ import (
 
 // ContractRepository representa o contracto de ContractRepository.
 type ContractRepository interface {
-	CreateContract(contract entities.Contrato) (entities.Contrato, error)
-	UpdateContract(contract entities.Contrato) (entities.Contrato, error)
-	FindContractByID(contractID string) entities.Contrato
-	FindContractByPontoID(pontoID string) entities.Contrato
-	DeleteContract(contract entities.Contrato) error
-	FindContracts(clientID string, addressID string) []entities.Contrato
+	CreateContract(ctx context.Context, contract entities.Contrato) (entities.Contrato, error)
+	UpdateContract(ctx context.Context, contract entities.Contrato) (entities.Contrato, error)
+	FindContractByID(ctx context.Context, contractID string) (entities.Contrato, error)
+	FindContractByPontoID(ctx context.Context, pontoID string) (entities.Contrato, error)
+	FindContractsByPontoID(ctx context.Context, pontoID string) ([]entities.Contrato, error)
+	FindCurrentContractsByPontoIDs(ctx context.Context, pontoIDs []string) ([]entities.Contrato, error)
+	DeleteContract(ctx context.Context, contract entities.Contrato) error
+	FindContracts(ctx context.Context, clientID string, addressID string, includeBranches bool) ([]entities.Contrato, error)
+	CountActiveContractsByPlanID(ctx context.Context, planID string) (int64, error)
+	FindContractsEndingBetween(ctx context.Context, from time.Time, to time.Time) ([]entities.Contrato, error)
+	FindContractsForBilling(ctx context.Context, from time.Time) ([]entities.Contrato, error)
+	UpdateCurrentVersion(ctx context.Context, contractID string, versionID string) error
 }
 
 type contractConnection struct {
 	connection *gorm.DB
+	logger     *slog.Logger
 }
 
-func (db *contractConnection) CreateContract(contract entities.Contrato) (entities.Contrato, error) {
-	err := db.connection.Create(&contract).Error
+func (db *contractConnection) CreateContract(ctx context.Context, contract entities.Contrato) (entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.CreateContract")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&contract).Error
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return contract, ErrAlreadyExists
+	}
+
 	if err != nil {
 		return contract, err
 	}
@@ -31,8 +47,11 @@ func (db *contractConnection) CreateContract(contract entities.Contrato) (entiti
 	return contract, nil
 }
 
-func (db *contractConnection) UpdateContract(contract entities.Contrato) (entities.Contrato, error) {
-	err := db.connection.Save(&contract).Error
+func (db *contractConnection) UpdateContract(ctx context.Context, contract entities.Contrato) (entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.UpdateContract")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&contract).Error
 	if err != nil {
 		return contract, err
 	}
@@ -40,30 +59,77 @@ func (db *contractConnection) UpdateContract(contract entities.Contrato) (entiti
 	return contract, nil
 }
 
-func (db *contractConnection) FindContractByID(contractID string) entities.Contrato {
+func (db *contractConnection) FindContractByID(ctx context.Context, contractID string) (entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.FindContractByID")
+	defer span.End()
+
 	contract := entities.Contrato{}
 
-	err := db.connection.Preload("Ponto.Cliente").Preload("Ponto.Endereco").First(&contract, "id = ?", contractID).Error
+	err := db.connection.WithContext(ctx).Preload("Ponto.Cliente").Preload("Ponto.Endereco").Preload("Plano", unscoped).Preload("Versao").
+		First(&contract, "id = ?", contractID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return entities.Contrato{}, queryError(ctx, db.logger, "failed to find contract by id", err)
 	}
 
-	return contract
+	return contract, nil
 }
 
-func (db *contractConnection) FindContractByPontoID(pontoID string) entities.Contrato {
+func (db *contractConnection) FindContractByPontoID(ctx context.Context, pontoID string) (entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.FindContractByPontoID")
+	defer span.End()
+
 	contract := entities.Contrato{}
 
-	err := db.connection.Unscoped().First(&contract, "ponto_id = ?", pontoID).Error
+	// O ponto possui no maximo um contrato não cancelado, que é o seu contrato atual.
+	err := db.connection.WithContext(ctx).Preload("Versao").Order("data_criacao DESC").
+		First(&contract, "ponto_id = ? AND estado <> ?", pontoID, entities.CANCELADO).Error
+	if err != nil {
+		return entities.Contrato{}, queryError(ctx, db.logger, "failed to find contract by point id", err)
+	}
+
+	return contract, nil
+}
+
+func (db *contractConnection) FindContractsByPontoID(ctx context.Context, pontoID string) ([]entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.FindContractsByPontoID")
+	defer span.End()
+
+	contracts := []entities.Contrato{}
+
+	err := db.connection.WithContext(ctx).Preload("Ponto.Cliente").Preload("Ponto.Endereco").Preload("Plano", unscoped).Preload("Versao").
+		Order("data_criacao").Find(&contracts, "ponto_id = ?", pontoID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return nil, queryError(ctx, db.logger, "failed to find contracts by point id", err)
 	}
 
-	return contract
+	return contracts, nil
 }
 
-func (db *contractConnection) DeleteContract(contract entities.Contrato) error {
-	err := db.connection.Delete(&contract).Error
+// FindCurrentContractsByPontoIDs pesquisa os contratos não cancelados dos pontos informados.
+func (db *contractConnection) FindCurrentContractsByPontoIDs(ctx context.Context, pontoIDs []string) ([]entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.FindCurrentContractsByPontoIDs")
+	defer span.End()
+
+	contracts := []entities.Contrato{}
+
+	if len(pontoIDs) == 0 {
+		return contracts, nil
+	}
+
+	err := db.connection.WithContext(ctx).Preload("Versao").
+		Find(&contracts, "ponto_id IN ? AND estado <> ?", pontoIDs, entities.CANCELADO).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find current contracts by point ids", err)
+	}
+
+	return contracts, nil
+}
+
+func (db *contractConnection) DeleteContract(ctx context.Context, contract entities.Contrato) error {
+	ctx, span := tracer.Start(ctx, "ContractRepository.DeleteContract")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Delete(&contract).Error
 	if err != nil {
 		return err
 	}
@@ -71,35 +137,122 @@ func (db *contractConnection) DeleteContract(contract entities.Contrato) error {
 	return nil
 }
 
-func (db *contractConnection) FindContracts(clientID string, addressID string) []entities.Contrato {
+func (db *contractConnection) FindContracts(ctx context.Context, clientID string, addressID string, includeBranches bool) ([]entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.FindContracts")
+	defer span.End()
+
 	contracts := []entities.Contrato{}
 
 	var sqlQuery = "JOIN t_ponto ON t_ponto.id = t_contrato.ponto_id "
+	var args []interface{}
 
-	if clientID != "" {
-		sqlQuery += fmt.Sprintf("AND t_ponto.cliente_id = '%v' ", clientID)
-	} else {
+	switch {
+	case clientID != "" && includeBranches:
+		// Percorre a hierarquia a partir da matriz para incluir as filiais diretas e indiretas.
+		sqlQuery += "AND t_ponto.cliente_id IN (WITH RECURSIVE grupo AS (" +
+			"SELECT id FROM t_cliente WHERE id = ? " +
+			"UNION SELECT c.id FROM t_cliente c JOIN grupo g ON c.matriz_id = g.id::text WHERE c.data_remocao IS NULL" +
+			") SELECT id FROM grupo) "
+		args = append(args, clientID)
+	case clientID != "":
+		sqlQuery += "AND t_ponto.cliente_id = ? "
+		args = append(args, clientID)
+	default:
 		sqlQuery += "AND NOT t_ponto.cliente_id IS NULL "
 	}
 
 	if addressID != "" {
-		sqlQuery += fmt.Sprintf("AND t_ponto.endereco_id = '%v'", addressID)
+		sqlQuery += "AND t_ponto.endereco_id = ?"
+		args = append(args, addressID)
 	} else {
 		sqlQuery += "AND NOT t_ponto.endereco_id IS NULL"
 	}
 
-	err := db.connection.Preload("Ponto.Cliente").Preload("Ponto.Endereco").
-		Joins(sqlQuery).Find(&contracts).Error
+	err := db.connection.WithContext(ctx).Preload("Ponto.Cliente").Preload("Ponto.Endereco").Preload("Plano", unscoped).Preload("Versao").
+		Joins(sqlQuery, args...).Find(&contracts).Error
 	if err != nil {
-		log.Println(err.Error())
+		return nil, queryError(ctx, db.logger, "failed to find contracts", err)
 	}
 
-	return contracts
+	return contracts, nil
+}
+
+func (db *contractConnection) CountActiveContractsByPlanID(ctx context.Context, planID string) (int64, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.CountActiveContractsByPlanID")
+	defer span.End()
+
+	var count int64
+
+	err := db.connection.WithContext(ctx).Model(&entities.Contrato{}).
+		Where("plano_id = ? AND estado <> ?", planID, entities.CANCELADO).Count(&count).Error
+	if err != nil {
+		return 0, queryError(ctx, db.logger, "failed to count contracts by plan id", err)
+	}
+
+	return count, nil
+}
+
+func (db *contractConnection) FindContractsEndingBetween(ctx context.Context, from time.Time, to time.Time) ([]entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.FindContractsEndingBetween")
+	defer span.End()
+
+	contracts := []entities.Contrato{}
+
+	// A data de termino é a da versão atual do contrato, e o contrato sem data de termino encerra a sua fidelidade.
+	endDate := "COALESCE(CASE WHEN t_contrato_versao.id IS NULL THEN t_contrato.data_termino " +
+		"ELSE NULLIF(t_contrato_versao.termos::jsonb->>'data_termino', '')::date END, t_contrato.data_fim_fidelidade)"
+
+	err := db.connection.WithContext(ctx).Preload("Ponto.Cliente").Preload("Ponto.Endereco").Preload("Plano", unscoped).Preload("Versao").
+		Joins("LEFT JOIN t_contrato_versao ON t_contrato_versao.id::text = t_contrato.versao_id").
+		Where("t_contrato.estado = ? AND "+endDate+" BETWEEN ? AND ?", entities.VIGOR, from, to).
+		Order(endDate).Find(&contracts).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find contracts ending between dates", err)
+	}
+
+	return contracts, nil
+}
+
+// FindContractsForBilling busca os contratos faturaveis a partir da data informada, incluindo os contratos e os
+// pontos removidos depois dela, já que os dias anteriores à remoção ainda são cobrados.
+func (db *contractConnection) FindContractsForBilling(ctx context.Context, from time.Time) ([]entities.Contrato, error) {
+	ctx, span := tracer.Start(ctx, "ContractRepository.FindContractsForBilling")
+	defer span.End()
+
+	contracts := []entities.Contrato{}
+
+	err := db.connection.WithContext(ctx).Unscoped().Preload("Ponto", unscoped).Preload("Plano", unscoped).
+		Where("t_contrato.data_remocao IS NULL OR t_contrato.data_remocao >= ?", from).Find(&contracts).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find contracts for billing", err)
+	}
+
+	return contracts, nil
+}
+
+// UpdateCurrentVersion aponta o contrato para a sua versão atual, sem alterar os demais campos do contrato.
+func (db *contractConnection) UpdateCurrentVersion(ctx context.Context, contractID string, versionID string) error {
+	ctx, span := tracer.Start(ctx, "ContractRepository.UpdateCurrentVersion")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Model(&entities.Contrato{}).Where("id = ?", contractID).
+		UpdateColumn("versao_id", versionID).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// unscoped carrega as associações removidas, como o plano de um contrato antigo.
+func unscoped(db *gorm.DB) *gorm.DB {
+	return db.Unscoped()
 }
 
 // NewContractRepository cria uma nova instancia de ContractRepository.
-func NewContractRepository(database *gorm.DB) ContractRepository {
+func NewContractRepository(database *gorm.DB, logger *slog.Logger) ContractRepository {
 	return &contractConnection{
 		connection: database,
+		logger:     logger,
 	}
 }
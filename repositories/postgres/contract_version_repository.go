@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"gorm.io/gorm"
+)
+
+// ContractVersionRepository representa o contracto de ContractVersionRepository.
+type ContractVersionRepository interface {
+	CreateContractVersion(ctx context.Context, contractVersion entities.ContratoVersao) (entities.ContratoVersao, error)
+	FindContractVersionsByContractID(ctx context.Context, contractID string) ([]entities.ContratoVersao, error)
+}
+
+type contractVersionConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *contractVersionConnection) CreateContractVersion(ctx context.Context, contractVersion entities.ContratoVersao) (entities.ContratoVersao, error) {
+	ctx, span := tracer.Start(ctx, "ContractVersionRepository.CreateContractVersion")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&contractVersion).Error
+	if err != nil {
+		return contractVersion, err
+	}
+
+	return contractVersion, nil
+}
+
+func (db *contractVersionConnection) FindContractVersionsByContractID(ctx context.Context, contractID string) ([]entities.ContratoVersao, error) {
+	ctx, span := tracer.Start(ctx, "ContractVersionRepository.FindContractVersionsByContractID")
+	defer span.End()
+
+	contractVersions := []entities.ContratoVersao{}
+
+	err := db.connection.WithContext(ctx).Order("numero").Find(&contractVersions, "contrato_id = ?", contractID).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find contract versions by contract id", err)
+	}
+
+	return contractVersions, nil
+}
+
+// NewContractVersionRepository cria uma nova instancia de ContractVersionRepository.
+func NewContractVersionRepository(database *gorm.DB, logger *slog.Logger) ContractVersionRepository {
+	return &contractVersionConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
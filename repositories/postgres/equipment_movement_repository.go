@@ -0,0 +1,54 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"gorm.io/gorm"
+)
+
+// EquipmentMovementRepository representa o contracto de EquipmentMovementRepository.
+type EquipmentMovementRepository interface {
+	CreateEquipmentMovement(ctx context.Context, movement entities.EquipamentoMovimentacao) (entities.EquipamentoMovimentacao, error)
+	FindEquipmentMovementsByEquipmentID(ctx context.Context, equipmentID string) ([]entities.EquipamentoMovimentacao, error)
+}
+
+type equipmentMovementConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *equipmentMovementConnection) CreateEquipmentMovement(ctx context.Context, movement entities.EquipamentoMovimentacao) (entities.EquipamentoMovimentacao, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentMovementRepository.CreateEquipmentMovement")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&movement).Error
+	if err != nil {
+		return movement, err
+	}
+
+	return movement, nil
+}
+
+func (db *equipmentMovementConnection) FindEquipmentMovementsByEquipmentID(ctx context.Context, equipmentID string) ([]entities.EquipamentoMovimentacao, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentMovementRepository.FindEquipmentMovementsByEquipmentID")
+	defer span.End()
+
+	movements := []entities.EquipamentoMovimentacao{}
+
+	err := db.connection.WithContext(ctx).Order("data_criacao").Find(&movements, "equipamento_id = ?", equipmentID).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find equipment movements by equipment id", err)
+	}
+
+	return movements, nil
+}
+
+// NewEquipmentMovementRepository cria uma nova instancia de EquipmentMovementRepository.
+func NewEquipmentMovementRepository(database *gorm.DB, logger *slog.Logger) EquipmentMovementRepository {
+	return &equipmentMovementConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
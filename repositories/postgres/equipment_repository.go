@@ -0,0 +1,134 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"gorm.io/gorm"
+)
+
+// EquipmentRepository representa o contracto de EquipmentRepository.
+type EquipmentRepository interface {
+	CreateEquipment(ctx context.Context, equipment entities.Equipamento) (entities.Equipamento, error)
+	UpdateEquipment(ctx context.Context, equipment entities.Equipamento) (entities.Equipamento, error)
+	FindEquipmentByID(ctx context.Context, equipmentID string) (entities.Equipamento, error)
+	FindEquipmentBySerial(ctx context.Context, serial string) (entities.Equipamento, error)
+	FindEquipments(ctx context.Context, filter dtos.EquipmentFilter) ([]entities.Equipamento, error)
+	FindEquipmentsToReturn(ctx context.Context) ([]entities.Equipamento, error)
+}
+
+type equipmentConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *equipmentConnection) CreateEquipment(ctx context.Context, equipment entities.Equipamento) (entities.Equipamento, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentRepository.CreateEquipment")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&equipment).Error
+	if err != nil {
+		return equipment, err
+	}
+
+	return equipment, nil
+}
+
+func (db *equipmentConnection) UpdateEquipment(ctx context.Context, equipment entities.Equipamento) (entities.Equipamento, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentRepository.UpdateEquipment")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&equipment).Error
+	if err != nil {
+		return equipment, err
+	}
+
+	return equipment, nil
+}
+
+func (db *equipmentConnection) FindEquipmentByID(ctx context.Context, equipmentID string) (entities.Equipamento, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentRepository.FindEquipmentByID")
+	defer span.End()
+
+	equipment := entities.Equipamento{}
+
+	err := db.connection.WithContext(ctx).First(&equipment, "id = ?", equipmentID).Error
+	if err != nil {
+		return entities.Equipamento{}, queryError(ctx, db.logger, "failed to find equipment by id", err)
+	}
+
+	return equipment, nil
+}
+
+func (db *equipmentConnection) FindEquipmentBySerial(ctx context.Context, serial string) (entities.Equipamento, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentRepository.FindEquipmentBySerial")
+	defer span.End()
+
+	equipment := entities.Equipamento{}
+
+	err := db.connection.WithContext(ctx).First(&equipment, "serial = ?", serial).Error
+	if err != nil {
+		return entities.Equipamento{}, queryError(ctx, db.logger, "failed to find equipment by serial", err)
+	}
+
+	return equipment, nil
+}
+
+func (db *equipmentConnection) FindEquipments(ctx context.Context, filter dtos.EquipmentFilter) ([]entities.Equipamento, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentRepository.FindEquipments")
+	defer span.End()
+
+	equipments := []entities.Equipamento{}
+
+	query := db.connection.WithContext(ctx).Order("serial")
+
+	if filter.PontoID != "" {
+		query = query.Where("ponto_id = ?", filter.PontoID)
+	}
+
+	if filter.Estado != "" {
+		query = query.Where("estado = ?", filter.Estado)
+	}
+
+	if filter.DevolucaoPendente != nil {
+		query = query.Where("devolucao_pendente = ?", *filter.DevolucaoPendente)
+	}
+
+	err := query.Find(&equipments).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find equipments", err)
+	}
+
+	return equipments, nil
+}
+
+// FindEquipmentsToReturn busca os equipamentos instalados que devem ser recolhidos: os sinalizados na remoção
+// do ponto ou no cancelamento do contrato, e os que estão em pontos cujo ultimo contrato foi cancelado.
+func (db *equipmentConnection) FindEquipmentsToReturn(ctx context.Context) ([]entities.Equipamento, error) {
+	ctx, span := tracer.Start(ctx, "EquipmentRepository.FindEquipmentsToReturn")
+	defer span.End()
+
+	equipments := []entities.Equipamento{}
+
+	sqlQuery := `t_equipamento.estado = ? AND (t_equipamento.devolucao_pendente OR (
+		EXISTS (SELECT 1 FROM t_contrato c WHERE c.ponto_id::text = t_equipamento.ponto_id AND c.estado = ? AND c.data_remocao IS NULL)
+		AND NOT EXISTS (SELECT 1 FROM t_contrato c WHERE c.ponto_id::text = t_equipamento.ponto_id AND c.estado <> ? AND c.data_remocao IS NULL)))`
+
+	err := db.connection.WithContext(ctx).Preload("Ponto", unscoped).Preload("Ponto.Cliente", unscoped).
+		Where(sqlQuery, entities.INSTALADO, entities.CANCELADO, entities.CANCELADO).Order("ponto_id, serial").Find(&equipments).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find equipments to return", err)
+	}
+
+	return equipments, nil
+}
+
+// NewEquipmentRepository cria uma nova instancia de EquipmentRepository.
+func NewEquipmentRepository(database *gorm.DB, logger *slog.Logger) EquipmentRepository {
+	return &equipmentConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
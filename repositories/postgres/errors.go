@@ -0,0 +1,32 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"gorm.io/gorm"
+)
+
+// ErrNotFound retornado pelos repositorios quando o registro pesquisado não existe.
+var ErrNotFound = errors.New("record not found")
+
+// ErrAlreadyExists retornado quando o registro viola uma restrição de unicidade do banco de dados.
+var ErrAlreadyExists = errors.New("record already exists")
+
+// ErrInvoicePaid retornado quando o pagamento é registrado em uma fatura já quitada.
+var ErrInvoicePaid = errors.New("invoice already paid")
+
+// ErrScheduleConflict retornado quando a janela da ordem de serviço se sobrepõe a outra ordem do mesmo tecnico.
+var ErrScheduleConflict = errors.New("schedule conflict")
+
+// queryError converte o erro de registro não encontrado do GORM em ErrNotFound e registra no log os demais erros.
+func queryError(ctx context.Context, logger *slog.Logger, message string, err error) error {
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return ErrNotFound
+	}
+
+	logger.ErrorContext(ctx, message, slog.String("error", err.Error()))
+
+	return err
+}
@@ -0,0 +1,102 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"gorm.io/gorm"
+)
+
+// ImportRepository representa o contracto de ImportRepository.
+type ImportRepository interface {
+	ImportRows(ctx context.Context, rows []dtos.ImportRowPlan) error
+}
+
+type importConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+// ImportRows grava os registros das linhas do lote da importação. O lote é gravado na mesma transação, então
+// um erro em qualquer linha desfaz o lote inteiro.
+func (db *importConnection) ImportRows(ctx context.Context, rows []dtos.ImportRowPlan) error {
+	ctx, span := tracer.Start(ctx, "ImportRepository.ImportRows")
+	defer span.End()
+
+	return db.connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		for _, row := range rows {
+			err := saveImportRecord(tx, row.AcaoCliente, &row.Cliente)
+			if err != nil {
+				return err
+			}
+
+			err = saveImportRecord(tx, row.AcaoEndereco, &row.Endereco)
+			if err != nil {
+				return err
+			}
+
+			err = saveImportRecord(tx, row.AcaoPonto, &row.Ponto)
+			if err != nil {
+				return err
+			}
+
+			if row.Contrato == nil {
+				continue
+			}
+
+			if row.Contato != nil {
+				err = saveImportRecord(tx, row.AcaoContato, row.Contato)
+				if err != nil {
+					return err
+				}
+			}
+
+			err = tx.Create(row.Contrato).Error
+			if err != nil {
+				return err
+			}
+
+			for _, contractEvent := range row.ContratoEventos {
+				err = tx.Create(&contractEvent).Error
+				if err != nil {
+					return err
+				}
+			}
+
+			err = tx.Create(row.ContratoVersao).Error
+			if err != nil {
+				return err
+			}
+
+			if row.OrdemServico != nil {
+				err = tx.Create(row.OrdemServico).Error
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+}
+
+// saveImportRecord cria ou restaura o registro da linha, sem alterar os registros existentes.
+func saveImportRecord(tx *gorm.DB, action dtos.ImportAction, record interface{}) error {
+	switch action {
+	case dtos.IMPORTACAO_CRIAR:
+		return tx.Create(record).Error
+	case dtos.IMPORTACAO_RESTAURAR:
+		return tx.Unscoped().Save(record).Error
+	default:
+		return nil
+	}
+}
+
+// NewImportRepository cria uma nova instancia de ImportRepository.
+func NewImportRepository(database *gorm.DB, logger *slog.Logger) ImportRepository {
+	return &importConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,124 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"gorm.io/gorm"
+)
+
+// InvoiceRepository representa o contracto de InvoiceRepository.
+type InvoiceRepository interface {
+	CreateInvoice(ctx context.Context, invoice entities.Fatura) (entities.Fatura, error)
+	FindInvoiceByID(ctx context.Context, invoiceID string) (entities.Fatura, error)
+	FindInvoiceByClientIDAndCompetencia(ctx context.Context, clientID string, competencia string) (entities.Fatura, error)
+	FindInvoices(ctx context.Context, clientID string, competencia string) ([]entities.Fatura, error)
+	UpdateInvoice(ctx context.Context, invoice entities.Fatura) (entities.Fatura, error)
+	FindUnpaidInvoicesDueBefore(ctx context.Context, date time.Time) ([]entities.Fatura, error)
+}
+
+type invoiceConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *invoiceConnection) CreateInvoice(ctx context.Context, invoice entities.Fatura) (entities.Fatura, error) {
+	ctx, span := tracer.Start(ctx, "InvoiceRepository.CreateInvoice")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&invoice).Error
+	if err != nil {
+		return invoice, err
+	}
+
+	return invoice, nil
+}
+
+func (db *invoiceConnection) FindInvoiceByID(ctx context.Context, invoiceID string) (entities.Fatura, error) {
+	ctx, span := tracer.Start(ctx, "InvoiceRepository.FindInvoiceByID")
+	defer span.End()
+
+	invoice := entities.Fatura{}
+
+	err := db.connection.WithContext(ctx).First(&invoice, "id = ?", invoiceID).Error
+	if err != nil {
+		return entities.Fatura{}, queryError(ctx, db.logger, "failed to find invoice by id", err)
+	}
+
+	return invoice, nil
+}
+
+func (db *invoiceConnection) FindInvoiceByClientIDAndCompetencia(ctx context.Context, clientID string, competencia string) (entities.Fatura, error) {
+	ctx, span := tracer.Start(ctx, "InvoiceRepository.FindInvoiceByClientIDAndCompetencia")
+	defer span.End()
+
+	invoice := entities.Fatura{}
+
+	err := db.connection.WithContext(ctx).First(&invoice, "cliente_id = ? AND competencia = ?", clientID, competencia).Error
+	if err != nil {
+		return entities.Fatura{}, queryError(ctx, db.logger, "failed to find invoice by client id and competencia", err)
+	}
+
+	return invoice, nil
+}
+
+func (db *invoiceConnection) FindInvoices(ctx context.Context, clientID string, competencia string) ([]entities.Fatura, error) {
+	ctx, span := tracer.Start(ctx, "InvoiceRepository.FindInvoices")
+	defer span.End()
+
+	invoices := []entities.Fatura{}
+
+	query := db.connection.WithContext(ctx).Order("competencia DESC, cliente_id")
+
+	if clientID != "" {
+		query = query.Where("cliente_id = ?", clientID)
+	}
+
+	if competencia != "" {
+		query = query.Where("competencia = ?", competencia)
+	}
+
+	err := query.Find(&invoices).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find invoices", err)
+	}
+
+	return invoices, nil
+}
+
+func (db *invoiceConnection) UpdateInvoice(ctx context.Context, invoice entities.Fatura) (entities.Fatura, error) {
+	ctx, span := tracer.Start(ctx, "InvoiceRepository.UpdateInvoice")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&invoice).Error
+	if err != nil {
+		return invoice, err
+	}
+
+	return invoice, nil
+}
+
+func (db *invoiceConnection) FindUnpaidInvoicesDueBefore(ctx context.Context, date time.Time) ([]entities.Fatura, error) {
+	ctx, span := tracer.Start(ctx, "InvoiceRepository.FindUnpaidInvoicesDueBefore")
+	defer span.End()
+
+	invoices := []entities.Fatura{}
+
+	err := db.connection.WithContext(ctx).Order("vencimento, cliente_id").
+		Find(&invoices, "estado <> ? AND vencimento < ?", entities.PAGA, date).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find unpaid invoices", err)
+	}
+
+	return invoices, nil
+}
+
+// NewInvoiceRepository cria uma nova instancia de InvoiceRepository.
+func NewInvoiceRepository(database *gorm.DB, logger *slog.Logger) InvoiceRepository {
+	return &invoiceConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,105 @@
+package repositories
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"gorm.io/gorm"
+)
+
+// PaymentRepository representa o contracto de PaymentRepository.
+type PaymentRepository interface {
+	CreatePayment(ctx context.Context, payment entities.Pagamento) (entities.Pagamento, entities.Fatura, error)
+	FindPaymentByIdentifier(ctx context.Context, identifier string) (entities.Pagamento, error)
+	FindPaymentsByInvoiceID(ctx context.Context, invoiceID string) ([]entities.Pagamento, error)
+}
+
+type paymentConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+// CreatePayment registra o pagamento e soma o seu valor na fatura na mesma transação, retornando a fatura com o
+// valor pago e o estado atualizados.
+func (db *paymentConnection) CreatePayment(ctx context.Context, payment entities.Pagamento) (entities.Pagamento, entities.Fatura, error) {
+	ctx, span := tracer.Start(ctx, "PaymentRepository.CreatePayment")
+	defer span.End()
+
+	invoice := entities.Fatura{}
+
+	err := db.connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Create(&payment).Error
+		if errors.Is(err, gorm.ErrDuplicatedKey) {
+			return ErrAlreadyExists
+		}
+
+		if err != nil {
+			return err
+		}
+
+		// A soma é feita pelo banco, que bloqueia a fatura até o fim da transação.
+		result := tx.Model(&entities.Fatura{}).Where("id = ? AND valor_pago < total", payment.FaturaID).
+			Update("valor_pago", gorm.Expr("valor_pago + ?", payment.Valor))
+		if result.Error != nil {
+			return result.Error
+		}
+
+		if result.RowsAffected == 0 {
+			return ErrInvoicePaid
+		}
+
+		err = tx.First(&invoice, "id = ?", payment.FaturaID).Error
+		if err != nil {
+			return err
+		}
+
+		invoice.Estado = dtos.InvoiceState(invoice, time.Now())
+
+		return tx.Model(&invoice).Update("estado", invoice.Estado).Error
+	})
+	if err != nil {
+		return payment, invoice, err
+	}
+
+	return payment, invoice, nil
+}
+
+func (db *paymentConnection) FindPaymentByIdentifier(ctx context.Context, identifier string) (entities.Pagamento, error) {
+	ctx, span := tracer.Start(ctx, "PaymentRepository.FindPaymentByIdentifier")
+	defer span.End()
+
+	payment := entities.Pagamento{}
+
+	err := db.connection.WithContext(ctx).First(&payment, "identificador = ?", identifier).Error
+	if err != nil {
+		return entities.Pagamento{}, queryError(ctx, db.logger, "failed to find payment by identifier", err)
+	}
+
+	return payment, nil
+}
+
+func (db *paymentConnection) FindPaymentsByInvoiceID(ctx context.Context, invoiceID string) ([]entities.Pagamento, error) {
+	ctx, span := tracer.Start(ctx, "PaymentRepository.FindPaymentsByInvoiceID")
+	defer span.End()
+
+	payments := []entities.Pagamento{}
+
+	err := db.connection.WithContext(ctx).Order("data_pagamento").Find(&payments, "fatura_id = ?", invoiceID).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find payments by invoice id", err)
+	}
+
+	return payments, nil
+}
+
+// NewPaymentRepository cria uma nova instancia de PaymentRepository.
+func NewPaymentRepository(database *gorm.DB, logger *slog.Logger) PaymentRepository {
+	return &paymentConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"gorm.io/gorm"
+)
+
+// PlanRepository representa o contracto de PlanRepository.
+type PlanRepository interface {
+	CreatePlan(ctx context.Context, plan entities.Plano) (entities.Plano, error)
+	UpdatePlan(ctx context.Context, plan entities.Plano) (entities.Plano, error)
+	FindPlanByID(ctx context.Context, planID string) (entities.Plano, error)
+	FindPlanByName(ctx context.Context, name string) (entities.Plano, error)
+	DeletePlan(ctx context.Context, plan entities.Plano) error
+	FindPlans(ctx context.Context, name string) ([]entities.Plano, error)
+	FindPlansByIDs(ctx context.Context, planIDs []string) ([]entities.Plano, error)
+}
+
+type planConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *planConnection) CreatePlan(ctx context.Context, plan entities.Plano) (entities.Plano, error) {
+	ctx, span := tracer.Start(ctx, "PlanRepository.CreatePlan")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&plan).Error
+	if err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+func (db *planConnection) UpdatePlan(ctx context.Context, plan entities.Plano) (entities.Plano, error) {
+	ctx, span := tracer.Start(ctx, "PlanRepository.UpdatePlan")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&plan).Error
+	if err != nil {
+		return plan, err
+	}
+
+	return plan, nil
+}
+
+func (db *planConnection) FindPlanByID(ctx context.Context, planID string) (entities.Plano, error) {
+	ctx, span := tracer.Start(ctx, "PlanRepository.FindPlanByID")
+	defer span.End()
+
+	plan := entities.Plano{}
+
+	err := db.connection.WithContext(ctx).First(&plan, "id = ?", planID).Error
+	if err != nil {
+		return entities.Plano{}, queryError(ctx, db.logger, "failed to find plan by id", err)
+	}
+
+	return plan, nil
+}
+
+func (db *planConnection) FindPlanByName(ctx context.Context, name string) (entities.Plano, error) {
+	ctx, span := tracer.Start(ctx, "PlanRepository.FindPlanByName")
+	defer span.End()
+
+	plan := entities.Plano{}
+
+	err := db.connection.WithContext(ctx).Unscoped().First(&plan, "nome = ?", name).Error
+	if err != nil {
+		return entities.Plano{}, queryError(ctx, db.logger, "failed to find plan by name", err)
+	}
+
+	return plan, nil
+}
+
+func (db *planConnection) DeletePlan(ctx context.Context, plan entities.Plano) error {
+	ctx, span := tracer.Start(ctx, "PlanRepository.DeletePlan")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Delete(&plan).Error
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (db *planConnection) FindPlans(ctx context.Context, name string) ([]entities.Plano, error) {
+	ctx, span := tracer.Start(ctx, "PlanRepository.FindPlans")
+	defer span.End()
+
+	plans := []entities.Plano{}
+
+	query := db.connection.WithContext(ctx).Order("preco_mensal, nome")
+
+	if name != "" {
+		query = query.Where("nome ILIKE ?", "%"+name+"%")
+	}
+
+	err := query.Find(&plans).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find plans", err)
+	}
+
+	return plans, nil
+}
+
+func (db *planConnection) FindPlansByIDs(ctx context.Context, planIDs []string) ([]entities.Plano, error) {
+	ctx, span := tracer.Start(ctx, "PlanRepository.FindPlansByIDs")
+	defer span.End()
+
+	plans := []entities.Plano{}
+
+	if len(planIDs) == 0 {
+		return plans, nil
+	}
+
+	// Os planos removidos do catalogo continuam valendo para os contratos que ainda os usam.
+	err := db.connection.WithContext(ctx).Unscoped().Find(&plans, "id IN ?", planIDs).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find plans by ids", err)
+	}
+
+	return plans, nil
+}
+
+// NewPlanRepository cria uma nova instancia de PlanRepository.
+func NewPlanRepository(database *gorm.DB, logger *slog.Logger) PlanRepository {
+	return &planConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -1,31 +1,49 @@
 package repositories
 
 import (
+	"context"
 	"fmt"
-	"log"
+	"log/slog"
+	"strings"
+	"sync"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/gorm"
 )
 
 // PointRepository representa o contracto de PointRepository.
 type PointRepository interface {
-	CreatePoint(point entities.Ponto) (entities.Ponto, error)
-	UpdatePoint(point entities.Ponto) (entities.Ponto, error)
-	FindPointByID(pointID string) entities.Ponto
-	FindPointByClientIDAndAddressID(clientID string, addressID string) entities.Ponto
-	FindPointsByClientID(clientID string) []entities.Ponto
-	FindPointsByAddressID(addressID string) []entities.Ponto
-	DeletePoint(point entities.Ponto) error
-	FindPoints(clientID string, addressID string) []entities.Ponto
+	CreatePoint(ctx context.Context, point entities.Ponto) (entities.Ponto, error)
+	UpdatePoint(ctx context.Context, point entities.Ponto) (entities.Ponto, error)
+	TransferPoint(ctx context.Context, point entities.Ponto, contractEvent *entities.ContratoEvento, contactID string) (entities.Ponto, error)
+	FindPointByID(ctx context.Context, pointID string) (entities.Ponto, error)
+	FindPointByClientIDAndAddressID(ctx context.Context, clientID string, addressID string) (entities.Ponto, error)
+	FindPointsByClientID(ctx context.Context, clientID string) ([]entities.Ponto, error)
+	FindPointsByAddressID(ctx context.Context, addressID string) ([]entities.Ponto, error)
+	DeletePoint(ctx context.Context, point entities.Ponto) error
+	FindPoints(ctx context.Context, clientID string, addressID string) ([]entities.Ponto, error)
+	FindPointsInArea(ctx context.Context, filter dtos.PointGeoFilter) ([]entities.Ponto, error)
 }
 
 type pointConnection struct {
 	connection *gorm.DB
+	logger     *slog.Logger
+
+	// postGIS indica se a extensão PostGIS está instalada. A verificação é repetida até a primeira consulta
+	// bem sucedida, para que o cancelamento de uma requisição não desative o PostGIS até o reinicio do processo.
+	postGIS        bool
+	postGISChecked bool
+	postGISMutex   sync.Mutex
 }
 
-func (db *pointConnection) CreatePoint(point entities.Ponto) (entities.Ponto, error) {
-	err := db.connection.Create(&point).Error
+func (db *pointConnection) CreatePoint(ctx context.Context, point entities.Ponto) (entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.CreatePoint")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&point).Error
 	if err != nil {
 		return point, err
 	}
@@ -33,8 +51,42 @@ func (db *pointConnection) CreatePoint(point entities.Ponto) (entities.Ponto, er
 	return point, nil
 }
 
-func (db *pointConnection) UpdatePoint(point entities.Ponto) (entities.Ponto, error) {
-	err := db.connection.Save(&point).Error
+func (db *pointConnection) UpdatePoint(ctx context.Context, point entities.Ponto) (entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.UpdatePoint")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&point).Error
+	if err != nil {
+		return point, err
+	}
+
+	return point, nil
+}
+
+// TransferPoint salva o ponto transferido para o novo cliente, o evento de transferencia do seu contrato e o novo
+// contato responsavel do contrato na mesma transação. O ponto sem contrato é transferido sem o evento.
+func (db *pointConnection) TransferPoint(ctx context.Context, point entities.Ponto, contractEvent *entities.ContratoEvento, contactID string) (entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.TransferPoint")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Save(&point).Error
+		if err != nil {
+			return err
+		}
+
+		if contractEvent == nil {
+			return nil
+		}
+
+		err = tx.Model(&entities.Contrato{}).Where("id = ?", contractEvent.ContratoID).
+			Update("contato_responsavel_id", contactID).Error
+		if err != nil {
+			return err
+		}
+
+		return tx.Create(contractEvent).Error
+	})
 	if err != nil {
 		return point, err
 	}
@@ -42,52 +94,68 @@ func (db *pointConnection) UpdatePoint(point entities.Ponto) (entities.Ponto, er
 	return point, nil
 }
 
-func (db *pointConnection) FindPointByID(pointID string) entities.Ponto {
+func (db *pointConnection) FindPointByID(ctx context.Context, pointID string) (entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.FindPointByID")
+	defer span.End()
+
 	point := entities.Ponto{}
 
-	err := db.connection.First(&point, "id = ?", pointID).Error
+	err := db.connection.WithContext(ctx).First(&point, "id = ?", pointID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return entities.Ponto{}, queryError(ctx, db.logger, "failed to find point by id", err)
 	}
 
-	return point
+	return point, nil
 }
 
-func (db *pointConnection) FindPointByClientIDAndAddressID(clientID string, addressID string) entities.Ponto {
+func (db *pointConnection) FindPointByClientIDAndAddressID(ctx context.Context, clientID string, addressID string) (entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.FindPointByClientIDAndAddressID")
+	defer span.End()
+
 	point := entities.Ponto{}
 
-	err := db.connection.Unscoped().First(&point, "cliente_id = ? AND endereco_id = ?", clientID, addressID).Error
+	err := db.connection.WithContext(ctx).Unscoped().First(&point, "cliente_id = ? AND endereco_id = ?", clientID, addressID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return entities.Ponto{}, queryError(ctx, db.logger, "failed to find point by client id and address id", err)
 	}
 
-	return point
+	return point, nil
 }
 
-func (db *pointConnection) FindPointsByClientID(clientID string) []entities.Ponto {
+func (db *pointConnection) FindPointsByClientID(ctx context.Context, clientID string) ([]entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.FindPointsByClientID")
+	defer span.End()
+
 	points := []entities.Ponto{}
 
-	err := db.connection.Find(&points, "cliente_id = ?", clientID).Error
+	err := db.connection.WithContext(ctx).Find(&points, "cliente_id = ?", clientID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return nil, queryError(ctx, db.logger, "failed to find points by client id", err)
 	}
 
-	return points
+	return points, nil
 }
 
-func (db *pointConnection) FindPointsByAddressID(addressID string) []entities.Ponto {
+func (db *pointConnection) FindPointsByAddressID(ctx context.Context, addressID string) ([]entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.FindPointsByAddressID")
+	defer span.End()
+
 	points := []entities.Ponto{}
 
-	err := db.connection.Find(&points, "endereco_id = ?", addressID).Error
+	err := db.connection.WithContext(ctx).Find(&points, "endereco_id = ?", addressID).Error
 	if err != nil {
-		log.Println(err.Error())
+		return nil, queryError(ctx, db.logger, "failed to find points by address id", err)
 	}
 
-	return points
+	return points, nil
 }
 
-func (db *pointConnection) DeletePoint(point entities.Ponto) error {
-	err := db.connection.Delete(&point).Error
+func (db *pointConnection) DeletePoint(ctx context.Context, point entities.Ponto) error {
+	ctx, span := tracer.Start(ctx, "PointRepository.DeletePoint",
+		trace.WithAttributes(attribute.String("ponto.id", point.ID)))
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Delete(&point).Error
 	if err != nil {
 		return err
 	}
@@ -95,7 +163,10 @@ func (db *pointConnection) DeletePoint(point entities.Ponto) error {
 	return nil
 }
 
-func (db *pointConnection) FindPoints(clientID string, addressID string) []entities.Ponto {
+func (db *pointConnection) FindPoints(ctx context.Context, clientID string, addressID string) ([]entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.FindPoints")
+	defer span.End()
+
 	points := []entities.Ponto{}
 
 	var sqlQuery string
@@ -112,17 +183,90 @@ func (db *pointConnection) FindPoints(clientID string, addressID string) []entit
 		sqlQuery += "AND NOT endereco_id IS NULL"
 	}
 
-	err := db.connection.Preload("Cliente").Preload("Endereco").Find(&points, sqlQuery).Error
+	err := db.connection.WithContext(ctx).Preload("Cliente").Preload("Endereco").Find(&points, sqlQuery).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find points", err)
+	}
+
+	return points, nil
+}
+
+func (db *pointConnection) FindPointsInArea(ctx context.Context, filter dtos.PointGeoFilter) ([]entities.Ponto, error) {
+	ctx, span := tracer.Start(ctx, "PointRepository.FindPointsInArea")
+	defer span.End()
+
+	points := []entities.Ponto{}
+
+	query := db.connection.WithContext(ctx).Preload("Cliente").Preload("Endereco").
+		Joins("JOIN t_endereco ON t_endereco.id = t_ponto.endereco_id AND t_endereco.data_remocao IS NULL").
+		Where("t_endereco.latitude IS NOT NULL AND t_endereco.longitude IS NOT NULL")
+
+	for _, box := range filter.BoundingBoxes() {
+		query = query.Where("t_endereco.latitude BETWEEN ? AND ? AND t_endereco.longitude BETWEEN ? AND ?",
+			box.MinLatitude, box.MaxLatitude, box.MinLongitude, box.MaxLongitude)
+	}
+
+	// Com o PostGIS o filtro exato também é feito no banco de dados, sem ele o service filtra os pontos do retangulo.
+	if db.hasPostGIS(ctx) {
+		span.SetAttributes(attribute.Bool("postgis", true))
+
+		if filter.Perto != nil && filter.Raio > 0 {
+			query = query.Where("ST_DWithin(ST_MakePoint(t_endereco.longitude, t_endereco.latitude)::geography, "+
+				"ST_MakePoint(?, ?)::geography, ?)", filter.Perto.Longitude, filter.Perto.Latitude, filter.Raio)
+		}
+
+		if len(filter.Poligono) != 0 {
+			query = query.Where("ST_Contains(ST_GeomFromText(?, 4326), "+
+				"ST_SetSRID(ST_MakePoint(t_endereco.longitude, t_endereco.latitude), 4326))", polygonWKT(filter.Poligono))
+		}
+	}
+
+	err := query.Find(&points).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find points in area", err)
+	}
+
+	return points, nil
+}
+
+func (db *pointConnection) hasPostGIS(ctx context.Context) bool {
+	db.postGISMutex.Lock()
+	defer db.postGISMutex.Unlock()
+
+	if db.postGISChecked {
+		return db.postGIS
+	}
+
+	installed := false
+
+	err := db.connection.WithContext(ctx).
+		Raw("SELECT EXISTS (SELECT 1 FROM pg_extension WHERE extname = 'postgis')").Scan(&installed).Error
 	if err != nil {
-		log.Println(err.Error())
+		db.logger.WarnContext(ctx, "failed to check postgis extension", slog.String("error", err.Error()))
+		return false
+	}
+
+	db.postGIS = installed
+	db.postGISChecked = true
+
+	return db.postGIS
+}
+
+// polygonWKT converte o poligono para o formato WKT usado pelo PostGIS, fechando o anel.
+func polygonWKT(polygon []dtos.Coordinate) string {
+	vertices := []string{}
+
+	for _, vertex := range append(polygon, polygon[0]) {
+		vertices = append(vertices, fmt.Sprintf("%v %v", vertex.Longitude, vertex.Latitude))
 	}
 
-	return points
+	return "POLYGON((" + strings.Join(vertices, ", ") + "))"
 }
 
 // NewPointRepository cria uma nova instancia de PointRepository.
-func NewPointRepository(database *gorm.DB) PointRepository {
+func NewPointRepository(database *gorm.DB, logger *slog.Logger) PointRepository {
 	return &pointConnection{
 		connection: database,
+		logger:     logger,
 	}
 }
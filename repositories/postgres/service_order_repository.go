@@ -0,0 +1,150 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"gorm.io/gorm"
+)
+
+// ServiceOrderRepository representa o contracto de ServiceOrderRepository.
+type ServiceOrderRepository interface {
+	CreateServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error)
+	UpdateServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error)
+	BookServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error)
+	FindServiceOrderByID(ctx context.Context, serviceOrderID string) (entities.OrdemServico, error)
+	FindServiceOrders(ctx context.Context, filter dtos.ServiceOrderFilter) ([]entities.OrdemServico, error)
+}
+
+type serviceOrderConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *serviceOrderConnection) CreateServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderRepository.CreateServiceOrder")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&serviceOrder).Error
+	if err != nil {
+		return serviceOrder, err
+	}
+
+	return serviceOrder, nil
+}
+
+func (db *serviceOrderConnection) UpdateServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderRepository.UpdateServiceOrder")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Save(&serviceOrder).Error
+	if err != nil {
+		return serviceOrder, err
+	}
+
+	return serviceOrder, nil
+}
+
+// BookServiceOrder salva a ordem de serviço agendada, criando-a quando ainda não existe. Os agendamentos do
+// mesmo tecnico são serializados pelo advisory lock da transação, e a janela que se sobrepõe a outra ordem não
+// cancelada do tecnico retorna ErrScheduleConflict.
+func (db *serviceOrderConnection) BookServiceOrder(ctx context.Context, serviceOrder entities.OrdemServico) (entities.OrdemServico, error) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderRepository.BookServiceOrder")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		err := tx.Exec("SELECT pg_advisory_xact_lock(hashtext(?))", serviceOrder.Tecnico).Error
+		if err != nil {
+			return err
+		}
+
+		var conflicts int64
+
+		err = tx.Model(&entities.OrdemServico{}).
+			Where("tecnico = ? AND id::text <> ? AND estado <> ?", serviceOrder.Tecnico, serviceOrder.ID, entities.ORDEM_CANCELADA).
+			Where("inicio_janela < ? AND fim_janela > ?", serviceOrder.FimJanela, serviceOrder.InicioJanela).
+			Count(&conflicts).Error
+		if err != nil {
+			return err
+		}
+
+		if conflicts > 0 {
+			return ErrScheduleConflict
+		}
+
+		return tx.Save(&serviceOrder).Error
+	})
+	if err != nil {
+		return serviceOrder, err
+	}
+
+	return serviceOrder, nil
+}
+
+func (db *serviceOrderConnection) FindServiceOrderByID(ctx context.Context, serviceOrderID string) (entities.OrdemServico, error) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderRepository.FindServiceOrderByID")
+	defer span.End()
+
+	serviceOrder := entities.OrdemServico{}
+
+	err := db.connection.WithContext(ctx).First(&serviceOrder, "id = ?", serviceOrderID).Error
+	if err != nil {
+		return entities.OrdemServico{}, queryError(ctx, db.logger, "failed to find service order by id", err)
+	}
+
+	return serviceOrder, nil
+}
+
+func (db *serviceOrderConnection) FindServiceOrders(ctx context.Context, filter dtos.ServiceOrderFilter) ([]entities.OrdemServico, error) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderRepository.FindServiceOrders")
+	defer span.End()
+
+	serviceOrders := []entities.OrdemServico{}
+
+	query := db.connection.WithContext(ctx).Order("inicio_janela NULLS LAST, data_criacao")
+
+	if filter.PontoID != "" {
+		query = query.Where("ponto_id = ?", filter.PontoID)
+	}
+
+	if filter.ContratoID != "" {
+		query = query.Where("contrato_id = ?", filter.ContratoID)
+	}
+
+	if filter.Tecnico != "" {
+		query = query.Where("tecnico = ?", filter.Tecnico)
+	}
+
+	if filter.Tipo != "" {
+		query = query.Where("tipo = ?", filter.Tipo)
+	}
+
+	if filter.Estado != "" {
+		query = query.Where("estado = ?", filter.Estado)
+	}
+
+	if filter.De != nil {
+		query = query.Where("fim_janela > ?", *filter.De)
+	}
+
+	if filter.Ate != nil {
+		query = query.Where("inicio_janela < ?", *filter.Ate)
+	}
+
+	err := query.Find(&serviceOrders).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find service orders", err)
+	}
+
+	return serviceOrders, nil
+}
+
+// NewServiceOrderRepository cria uma nova instancia de ServiceOrderRepository.
+func NewServiceOrderRepository(database *gorm.DB, logger *slog.Logger) ServiceOrderRepository {
+	return &serviceOrderConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,78 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"gorm.io/gorm"
+)
+
+// TechnicianRepository representa o contracto de TechnicianRepository.
+type TechnicianRepository interface {
+	CreateTechnician(ctx context.Context, technician entities.Tecnico) (entities.Tecnico, error)
+	FindTechnicianByName(ctx context.Context, name string) (entities.Tecnico, error)
+	FindTechnicians(ctx context.Context, neighborhoodKey string) ([]entities.Tecnico, error)
+}
+
+type technicianConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *technicianConnection) CreateTechnician(ctx context.Context, technician entities.Tecnico) (entities.Tecnico, error) {
+	ctx, span := tracer.Start(ctx, "TechnicianRepository.CreateTechnician")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&technician).Error
+	if err != nil {
+		return technician, err
+	}
+
+	return technician, nil
+}
+
+func (db *technicianConnection) FindTechnicianByName(ctx context.Context, name string) (entities.Tecnico, error) {
+	ctx, span := tracer.Start(ctx, "TechnicianRepository.FindTechnicianByName")
+	defer span.End()
+
+	technician := entities.Tecnico{}
+
+	err := db.connection.WithContext(ctx).Preload("Bairros").First(&technician, "LOWER(nome) = LOWER(?)", name).Error
+	if err != nil {
+		return entities.Tecnico{}, queryError(ctx, db.logger, "failed to find technician by name", err)
+	}
+
+	return technician, nil
+}
+
+// FindTechnicians busca os tecnicos ordenados pelo nome, apenas os que atendem o bairro quando a chave
+// normalizada do bairro é informada.
+func (db *technicianConnection) FindTechnicians(ctx context.Context, neighborhoodKey string) ([]entities.Tecnico, error) {
+	ctx, span := tracer.Start(ctx, "TechnicianRepository.FindTechnicians")
+	defer span.End()
+
+	technicians := []entities.Tecnico{}
+
+	query := db.connection.WithContext(ctx).Preload("Bairros").Order("nome")
+
+	if neighborhoodKey != "" {
+		query = query.Where("EXISTS (SELECT 1 FROM t_tecnico_bairro b WHERE b.tecnico_id = t_tecnico.id AND b.bairro_normalizado = ?)",
+			neighborhoodKey)
+	}
+
+	err := query.Find(&technicians).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find technicians", err)
+	}
+
+	return technicians, nil
+}
+
+// NewTechnicianRepository cria uma nova instancia de TechnicianRepository.
+func NewTechnicianRepository(database *gorm.DB, logger *slog.Logger) TechnicianRepository {
+	return &technicianConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,126 @@
+package repositories
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"gorm.io/gorm"
+)
+
+// TicketRepository representa o contracto de TicketRepository.
+type TicketRepository interface {
+	CreateTicket(ctx context.Context, ticket entities.Chamado) (entities.Chamado, error)
+	UpdateTicket(ctx context.Context, ticket entities.Chamado) (entities.Chamado, error)
+	FindTicketByID(ctx context.Context, ticketID string) (entities.Chamado, error)
+	FindTickets(ctx context.Context, filter dtos.TicketFilter) ([]entities.Chamado, error)
+	CreateTicketComment(ctx context.Context, comment entities.ChamadoComentario) (entities.ChamadoComentario, error)
+}
+
+type ticketConnection struct {
+	connection *gorm.DB
+	logger     *slog.Logger
+}
+
+func (db *ticketConnection) CreateTicket(ctx context.Context, ticket entities.Chamado) (entities.Chamado, error) {
+	ctx, span := tracer.Start(ctx, "TicketRepository.CreateTicket")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&ticket).Error
+	if err != nil {
+		return ticket, err
+	}
+
+	return ticket, nil
+}
+
+func (db *ticketConnection) UpdateTicket(ctx context.Context, ticket entities.Chamado) (entities.Chamado, error) {
+	ctx, span := tracer.Start(ctx, "TicketRepository.UpdateTicket")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Omit("Comentarios").Save(&ticket).Error
+	if err != nil {
+		return ticket, err
+	}
+
+	return ticket, nil
+}
+
+func (db *ticketConnection) FindTicketByID(ctx context.Context, ticketID string) (entities.Chamado, error) {
+	ctx, span := tracer.Start(ctx, "TicketRepository.FindTicketByID")
+	defer span.End()
+
+	ticket := entities.Chamado{}
+
+	err := db.connection.WithContext(ctx).Preload("Comentarios", func(tx *gorm.DB) *gorm.DB {
+		return tx.Order("data_criacao")
+	}).First(&ticket, "id = ?", ticketID).Error
+	if err != nil {
+		return entities.Chamado{}, queryError(ctx, db.logger, "failed to find ticket by id", err)
+	}
+
+	return ticket, nil
+}
+
+// FindTickets busca os chamados pelos filtros, ordenados pelo prazo do SLA.
+func (db *ticketConnection) FindTickets(ctx context.Context, filter dtos.TicketFilter) ([]entities.Chamado, error) {
+	ctx, span := tracer.Start(ctx, "TicketRepository.FindTickets")
+	defer span.End()
+
+	tickets := []entities.Chamado{}
+
+	query := db.connection.WithContext(ctx).Order("prazo_sla")
+
+	if filter.ClienteID != "" {
+		query = query.Where("cliente_id = ?", filter.ClienteID)
+	}
+
+	if filter.Estado != "" {
+		query = query.Where("estado = ?", filter.Estado)
+	}
+
+	if filter.Prioridade != "" {
+		query = query.Where("prioridade = ?", filter.Prioridade)
+	}
+
+	if filter.Categoria != "" {
+		query = query.Where("categoria = ?", filter.Categoria)
+	}
+
+	if filter.Abertos || filter.Atrasados {
+		query = query.Where("estado NOT IN ?", []entities.TicketStatus{entities.CHAMADO_RESOLVIDO, entities.CHAMADO_FECHADO})
+	}
+
+	if filter.Atrasados {
+		query = query.Where("prazo_sla < ?", time.Now())
+	}
+
+	err := query.Find(&tickets).Error
+	if err != nil {
+		return nil, queryError(ctx, db.logger, "failed to find tickets", err)
+	}
+
+	return tickets, nil
+}
+
+func (db *ticketConnection) CreateTicketComment(ctx context.Context, comment entities.ChamadoComentario) (entities.ChamadoComentario, error) {
+	ctx, span := tracer.Start(ctx, "TicketRepository.CreateTicketComment")
+	defer span.End()
+
+	err := db.connection.WithContext(ctx).Create(&comment).Error
+	if err != nil {
+		return comment, err
+	}
+
+	return comment, nil
+}
+
+// NewTicketRepository cria uma nova instancia de TicketRepository.
+func NewTicketRepository(database *gorm.DB, logger *slog.Logger) TicketRepository {
+	return &ticketConnection{
+		connection: database,
+		logger:     logger,
+	}
+}
@@ -0,0 +1,6 @@
+package repositories
+
+import "go.opentelemetry.io/otel"
+
+// tracer usado para criar os spans da camada de repositorios.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres")
@@ -0,0 +1,46 @@
+package middlewares
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// PrincipalKey chave do gin.Context onde a autenticação registra o usuario da requisição.
+const PrincipalKey = "principal"
+
+// AccessLog registra em JSON cada requisição atendida com rota, estado, latencia e usuario.
+func AccessLog(log *slog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		start := time.Now()
+
+		ctx.Next()
+
+		principal := ctx.GetString(PrincipalKey)
+		if principal == "" {
+			principal = "anonymous"
+		}
+
+		status := ctx.Writer.Status()
+
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		log.LogAttrs(ctx.Request.Context(), level, "request completed",
+			slog.String("method", ctx.Request.Method),
+			slog.String("route", ctx.FullPath()),
+			slog.String("path", ctx.Request.URL.Path),
+			slog.Int("status", status),
+			slog.Float64("latency_ms", float64(time.Since(start).Microseconds())/1000),
+			slog.String("client_ip", ctx.ClientIP()),
+			slog.String("principal", principal),
+		)
+	}
+}
@@ -0,0 +1,23 @@
+package middlewares
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/gin-gonic/gin"
+	"github.com/gofrs/uuid"
+)
+
+// RequestID reaproveita o cabeçalho X-Request-ID recebido, ou gera um novo, e o devolve na resposta.
+func RequestID() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(logger.RequestIDHeader)
+		if requestID == "" {
+			newID, _ := uuid.NewV4()
+			requestID = newID.String()
+		}
+
+		ctx.Request = ctx.Request.WithContext(logger.WithRequestID(ctx.Request.Context(), requestID))
+		ctx.Header(logger.RequestIDHeader, requestID)
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,29 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin"
+)
+
+// Timeout define um prazo para o contexto da requisição, cancelando as consultas ao banco quando ele expira.
+// Caso o prazo expire sem que o handler tenha respondido, devolve 504.
+func Timeout(timeout time.Duration) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestCtx, cancel := context.WithTimeout(ctx.Request.Context(), timeout)
+		defer cancel()
+
+		ctx.Request = ctx.Request.WithContext(requestCtx)
+
+		ctx.Next()
+
+		if errors.Is(requestCtx.Err(), context.DeadlineExceeded) && !ctx.Writer.Written() {
+			response := utils.NewResponse(utils.RequestTimeout)
+			ctx.AbortWithStatusJSON(http.StatusGatewayTimeout, response)
+		}
+	}
+}
@@ -1,53 +1,177 @@
 package routes
 
 import (
+	"log/slog"
+	"os"
+	"time"
+
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/database"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/server/middlewares"
 	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	billingService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/billing_service"
+	cepService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/cep_service"
+	clientMergeService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_merge_service"
 	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
 	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
 	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	importService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/import_service"
+	paymentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/payment_service"
+	planService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/plan_service"
 	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	scheduleService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/schedule_service"
+	serviceOrderService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/service_order_service"
+	ticketService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/ticket_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/telemetry"
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 // ConfigRoutes define as configurações das rotas.
-func ConfigRoutes(router *gin.Engine) *gin.Engine {
+func ConfigRoutes(router *gin.Engine, logger *slog.Logger) *gin.Engine {
 	// Database
 	db := database.GetDB()
 
 	// Repositories
-	clientRepository := repositories.NewClientRepository(db)
-	addressRepository := repositories.NewAddressRepository(db)
-	pointRepository := repositories.NewPointRepository(db)
-	contractRepository := repositories.NewContractRepository(db)
-	contractEventRepository := repositories.NewContractEventRepository(db)
+	clientRepository := repositories.NewClientRepository(db, logger)
+	addressRepository := repositories.NewAddressRepository(db, logger)
+	pointRepository := repositories.NewPointRepository(db, logger)
+	contractRepository := repositories.NewContractRepository(db, logger)
+	contractEventRepository := repositories.NewContractEventRepository(db, logger)
+	contractVersionRepository := repositories.NewContractVersionRepository(db, logger)
+	contactRepository := repositories.NewContactRepository(db, logger)
+	cepRepository := repositories.NewCEPRepository(db, logger)
+	clientMergeRepository := repositories.NewClientMergeRepository(db, logger)
+	planRepository := repositories.NewPlanRepository(db, logger)
+	serviceOrderRepository := repositories.NewServiceOrderRepository(db, logger)
+	equipmentRepository := repositories.NewEquipmentRepository(db, logger)
+	equipmentMovementRepository := repositories.NewEquipmentMovementRepository(db, logger)
+	technicianRepository := repositories.NewTechnicianRepository(db, logger)
+	ticketRepository := repositories.NewTicketRepository(db, logger)
+	importRepository := repositories.NewImportRepository(db, logger)
+	invoiceRepository := repositories.NewInvoiceRepository(db, logger)
+	paymentRepository := repositories.NewPaymentRepository(db, logger)
+
+	// Policies
+	clientPolicies := policies.Load()
+	billingPolicy := policies.LoadBilling()
+	schedulePolicy := policies.LoadSchedule()
 
 	// Services
-	contractEventService := contractEventService.NewContractEventService(contractEventRepository, contractRepository)
-	contractService := contractService.NewContractService(contractRepository, pointRepository, contractEventService)
-	pointService := pointService.NewPointService(pointRepository, clientRepository, addressRepository, contractService)
-	clientService := clientService.NewClientService(clientRepository, pointService)
-	addressService := addressService.NewAddressService(addressRepository, pointService)
+	contractEventService := contractEventService.NewContractEventService(contractEventRepository, contractRepository, logger)
+	contractVersionService := contractVersionService.NewContractVersionService(contractVersionRepository, contractRepository, logger)
+	equipmentService := equipmentService.NewEquipmentService(equipmentRepository, equipmentMovementRepository, pointRepository, logger)
+	contractService := contractService.NewContractService(contractRepository, pointRepository, clientRepository, contactRepository, planRepository,
+		serviceOrderRepository, contractEventService, contractVersionService, equipmentService, clientPolicies, logger)
+	pointService := pointService.NewPointService(pointRepository, clientRepository, addressRepository, contractService,
+		equipmentService, clientPolicies, logger)
+	cepService := cepService.NewCEPService(cepRepository, logger)
+	contactService := contactService.NewContactService(contactRepository, clientRepository, logger)
+	clientService := clientService.NewClientService(clientRepository, ticketRepository, pointService, contactService, logger)
+	addressService := addressService.NewAddressService(addressRepository, cepRepository, pointService, logger)
+	clientMergeService := clientMergeService.NewClientMergeService(clientMergeRepository, clientRepository, pointRepository,
+		contractRepository, contactRepository, logger)
+	planService := planService.NewPlanService(planRepository, contractRepository, logger)
+	billingService := billingService.NewBillingService(invoiceRepository, contractRepository, contractEventRepository,
+		planRepository, billingPolicy, logger)
+	paymentService := paymentService.NewPaymentService(paymentRepository, invoiceRepository, contractService,
+		contractEventService, clientPolicies, billingPolicy, logger)
+	serviceOrderService := serviceOrderService.NewServiceOrderService(serviceOrderRepository, pointRepository, technicianRepository,
+		contractService, logger)
+	scheduleService := scheduleService.NewScheduleService(technicianRepository, serviceOrderRepository, pointRepository,
+		addressRepository, schedulePolicy, logger)
+	ticketService := ticketService.NewTicketService(ticketRepository, clientRepository, pointRepository, contractRepository,
+		clientPolicies, logger)
+	importService := importService.NewImportService(importRepository, clientRepository, addressRepository, pointRepository,
+		contractRepository, contactRepository, planRepository, cepRepository, clientPolicies, logger)
 
 	// Controllers
-	clientController := controllers.NewClientController(clientService)
-	addressController := controllers.NewAddressController(addressService)
-	pointController := controllers.NewPointController(pointService)
-	contractController := controllers.NewContractController(contractService)
-	contractEventController := controllers.NewContractEventController(contractEventService)
+	clientController := controllers.NewClientController(clientService, logger)
+	addressController := controllers.NewAddressController(addressService, logger)
+	pointController := controllers.NewPointController(pointService, logger)
+	contractController := controllers.NewContractController(contractService, logger)
+	contractEventController := controllers.NewContractEventController(contractEventService, logger)
+	contractVersionController := controllers.NewContractVersionController(contractVersionService, logger)
+	contactController := controllers.NewContactController(contactService, logger)
+	cepController := controllers.NewCEPController(cepService, logger)
+	clientMergeController := controllers.NewClientMergeController(clientMergeService, logger)
+	planController := controllers.NewPlanController(planService, logger)
+	billingController := controllers.NewBillingController(billingService, logger)
+	paymentController := controllers.NewPaymentController(paymentService, logger)
+	serviceOrderController := controllers.NewServiceOrderController(serviceOrderService, logger)
+	equipmentController := controllers.NewEquipmentController(equipmentService, logger)
+	scheduleController := controllers.NewScheduleController(scheduleService, logger)
+	ticketController := controllers.NewTicketController(ticketService, logger)
+	importController := controllers.NewImportController(importService, logger)
 
 	router.SetTrustedProxies([]string{"192.168.1.2"})
 	main := router.Group("api/v1")
+	main.Use(otelgin.Middleware(telemetry.ServiceName))
 	{
-		ClientRouterConfig(main, clientController)
-		AddressRouterConfig(main, addressController)
-		PointRouterConfig(main, pointController)
-		ContractRouterConfig(main, contractController)
-		ContractEventRouterConfig(main, contractEventController)
+		ClientRouterConfig(timeoutGroup(main, "CLIENTES"), clientController)
+		ClientMergeRouterConfig(timeoutGroup(main, "CLIENTES"), clientMergeController)
+		AddressRouterConfig(timeoutGroup(main, "ENDERECOS"), addressController)
+		PointRouterConfig(timeoutGroup(main, "PONTOS"), pointController)
+		ContractRouterConfig(timeoutGroup(main, "CONTRATOS"), contractController)
+		ContractEventRouterConfig(timeoutGroup(main, "HISTORICOS"), contractEventController)
+		ContractVersionRouterConfig(timeoutGroup(main, "HISTORICOS"), contractVersionController)
+		ContactRouterConfig(timeoutGroup(main, "CONTATOS"), contactController)
+		CEPRouterConfig(timeoutGroup(main, "CEP"), cepController)
+		PlanRouterConfig(timeoutGroup(main, "PLANOS"), planController)
+		BillingRouterConfig(timeoutGroup(main, "FATURAMENTO"), billingController)
+		PaymentRouterConfig(timeoutGroup(main, "FATURAMENTO"), paymentController)
+		ServiceOrderRouterConfig(timeoutGroup(main, "ORDENS"), serviceOrderController)
+		EquipmentRouterConfig(timeoutGroup(main, "EQUIPAMENTOS"), equipmentController)
+		ScheduleRouterConfig(timeoutGroup(main, "AGENDA"), scheduleController)
+		TicketRouterConfig(timeoutGroup(main, "CHAMADOS"), ticketController)
+		ImportRouterConfig(timeoutGroup(main, "IMPORTACOES"), importController)
 	}
 	SwaggerRouterConfig(router.Group(""))
 
 	return router
 }
+
+// defaultRequestTimeout prazo usado quando REQUEST_TIMEOUT não está definido.
+const defaultRequestTimeout = 10 * time.Second
+
+// groupRequestTimeouts prazos padrão dos grupos com as rotas de importação e processamento em lote, que não
+// terminam no prazo padrão das demais rotas.
+var groupRequestTimeouts = map[string]time.Duration{
+	"CEP":         5 * time.Minute,
+	"FATURAMENTO": 10 * time.Minute,
+	"IMPORTACOES": 10 * time.Minute,
+}
+
+// timeoutGroup cria um grupo de rotas com o prazo definido em REQUEST_TIMEOUT_<NOME>, ou, quando o grupo não
+// possui um prazo próprio, com o prazo padrão do grupo ou o definido em REQUEST_TIMEOUT.
+func timeoutGroup(router *gin.RouterGroup, name string) *gin.RouterGroup {
+	return router.Group("", middlewares.Timeout(requestTimeout(name)))
+}
+
+func requestTimeout(name string) time.Duration {
+	keys := []string{"REQUEST_TIMEOUT_" + name}
+
+	// O REQUEST_TIMEOUT não reduz o prazo padrão dos grupos em lote.
+	groupTimeout, ok := groupRequestTimeouts[name]
+	if !ok {
+		keys = append(keys, "REQUEST_TIMEOUT")
+	}
+
+	for _, key := range keys {
+		timeout, err := time.ParseDuration(os.Getenv(key))
+		if err == nil && timeout > 0 {
+			return timeout
+		}
+	}
+
+	if ok {
+		return groupTimeout
+	}
+
+	return defaultRequestTimeout
+}
@@ -11,6 +11,7 @@ func AddressRouterConfig(router *gin.RouterGroup, addressController controllers.
 	{
 		addresses.POST("/", addressController.CreateAddress)
 		addresses.GET("/", addressController.FindAddress)
+		addresses.GET("/duplicados", addressController.FindDuplicateAddresses)
 	}
 
 	address := router.Group("endereco")
@@ -0,0 +1,24 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// BillingRouterConfig define as configurações das rotas do faturamento.
+func BillingRouterConfig(router *gin.RouterGroup, billingController controllers.BillingController) {
+	billing := router.Group("faturamento")
+	{
+		billing.POST("/executar", billingController.RunBilling)
+	}
+
+	invoices := router.Group("faturas")
+	{
+		invoices.GET("/", billingController.FindInvoices)
+	}
+
+	invoice := router.Group("fatura")
+	{
+		invoice.GET("/:id", billingController.FindInvoiceByID)
+	}
+}
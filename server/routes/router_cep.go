@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// CEPRouterConfig define as configurações das rotas do diretorio de CEPs.
+func CEPRouterConfig(router *gin.RouterGroup, cepController controllers.CEPController) {
+	cep := router.Group("cep")
+	{
+		cep.POST("/importacoes", cepController.ImportCEPs)
+		cep.GET("/:cep", cepController.FindCEP)
+	}
+}
@@ -18,5 +18,6 @@ func ClientRouterConfig(router *gin.RouterGroup, clientController controllers.Cl
 		client.PUT("/:id", clientController.UpdateClient)
 		client.GET("/:id", clientController.FindClientByID)
 		client.DELETE("/:id", clientController.DeleteClient)
+		client.GET("/:id/filiais", clientController.FindBranches)
 	}
 }
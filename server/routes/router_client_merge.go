@@ -0,0 +1,19 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// ClientMergeRouterConfig define as configurações das rotas da mesclagem de clientes.
+func ClientMergeRouterConfig(router *gin.RouterGroup, clientMergeController controllers.ClientMergeController) {
+	clients := router.Group("clientes")
+	{
+		clients.POST("/merge", clientMergeController.MergeClients)
+	}
+
+	client := router.Group("cliente")
+	{
+		client.GET("/:id/mesclagens", clientMergeController.FindClientMerges)
+	}
+}
@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// ContactRouterConfig define as configurações das rotas dos contatos dos clientes.
+func ContactRouterConfig(router *gin.RouterGroup, contactController controllers.ContactController) {
+	contacts := router.Group("cliente/:id/contatos")
+	{
+		contacts.POST("/", contactController.CreateContact)
+		contacts.GET("/", contactController.FindContacts)
+		contacts.PUT("/:contato_id", contactController.UpdateContact)
+		contacts.GET("/:contato_id", contactController.FindContactByID)
+		contacts.DELETE("/:contato_id", contactController.DeleteContact)
+	}
+
+	client := router.Group("cliente")
+	{
+		client.PUT("/:id/canal-preferido", contactController.UpdatePreferredChannel)
+	}
+}
@@ -11,6 +11,8 @@ func ContractRouterConfig(router *gin.RouterGroup, contractController controller
 	{
 		clients.POST("/", contractController.CreateContract)
 		clients.GET("/", contractController.FindContracts)
+		clients.GET("/resumo", contractController.SummarizeContracts)
+		clients.GET("/renovacao", contractController.FindContractsForRenewal)
 	}
 
 	client := router.Group("contrato")
@@ -18,5 +20,7 @@ func ContractRouterConfig(router *gin.RouterGroup, contractController controller
 		client.PUT("/:id", contractController.UpdateContract)
 		client.GET("/:id", contractController.FindContractByID)
 		client.DELETE("/:id", contractController.DeleteContract)
+		client.PUT("/:id/plano", contractController.ChangePlan)
+		client.POST("/:id/renovacao", contractController.RenewContract)
 	}
 }
@@ -0,0 +1,15 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// ContractVersionRouterConfig define as configurações das rotas das versões dos contratos.
+func ContractVersionRouterConfig(router *gin.RouterGroup, contractVersionController controllers.ContractVersionController) {
+	versions := router.Group("contrato")
+	{
+		versions.GET("/:id/versoes", contractVersionController.FindContractVersions)
+		versions.GET("/:id/versoes/diferencas", contractVersionController.DiffContractVersions)
+	}
+}
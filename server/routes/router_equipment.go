@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// EquipmentRouterConfig define as configurações das rotas dos equipamentos.
+func EquipmentRouterConfig(router *gin.RouterGroup, equipmentController controllers.EquipmentController) {
+	equipments := router.Group("equipamentos")
+	{
+		equipments.POST("/", equipmentController.CreateEquipment)
+		equipments.GET("/", equipmentController.FindEquipments)
+		equipments.GET("/devolucoes", equipmentController.FindEquipmentsToReturn)
+	}
+
+	equipment := router.Group("equipamento")
+	{
+		equipment.GET("/:id", equipmentController.FindEquipmentByID)
+		equipment.GET("/:id/movimentacoes", equipmentController.FindEquipmentMovements)
+		equipment.PUT("/:id/movimentacao", equipmentController.MoveEquipment)
+	}
+}
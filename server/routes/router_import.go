@@ -0,0 +1,14 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// ImportRouterConfig define as configurações das rotas da importação em lote.
+func ImportRouterConfig(router *gin.RouterGroup, importController controllers.ImportController) {
+	imports := router.Group("importacoes")
+	{
+		imports.POST("/", importController.ImportRecords)
+	}
+}
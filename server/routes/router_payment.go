@@ -0,0 +1,25 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// PaymentRouterConfig define as configurações das rotas dos pagamentos e da inadimplencia.
+func PaymentRouterConfig(router *gin.RouterGroup, paymentController controllers.PaymentController) {
+	billing := router.Group("faturamento")
+	{
+		billing.POST("/inadimplencia", paymentController.SuspendDelinquentContracts)
+	}
+
+	payments := router.Group("pagamentos")
+	{
+		payments.POST("/importacoes", paymentController.ImportPayments)
+	}
+
+	invoice := router.Group("fatura")
+	{
+		invoice.POST("/:id/pagamentos", paymentController.CreatePayment)
+		invoice.GET("/:id/pagamentos", paymentController.FindPaymentsByInvoiceID)
+	}
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// PlanRouterConfig define as configurações das rotas do catalogo de planos.
+func PlanRouterConfig(router *gin.RouterGroup, planController controllers.PlanController) {
+	plans := router.Group("planos")
+	{
+		plans.POST("/", planController.CreatePlan)
+		plans.GET("/", planController.FindPlans)
+	}
+
+	plan := router.Group("plano")
+	{
+		plan.PUT("/:id", planController.UpdatePlan)
+		plan.GET("/:id", planController.FindPlanByID)
+		plan.DELETE("/:id", planController.DeletePlan)
+	}
+}
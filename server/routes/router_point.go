@@ -16,5 +16,7 @@ func PointRouterConfig(router *gin.RouterGroup, pointController controllers.Poin
 	point := router.Group("ponto")
 	{
 		point.DELETE("/:id", pointController.DeletePoint)
+		point.GET("/:id/contratos", pointController.FindPointContracts)
+		point.PUT("/:id/transferencia", pointController.TransferPoint)
 	}
 }
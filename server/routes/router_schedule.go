@@ -0,0 +1,21 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// ScheduleRouterConfig define as configurações das rotas dos tecnicos e da agenda.
+func ScheduleRouterConfig(router *gin.RouterGroup, scheduleController controllers.ScheduleController) {
+	technicians := router.Group("tecnicos")
+	{
+		technicians.POST("/", scheduleController.CreateTechnician)
+		technicians.GET("/", scheduleController.FindTechnicians)
+	}
+
+	agenda := router.Group("agenda")
+	{
+		agenda.GET("/", scheduleController.FindAgenda)
+		agenda.GET("/sugestoes", scheduleController.SuggestSlots)
+	}
+}
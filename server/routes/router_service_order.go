@@ -0,0 +1,23 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// ServiceOrderRouterConfig define as configurações das rotas das ordens de serviço.
+func ServiceOrderRouterConfig(router *gin.RouterGroup, serviceOrderController controllers.ServiceOrderController) {
+	serviceOrders := router.Group("ordens")
+	{
+		serviceOrders.POST("/", serviceOrderController.CreateServiceOrder)
+		serviceOrders.GET("/", serviceOrderController.FindServiceOrders)
+	}
+
+	serviceOrder := router.Group("ordem")
+	{
+		serviceOrder.GET("/:id", serviceOrderController.FindServiceOrderByID)
+		serviceOrder.PUT("/:id/agendamento", serviceOrderController.ScheduleServiceOrder)
+		serviceOrder.PUT("/:id/estado", serviceOrderController.UpdateServiceOrderStatus)
+		serviceOrder.POST("/:id/conclusao", serviceOrderController.CompleteServiceOrder)
+	}
+}
@@ -0,0 +1,22 @@
+package routes
+
+import (
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/controllers"
+	"github.com/gin-gonic/gin"
+)
+
+// TicketRouterConfig define as configurações das rotas dos chamados.
+func TicketRouterConfig(router *gin.RouterGroup, ticketController controllers.TicketController) {
+	tickets := router.Group("chamados")
+	{
+		tickets.POST("/", ticketController.CreateTicket)
+		tickets.GET("/", ticketController.FindTickets)
+	}
+
+	ticket := router.Group("chamado")
+	{
+		ticket.GET("/:id", ticketController.FindTicketByID)
+		ticket.PUT("/:id/estado", ticketController.UpdateTicketStatus)
+		ticket.POST("/:id/comentarios", ticketController.AddTicketComment)
+	}
+}
@@ -1,9 +1,10 @@
 package server
 
 import (
-	"log"
+	"log/slog"
 	"os"
 
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/server/middlewares"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/server/routes"
 	"github.com/gin-gonic/gin"
 	"github.com/joho/godotenv"
@@ -17,23 +18,28 @@ type Server interface {
 type server struct {
 	port   string
 	server *gin.Engine
+	logger *slog.Logger
 }
 
 // Run inicia o servidor.
 func (server *server) Run() {
-	router := routes.ConfigRoutes(server.server)
+	router := routes.ConfigRoutes(server.server, server.logger)
 
-	log.Println("Server is running at port:", server.port)
+	server.logger.Info("server is running", slog.String("port", server.port))
 	router.Run(":" + server.port)
 }
 
 // NewServer cria um novo servidor.
-func NewServer() Server {
+func NewServer(logger *slog.Logger) Server {
 	godotenv.Load()
 	port := os.Getenv("SERVER_PORT")
 
+	router := gin.New()
+	router.Use(gin.Recovery(), middlewares.RequestID(), middlewares.AccessLog(logger))
+
 	return &server{
 		port:   port,
-		server: gin.Default(),
+		server: router,
+		logger: logger,
 	}
 }
@@ -1,8 +1,13 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math"
 	"net/http"
+	"strings"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
@@ -10,24 +15,37 @@ import (
 	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/mashingan/smapping"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service")
+
+// DuplicateSimilarity semelhança minima, de 0 a 1, para que dois endereços sejam considerados possiveis duplicados.
+const DuplicateSimilarity = 0.85
+
 // AddressService representa a interface de addressService.
 type AddressService interface {
-	CreateAddress(addressDTO dtos.AddressCreateDTO) (entities.Endereco, utils.ResponseError)
-	UpdateAddress(addressDTO dtos.AddressUpdateDTO) (entities.Endereco, utils.ResponseError)
-	FindAddressByID(addressID string) entities.Endereco
-	FindAddressByFields(street string, neighborhood string, number int) entities.Endereco
-	DeleteAddressByID(addressID string) utils.ResponseError
-	FindAddresses(street string, neighborhood string, number string) []entities.Endereco
+	CreateAddress(ctx context.Context, addressDTO dtos.AddressCreateDTO) (entities.Endereco, utils.ResponseError)
+	UpdateAddress(ctx context.Context, addressDTO dtos.AddressUpdateDTO) (entities.Endereco, utils.ResponseError)
+	FindAddressByID(ctx context.Context, addressID string) (entities.Endereco, utils.ResponseError)
+	FindAddressByFields(ctx context.Context, fields entities.Endereco) (entities.Endereco, utils.ResponseError)
+	DeleteAddressByID(ctx context.Context, addressID string) utils.ResponseError
+	FindAddresses(ctx context.Context, street string, neighborhood string, number string) ([]entities.Endereco, utils.ResponseError)
+	FindDuplicateAddresses(ctx context.Context, minSimilarity float64) ([]dtos.AddressDuplicateCluster, utils.ResponseError)
 }
 
 type addressService struct {
 	addressRepository repositories.AddressRepository
+	cepRepository     repositories.CEPRepository
 	pointService      services.PointService
+	logger            *slog.Logger
 }
 
-func (service *addressService) CreateAddress(addressDTO dtos.AddressCreateDTO) (entities.Endereco, utils.ResponseError) {
+func (service *addressService) CreateAddress(ctx context.Context, addressDTO dtos.AddressCreateDTO) (entities.Endereco, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "AddressService.CreateAddress")
+	defer span.End()
+
 	address := entities.Endereco{}
 
 	err := smapping.FillStruct(&address, smapping.MapFields(&addressDTO))
@@ -36,34 +54,93 @@ func (service *addressService) CreateAddress(addressDTO dtos.AddressCreateDTO) (
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	addressAlreadyExists := service.FindAddressByFields(
-		address.Logradouro, address.Bairro, address.Numero)
+	cep, ok := dtos.NormalizeCEP(address.Cep)
+	if !ok {
+		return entities.Endereco{}, utils.NewResponseError("cep: "+utils.InvalidCEP, http.StatusBadRequest)
+	}
+
+	address.Cep = cep
+	address.Uf = strings.ToUpper(strings.TrimSpace(address.Uf))
+	address.Cidade = strings.TrimSpace(address.Cidade)
+	address.Logradouro = strings.TrimSpace(address.Logradouro)
+	address.Bairro = strings.TrimSpace(address.Bairro)
+	address.Complemento = strings.TrimSpace(address.Complemento)
+
+	address, responseError := service.fillFromCEPDirectory(ctx, address)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Endereco{}, responseError
+	}
+
+	if _, ok := dtos.NormalizeUF(address.Uf); !ok {
+		return entities.Endereco{}, utils.NewResponseError("uf: "+utils.InvalidUF, http.StatusBadRequest)
+	}
+
+	if !dtos.IsValidTextLenght(address.Cidade) {
+		return entities.Endereco{}, utils.NewResponseError("cidade: "+utils.InvalidNumberOfCaracter, http.StatusBadRequest)
+	}
+
+	if !dtos.IsValidTextLenght(address.Logradouro) {
+		return entities.Endereco{}, utils.NewResponseError("logradouro: "+utils.InvalidNumberOfCaracter, http.StatusBadRequest)
+	}
+
+	if !dtos.IsValidTextLenght(address.Bairro) {
+		return entities.Endereco{}, utils.NewResponseError("bairro: "+utils.InvalidNumberOfCaracter, http.StatusBadRequest)
+	}
+
+	if !dtos.IsValidCoordinates(address.Latitude, address.Longitude) {
+		return entities.Endereco{}, utils.NewResponseError(utils.InvalidCoordinates, http.StatusBadRequest)
+	}
+
+	address.ChaveNormalizada = dtos.AddressKey(address)
+
+	addressAlreadyExists, err := service.addressRepository.FindAddressByFields(ctx, address)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Endereco{}, utils.NewInternalResponseError(err)
+	}
 
 	switch {
 	case addressAlreadyExists.DataRemocao.Valid:
 		address.ID = addressAlreadyExists.ID
 
-		address, err := service.addressRepository.UpdateAddress(address)
+		address, err := service.addressRepository.UpdateAddress(ctx, address)
 		if err != nil {
-			return entities.Endereco{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return entities.Endereco{}, utils.NewInternalResponseError(err)
 		}
 
+		service.logger.InfoContext(ctx, "address restored", slog.String("endereco_id", address.ID))
+
 		return address, utils.ResponseError{}
 
-	case (addressAlreadyExists != entities.Endereco{}):
+	case err == nil:
 		return entities.Endereco{}, utils.NewResponseError(utils.AddressAlreadyExists, http.StatusConflict)
 
 	default:
-		address, err := service.addressRepository.CreateAddress(address)
+		similarAddresses, responseError := service.findSimilarAddresses(ctx, address)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Endereco{}, responseError
+		}
+
+		address, err := service.addressRepository.CreateAddress(ctx, address)
 		if err != nil {
-			return entities.Endereco{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return entities.Endereco{}, utils.NewInternalResponseError(err)
 		}
 
+		address.Semelhantes = similarAddresses
+		if len(similarAddresses) != 0 {
+			service.logger.WarnContext(ctx, "address similar to existing addresses", slog.String("endereco_id", address.ID),
+				slog.Int("semelhantes", len(similarAddresses)))
+		}
+
+		service.logger.InfoContext(ctx, "address created", slog.String("endereco_id", address.ID))
+
 		return address, utils.ResponseError{}
 	}
 }
 
-func (service *addressService) UpdateAddress(addressDTO dtos.AddressUpdateDTO) (entities.Endereco, utils.ResponseError) {
+func (service *addressService) UpdateAddress(ctx context.Context, addressDTO dtos.AddressUpdateDTO) (entities.Endereco, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "AddressService.UpdateAddress")
+	defer span.End()
+
 	address := entities.Endereco{}
 
 	err := smapping.FillStruct(&address, smapping.MapFields(&addressDTO))
@@ -72,11 +149,9 @@ func (service *addressService) UpdateAddress(addressDTO dtos.AddressUpdateDTO) (
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	addressFound := service.addressRepository.FindAddressByID(address.ID)
-
-	if addressFound == (entities.Endereco{}) {
-		return entities.Endereco{}, utils.NewResponseError(utils.AddressNotFound, http.StatusNotFound)
-
+	addressFound, responseError := service.FindAddressByID(ctx, address.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Endereco{}, responseError
 	}
 
 	if address.Logradouro == "" {
@@ -99,59 +174,326 @@ func (service *addressService) UpdateAddress(addressDTO dtos.AddressUpdateDTO) (
 		address.Numero = addressFound.Numero
 	}
 
-	addressAlreadyExists := service.addressRepository.FindAddressByFields(
-		address.Logradouro, address.Bairro, address.Numero)
+	if address.Cep == "" {
+		address.Cep = addressFound.Cep
+	} else {
+		cep, ok := dtos.NormalizeCEP(address.Cep)
+		if !ok {
+			return entities.Endereco{}, utils.NewResponseError("cep: "+utils.InvalidCEP, http.StatusBadRequest)
+		}
 
-	if (addressAlreadyExists != entities.Endereco{}) && (addressFound.ID != addressAlreadyExists.ID) {
+		address.Cep = cep
+	}
+
+	if address.Cidade == "" {
+		address.Cidade = addressFound.Cidade
+	} else {
+		address.Cidade = strings.TrimSpace(address.Cidade)
+		if !dtos.IsValidTextLenght(address.Cidade) {
+			return entities.Endereco{}, utils.NewResponseError("cidade: "+utils.InvalidNumberOfCaracter, http.StatusBadRequest)
+		}
+	}
+
+	if address.Uf == "" {
+		address.Uf = addressFound.Uf
+	} else {
+		uf, ok := dtos.NormalizeUF(address.Uf)
+		if !ok {
+			return entities.Endereco{}, utils.NewResponseError("uf: "+utils.InvalidUF, http.StatusBadRequest)
+		}
+
+		address.Uf = uf
+	}
+
+	if addressDTO.Complemento == nil {
+		address.Complemento = addressFound.Complemento
+	} else {
+		address.Complemento = strings.TrimSpace(*addressDTO.Complemento)
+	}
+
+	if addressDTO.Latitude == nil && addressDTO.Longitude == nil {
+		address.Latitude = addressFound.Latitude
+		address.Longitude = addressFound.Longitude
+	} else if !dtos.IsValidCoordinates(addressDTO.Latitude, addressDTO.Longitude) {
+		return entities.Endereco{}, utils.NewResponseError(utils.InvalidCoordinates, http.StatusBadRequest)
+	}
+
+	address.ChaveNormalizada = dtos.AddressKey(address)
+
+	addressAlreadyExists, err := service.addressRepository.FindAddressByFields(ctx, address)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Endereco{}, utils.NewInternalResponseError(err)
+	}
+
+	if err == nil && addressFound.ID != addressAlreadyExists.ID {
 		return entities.Endereco{}, utils.NewResponseError(utils.AddressAlreadyExists, http.StatusConflict)
 	}
 
 	address.DataRemocao.Scan(nil)
-	address, err = service.addressRepository.UpdateAddress(address)
+	address, err = service.addressRepository.UpdateAddress(ctx, address)
 	if err != nil {
-		return entities.Endereco{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return entities.Endereco{}, utils.NewInternalResponseError(err)
 	}
 
+	service.logger.InfoContext(ctx, "address updated", slog.String("endereco_id", address.ID))
+
 	return address, utils.ResponseError{}
 }
 
-func (service *addressService) FindAddressByID(addressID string) entities.Endereco {
-	return service.addressRepository.FindAddressByID(addressID)
-}
+func (service *addressService) FindAddressByID(ctx context.Context, addressID string) (entities.Endereco, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "AddressService.FindAddressByID")
+	defer span.End()
+
+	address, err := service.addressRepository.FindAddressByID(ctx, addressID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Endereco{}, utils.NewResponseError(utils.AddressNotFound, http.StatusNotFound)
+	}
 
-func (service *addressService) FindAddressByFields(street string, neighborhood string, number int) entities.Endereco {
-	return service.addressRepository.FindAddressByFields(street, neighborhood, number)
+	if err != nil {
+		return entities.Endereco{}, utils.NewInternalResponseError(err)
+	}
+
+	return address, utils.ResponseError{}
 }
 
-func (service *addressService) DeleteAddressByID(addressID string) utils.ResponseError {
+func (service *addressService) FindAddressByFields(ctx context.Context, fields entities.Endereco) (entities.Endereco, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "AddressService.FindAddressByFields")
+	defer span.End()
+
+	fields.ChaveNormalizada = dtos.AddressKey(fields)
+
+	address, err := service.addressRepository.FindAddressByFields(ctx, fields)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Endereco{}, utils.NewResponseError(utils.AddressNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Endereco{}, utils.NewInternalResponseError(err)
+	}
 
-	addressFound := service.addressRepository.FindAddressByID(addressID)
+	return address, utils.ResponseError{}
+}
 
-	if addressFound == (entities.Endereco{}) {
-		return utils.NewResponseError(utils.AddressNotFound, http.StatusNotFound)
+func (service *addressService) DeleteAddressByID(ctx context.Context, addressID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "AddressService.DeleteAddressByID")
+	defer span.End()
+
+	addressFound, responseError := service.FindAddressByID(ctx, addressID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
 	}
 
-	err := service.addressRepository.DeleteAddress(addressFound)
+	err := service.addressRepository.DeleteAddress(ctx, addressFound)
 	if err != nil {
-		return utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return utils.NewInternalResponseError(err)
 	}
 
-	responseError := service.pointService.DeletePointsByAddressID(addressID)
+	responseError = service.pointService.DeletePointsByAddressID(ctx, addressID)
 	if len(responseError.Message) != 0 {
 		return utils.NewResponseError(responseError.Message, responseError.StatusCode)
 	}
 
+	service.logger.InfoContext(ctx, "address deleted", slog.String("endereco_id", addressID))
+
 	return utils.ResponseError{}
 }
 
-func (service *addressService) FindAddresses(street string, neighborhood string, number string) []entities.Endereco {
-	return service.addressRepository.FindAddresses(street, neighborhood, number)
+func (service *addressService) FindAddresses(ctx context.Context, street string, neighborhood string, number string) ([]entities.Endereco, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "AddressService.FindAddresses")
+	defer span.End()
+
+	addresses, err := service.addressRepository.FindAddresses(ctx, street, neighborhood, number)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return addresses, utils.ResponseError{}
+}
+
+func (service *addressService) FindDuplicateAddresses(ctx context.Context, minSimilarity float64) ([]dtos.AddressDuplicateCluster, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "AddressService.FindDuplicateAddresses")
+	defer span.End()
+
+	addresses, err := service.addressRepository.FindAddresses(ctx, "", "", "")
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	// Somente endereços com o mesmo CEP, numero e complemento são comparados entre si.
+	groups := map[string][]entities.Endereco{}
+	groupKeys := []string{}
+
+	for _, address := range addresses {
+		key := fmt.Sprintf("%v|%v|%v", address.Cep, address.Numero, dtos.NormalizeAddressText(address.Complemento))
+		if _, ok := groups[key]; !ok {
+			groupKeys = append(groupKeys, key)
+		}
+
+		groups[key] = append(groups[key], address)
+	}
+
+	clusters := []dtos.AddressDuplicateCluster{}
+
+	for _, key := range groupKeys {
+		clusters = append(clusters, duplicateClusters(groups[key], minSimilarity)...)
+	}
+
+	return clusters, utils.ResponseError{}
+}
+
+// findSimilarAddresses busca os endereços já cadastrados no mesmo CEP, numero e complemento
+// com o logradouro e o bairro parecidos com os do endereço informado.
+func (service *addressService) findSimilarAddresses(ctx context.Context, address entities.Endereco) ([]entities.EnderecoSemelhante, utils.ResponseError) {
+	candidates, err := service.addressRepository.FindAddressesByCEPAndNumber(ctx, address.Cep, address.Numero)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	complement := dtos.NormalizeAddressText(address.Complemento)
+	var similarAddresses []entities.EnderecoSemelhante
+
+	for _, candidate := range candidates {
+		if dtos.NormalizeAddressText(candidate.Complemento) != complement {
+			continue
+		}
+
+		similarity := dtos.AddressSimilarity(address, candidate)
+		if similarity >= DuplicateSimilarity {
+			similarAddresses = append(similarAddresses, entities.EnderecoSemelhante{
+				EnderecoID:   candidate.ID,
+				Similaridade: math.Round(similarity*100) / 100,
+			})
+		}
+	}
+
+	return similarAddresses, utils.ResponseError{}
+}
+
+// duplicateClusters agrupa os endereços ligados por uma semelhança maior ou igual a minima,
+// retornando somente os grupos com mais de um endereço.
+func duplicateClusters(addresses []entities.Endereco, minSimilarity float64) []dtos.AddressDuplicateCluster {
+	parents := make([]int, len(addresses))
+	for i := range parents {
+		parents[i] = i
+	}
+
+	var find func(i int) int
+	find = func(i int) int {
+		if parents[i] != i {
+			parents[i] = find(parents[i])
+		}
+
+		return parents[i]
+	}
+
+	type link struct {
+		address    int
+		similarity float64
+	}
+
+	links := []link{}
+
+	for i := range addresses {
+		for j := i + 1; j < len(addresses); j++ {
+			similarity := dtos.AddressSimilarity(addresses[i], addresses[j])
+			if similarity < minSimilarity {
+				continue
+			}
+
+			parents[find(i)] = find(j)
+			links = append(links, link{address: i, similarity: similarity})
+		}
+	}
+
+	// A semelhança do grupo é a menor semelhança entre os endereços ligados.
+	lowestSimilarity := map[int]float64{}
+
+	for _, link := range links {
+		root := find(link.address)
+		if value, ok := lowestSimilarity[root]; !ok || link.similarity < value {
+			lowestSimilarity[root] = link.similarity
+		}
+	}
+
+	clusters := map[int]*dtos.AddressDuplicateCluster{}
+	roots := []int{}
+
+	for i, address := range addresses {
+		root := find(i)
+		if _, ok := clusters[root]; !ok {
+			clusters[root] = &dtos.AddressDuplicateCluster{}
+			roots = append(roots, root)
+		}
+
+		clusters[root].Enderecos = append(clusters[root].Enderecos, dtos.CreateAddressDuplicateResponse(address))
+	}
+
+	result := []dtos.AddressDuplicateCluster{}
+
+	for _, root := range roots {
+		cluster := clusters[root]
+		if len(cluster.Enderecos) < 2 {
+			continue
+		}
+
+		cluster.Similaridade = math.Round(lowestSimilarity[root]*100) / 100
+		result = append(result, *cluster)
+	}
+
+	return result
+}
+
+// fillFromCEPDirectory preenche os campos não informados com os dados do diretorio de CEPs
+// e marca como divergentes os campos informados com valores diferentes do diretorio.
+func (service *addressService) fillFromCEPDirectory(ctx context.Context, address entities.Endereco) (entities.Endereco, utils.ResponseError) {
+	cep, err := service.cepRepository.FindCEPByCode(ctx, address.Cep)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return address, utils.ResponseError{}
+	}
+
+	if err != nil {
+		return entities.Endereco{}, utils.NewInternalResponseError(err)
+	}
+
+	fields := []struct {
+		name  string
+		value *string
+		known string
+	}{
+		{"logradouro", &address.Logradouro, cep.Logradouro},
+		{"bairro", &address.Bairro, cep.Bairro},
+		{"cidade", &address.Cidade, cep.Cidade},
+		{"uf", &address.Uf, cep.Uf},
+	}
+
+	for _, field := range fields {
+		switch {
+		case field.known == "":
+		case *field.value == "":
+			*field.value = field.known
+		case !strings.EqualFold(*field.value, field.known):
+			address.Divergencias = append(address.Divergencias, entities.DivergenciaCep{
+				Campo:     field.name,
+				Informado: *field.value,
+				Diretorio: field.known,
+			})
+		}
+	}
+
+	if len(address.Divergencias) != 0 {
+		service.logger.WarnContext(ctx, "address differs from cep directory", slog.String("cep", address.Cep),
+			slog.Int("divergencias", len(address.Divergencias)))
+	}
+
+	return address, utils.ResponseError{}
 }
 
 // NewAddressService cria uma nova instancia de AddressService.
-func NewAddressService(addressRepository repositories.AddressRepository, pointService services.PointService) AddressService {
+func NewAddressService(addressRepository repositories.AddressRepository, cepRepository repositories.CEPRepository, pointService services.PointService, logger *slog.Logger) AddressService {
 	return &addressService{
 		addressRepository: addressRepository,
+		cepRepository:     cepRepository,
 		pointService:      pointService,
+		logger:            logger,
 	}
 }
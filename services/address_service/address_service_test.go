@@ -1,52 +1,83 @@
 package services_test
 
 import (
+	"context"
 	"net/http"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
 	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
 	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
 	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
 	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/stretchr/testify/require"
 )
 
 var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
 	// Fake Databases
-	dbClient        = repositoriesFake.DBClient
-	dbAddress       = repositoriesFake.DBAddress
-	dbPoint         = repositoriesFake.DBPoint
-	dbContract      = repositoriesFake.DBContract
-	dbContractEvent = repositoriesFake.DBContractEvent
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
 
 	// Fake Repositories
-	clientRepositoryFake        = repositoriesFake.NewClientRepositoryFake(dbClient)
-	addressRepositoryFake       = repositoriesFake.NewAddressRepositoryFake(dbAddress)
-	pointRepositoryFake         = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress)
-	contractRepositoryFake      = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint)
-	contractEventRepositoryFake = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+
+	// Policies
+	clientPolicies = policies.Default()
 
 	// Services Tests
-	contractEventServiceTest = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake)
-	contractServiceTest      = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, contractEventServiceTest)
-	pointServiceTest         = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest)
-	addressServiceTest       = addressService.NewAddressService(addressRepositoryFake, pointServiceTest)
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
 )
 
 // TestCreateAddress testa se é possivel criar um novo endereço.
 func TestCreateAddress(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 1.0",
 		Bairro:     "BairroTest 1.0",
 		Numero:     1,
 	}
 
-	address, responseError := addressServiceTest.CreateAddress(addressDTO)
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	require.Empty(t, responseError)
 
@@ -58,13 +89,16 @@ func TestCreateAddress(t *testing.T) {
 // TestCreateAddressWithAddressExistent testa se não é possivel criar um novo endrereço com os dados de um endereço ja existente.
 func TestCreateAddressWithAddressExistent(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 2.0",
 		Bairro:     "BairroTest 2.0",
 		Numero:     2,
 	}
 
-	addressServiceTest.CreateAddress(addressDTO)
-	address, responseError := addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.AddressAlreadyExists, responseError.Message)
@@ -76,14 +110,17 @@ func TestCreateAddressWithAddressExistent(t *testing.T) {
 // TestCreateAddressWithDeletedAtValid testa se é possivel atualizar um endereço de removido para ativo.
 func TestCreateAddressWithDeletedAtValid(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 3.0",
 		Bairro:     "BairroTest 3.0",
 		Numero:     3,
 	}
 
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
-	addressServiceTest.DeleteAddressByID(address.ID)
-	address, responseError := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+	addressServiceTest.DeleteAddressByID(ctx, address.ID)
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	require.Empty(t, responseError)
 
@@ -95,11 +132,14 @@ func TestCreateAddressWithDeletedAtValid(t *testing.T) {
 // TestUpdateAddress testa se é possivel atualizar um endereço existente.
 func TestUpdateAddress(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 4.0",
 		Bairro:     "BairroTest 4.0",
 		Numero:     4,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	newStreet := "LogradouroTest 4.1"
 	newNeightbohood := "BairroTest 4.1"
@@ -112,7 +152,7 @@ func TestUpdateAddress(t *testing.T) {
 		Bairro:     newNeightbohood,
 		Numero:     newNumber,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -127,18 +167,24 @@ func TestUpdateAddress(t *testing.T) {
 // TestUpdateAddressWithAddressExistent testa se não é possivel atualizar um endereço com dados ja existentes.
 func TestUpdateAddressWithAddressExistent(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 5.0",
 		Bairro:     "BairroTest 5.0",
 		Numero:     5,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	addressDTO2 := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 6.0",
 		Bairro:     "BairroTest 6.0",
 		Numero:     6,
 	}
-	address2, _ := addressServiceTest.CreateAddress(addressDTO2)
+	address2, _ := addressServiceTest.CreateAddress(ctx, addressDTO2)
 
 	addressUpdateDTO := dtos.AddressUpdateDTO{
 		Base: dtos.Base{
@@ -148,7 +194,7 @@ func TestUpdateAddressWithAddressExistent(t *testing.T) {
 		Bairro:     address2.Bairro,
 		Numero:     address2.Numero,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.AddressAlreadyExists, responseError.Message)
@@ -160,11 +206,14 @@ func TestUpdateAddressWithAddressExistent(t *testing.T) {
 // TestUpdateAddressWithoutStreet testa se é possivel atualizar um endereço sem passar o logradouro.
 func TestUpdateAddressWithoutStreet(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 7.0",
 		Bairro:     "BairroTest 7.0",
 		Numero:     7,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	newNeightbohood := "BairroTest 7.1"
 	newNumber := 7
@@ -175,7 +224,7 @@ func TestUpdateAddressWithoutStreet(t *testing.T) {
 		Bairro: newNeightbohood,
 		Numero: newNumber,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -190,11 +239,14 @@ func TestUpdateAddressWithoutStreet(t *testing.T) {
 // TestUpdateAddressWithoutNeighborhood testa se é possivel atualizar um endereço sem passar o bairro.
 func TestUpdateAddressWithoutNeighborhood(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 8.0",
 		Bairro:     "BairroTest 8.0",
 		Numero:     8,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	newStreet := "LogradouroTest 8.1"
 	newNumber := 8
@@ -205,7 +257,7 @@ func TestUpdateAddressWithoutNeighborhood(t *testing.T) {
 		Logradouro: newStreet,
 		Numero:     newNumber,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -220,11 +272,14 @@ func TestUpdateAddressWithoutNeighborhood(t *testing.T) {
 // TestUpdateAddressWithoutNumber testa se é possivel atualizar um endereço sem passar o numero.
 func TestUpdateAddressWithoutNumber(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 9.0",
 		Bairro:     "BairroTest 9.0",
 		Numero:     9,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	newStreet := "LogradouroTest 9.1"
 	newNeightbohood := "BairroTest 9.1"
@@ -235,7 +290,7 @@ func TestUpdateAddressWithoutNumber(t *testing.T) {
 		Logradouro: newStreet,
 		Bairro:     newNeightbohood,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -250,11 +305,14 @@ func TestUpdateAddressWithoutNumber(t *testing.T) {
 // TestUpdateAddressWithInvalidStreet testa se não é possivel atualizar um endereço passando um logradouro invalido.
 func TestUpdateAddressWithInvalidStreet(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 10.0",
 		Bairro:     "BairroTest 10.0",
 		Numero:     10,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	addressUpdateDTO := dtos.AddressUpdateDTO{
 		Base: dtos.Base{
@@ -264,7 +322,7 @@ func TestUpdateAddressWithInvalidStreet(t *testing.T) {
 		Bairro:     address.Bairro,
 		Numero:     address.Numero,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, "logradouro: "+utils.InvalidNumberOfCaracter, responseError.Message)
@@ -276,11 +334,14 @@ func TestUpdateAddressWithInvalidStreet(t *testing.T) {
 // TestUpdateAddressWithInvalidNeighborhood testa se não é possivel atualizar um endereço passando um bairro invalido.
 func TestUpdateAddressWithInvalidNeighborhood(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 11.0",
 		Bairro:     "BairroTest 11.0",
 		Numero:     11,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	addressUpdateDTO := dtos.AddressUpdateDTO{
 		Base: dtos.Base{
@@ -290,7 +351,7 @@ func TestUpdateAddressWithInvalidNeighborhood(t *testing.T) {
 		Bairro:     "Ba",
 		Numero:     address.Numero,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, "bairro: "+utils.InvalidNumberOfCaracter, responseError.Message)
@@ -302,11 +363,14 @@ func TestUpdateAddressWithInvalidNeighborhood(t *testing.T) {
 // TestUpdateAddressWithInvalidID testa se não é possivel atualizar os dadods do endereço a partir de um ID invalido.
 func TestUpdateAddressWithInvalidID(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 12.0",
 		Bairro:     "BairroTest 12.0",
 		Numero:     12,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	addressUpdateDTO := dtos.AddressUpdateDTO{
 		Base: dtos.Base{
@@ -316,7 +380,7 @@ func TestUpdateAddressWithInvalidID(t *testing.T) {
 		Bairro:     address.Bairro,
 		Numero:     address.Numero,
 	}
-	addressUpdated, responseError := addressServiceTest.UpdateAddress(addressUpdateDTO)
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.AddressNotFound, responseError.Message)
@@ -328,45 +392,57 @@ func TestUpdateAddressWithInvalidID(t *testing.T) {
 // TestFindAddressByID testa se é possivel buscar um endereço a partir do ID.
 func TestFindAddressByID(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 13.0",
 		Bairro:     "BairroTest 13.0",
 		Numero:     13,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addressFound := addressServiceTest.FindAddressByID(address.ID)
+	addressFound, responseError := addressServiceTest.FindAddressByID(ctx, address.ID)
 
 	require.NotEmpty(t, addressFound)
+	require.Empty(t, responseError)
 	require.Equal(t, address, addressFound)
 }
 
 // TestFindAddressByIDWithoutInvalidID testa se não é possivel buscar um endereço a partir de um ID invalido.
 func TestFindAddressByIDWithInvalidID(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 14.0",
 		Bairro:     "BairroTest 14.0",
 		Numero:     14,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addressFound := addressServiceTest.FindAddressByID("")
+	addressFound, responseError := addressServiceTest.FindAddressByID(ctx, "")
 
 	require.Empty(t, addressFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindAddressByIDWithDeletedAtValid testa se não é possivel buscar um endereço removido a partir do ID.
 func TestFindAddressByIDWithDeletedAtValid(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 15.0",
 		Bairro:     "BairroTest 15.0",
 		Numero:     15,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
-	addressServiceTest.DeleteAddressByID(address.ID)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+	addressServiceTest.DeleteAddressByID(ctx, address.ID)
 
-	addressFound := addressServiceTest.FindAddressByID(address.ID)
+	addressFound, responseError := addressServiceTest.FindAddressByID(ctx, address.ID)
 
 	require.Empty(t, addressFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindAddressByFields testa se é possivel buscar um endereço a partir do logradouro, bairro e numero.
@@ -376,15 +452,19 @@ func TestFindAddressByFields(t *testing.T) {
 	number := 16
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addressFound := addressServiceTest.FindAddressByFields(street, neighborhood, number)
+	addressFound, responseError := addressServiceTest.FindAddressByFields(ctx, address)
 
 	require.NotEmpty(t, addressFound)
+	require.Empty(t, responseError)
 	require.Equal(t, street, addressFound.Logradouro)
 	require.NotEmpty(t, neighborhood, addressFound.Bairro)
 	require.NotEmpty(t, number, addressFound.Numero)
@@ -393,15 +473,19 @@ func TestFindAddressByFields(t *testing.T) {
 // TestFindAddressByFieldsWithInvalidFields testa se não é possivel buscar um endereço a partir de um logradouro, bairro e numero invalidos.
 func TestFindAddressByFieldsWithInvalidFields(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 17.0",
 		Bairro:     "BairroTest 17.0",
 		Numero:     17,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addressFound := addressServiceTest.FindAddressByFields("", "", 0)
+	addressFound, responseError := addressServiceTest.FindAddressByFields(ctx, entities.Endereco{})
 
 	require.Empty(t, addressFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindAddressByFieldsWithInvalidFields testa se é possivel buscar um endereço removido a partir do logradouro, bairro e numero.
@@ -411,16 +495,20 @@ func TestFindAddressByFieldsWithDeletedAtValid(t *testing.T) {
 	number := 18
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
-	addressServiceTest.DeleteAddressByID(address.ID)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+	addressServiceTest.DeleteAddressByID(ctx, address.ID)
 
-	addressFound := addressServiceTest.FindAddressByFields(street, neighborhood, number)
+	addressFound, responseError := addressServiceTest.FindAddressByFields(ctx, address)
 
 	require.NotEmpty(t, addressFound)
+	require.Empty(t, responseError)
 	require.Equal(t, street, addressFound.Logradouro)
 	require.NotEmpty(t, neighborhood, addressFound.Bairro)
 	require.NotEmpty(t, number, addressFound.Numero)
@@ -429,15 +517,18 @@ func TestFindAddressByFieldsWithDeletedAtValid(t *testing.T) {
 // TestDeleteAddressByID testa se é possivel "excluir"(solfdelete) um endereço a partir do ID.
 func TestDeleteAddressByID(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 19.0",
 		Bairro:     "BairroTest 19.0",
 		Numero:     19,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	responseError := addressServiceTest.DeleteAddressByID(address.ID)
+	responseError := addressServiceTest.DeleteAddressByID(ctx, address.ID)
 
-	addressFound := addressServiceTest.FindAddressByID(address.ID)
+	addressFound, _ := addressServiceTest.FindAddressByID(ctx, address.ID)
 
 	require.Empty(t, responseError)
 	require.Empty(t, addressFound)
@@ -446,13 +537,16 @@ func TestDeleteAddressByID(t *testing.T) {
 // TestDeleteAddressByIDWithInvalidID testa se não é possivel "excluir"(solfdelete) um endereço a partir de um ID invalido.
 func TestDeleteAddressByIDWithInvalidID(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 20.0",
 		Bairro:     "BairroTest 20.0",
 		Numero:     20,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	responseError := addressServiceTest.DeleteAddressByID("")
+	responseError := addressServiceTest.DeleteAddressByID(ctx, "")
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.AddressNotFound, responseError.Message)
@@ -462,14 +556,17 @@ func TestDeleteAddressByIDWithInvalidID(t *testing.T) {
 // TestDeleteAddressByIDWithDeletedAtValid testa se não é possivel "excluir"(solfdelete) um endereço removido a partir do ID.
 func TestDeleteAddressByIDWithDeletedAtValid(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 21.0",
 		Bairro:     "BairroTest 21.0",
 		Numero:     21,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addressServiceTest.DeleteAddressByID(address.ID)
-	responseError := addressServiceTest.DeleteAddressByID(address.ID)
+	addressServiceTest.DeleteAddressByID(ctx, address.ID)
+	responseError := addressServiceTest.DeleteAddressByID(ctx, address.ID)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.AddressNotFound, responseError.Message)
@@ -479,34 +576,42 @@ func TestDeleteAddressByIDWithDeletedAtValid(t *testing.T) {
 // TestFindAddresses testa se é possivel listar todos os endereços não removidos.
 func TestFindAddresses(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 22.0",
 		Bairro:     "BairroTest 22.0",
 		Numero:     22,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses("", "", "")
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, "", "", "")
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
 
 // TestFindAddressesWithDeteletAtValid testa se não é possivel listar endereços removidos.
 func TestFindAddressesWithDeteletAtValid(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 23.0",
 		Bairro:     "BairroTest 23.0",
 		Numero:     23,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	for i := range *dbAddress {
 		(*dbAddress)[i].DataRemocao.Scan(time.Now())
 	}
 
-	addresses := addressServiceTest.FindAddresses("", "", "")
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, "", "", "")
 
 	require.Empty(t, addresses)
+	require.Empty(t, responseError)
 	require.Equal(t, len(addresses), 0)
 }
 
@@ -517,15 +622,19 @@ func TestFindAddressesByFields(t *testing.T) {
 	number := 23
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses(street, neighborhood, strconv.Itoa(number))
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, street, neighborhood, strconv.Itoa(number))
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
 
@@ -536,15 +645,19 @@ func TestFindAddressesByStreetAndNeighborhood(t *testing.T) {
 	number := 24
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses(street, neighborhood, "")
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, street, neighborhood, "")
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
 
@@ -555,15 +668,19 @@ func TestFindAddressesByStreetAndNumber(t *testing.T) {
 	number := 25
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses(street, "", strconv.Itoa(number))
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, street, "", strconv.Itoa(number))
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
 
@@ -574,15 +691,19 @@ func TestFindAddressesByNeighborhoodAndNumber(t *testing.T) {
 	number := 26
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses("", neighborhood, strconv.Itoa(number))
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, "", neighborhood, strconv.Itoa(number))
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
 
@@ -593,15 +714,19 @@ func TestFindAddressesByStreet(t *testing.T) {
 	number := 27
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses(street, "", "")
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, street, "", "")
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
 
@@ -612,15 +737,19 @@ func TestFindAddressesByNeighborhood(t *testing.T) {
 	number := 28
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses("", neighborhood, "")
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, "", neighborhood, "")
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
 
@@ -631,14 +760,305 @@ func TestFindAddressesByNumber(t *testing.T) {
 	number := 29
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: street,
 		Bairro:     neighborhood,
 		Numero:     number,
 	}
-	addressServiceTest.CreateAddress(addressDTO)
+	addressServiceTest.CreateAddress(ctx, addressDTO)
 
-	addresses := addressServiceTest.FindAddresses("", "", strconv.Itoa(number))
+	addresses, responseError := addressServiceTest.FindAddresses(ctx, "", "", strconv.Itoa(number))
 
 	require.NotEmpty(t, addresses)
+	require.Empty(t, responseError)
 	require.Greater(t, len(addresses), 0)
 }
+
+// TestCreateAddressWithComplement testa se é possivel criar dois endereços que diferem somente pelo complemento.
+func TestCreateAddressWithComplement(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:         "01001-000",
+		Cidade:      "São Paulo",
+		Uf:          "sp",
+		Logradouro:  "LogradouroTest 79.0",
+		Bairro:      "BairroTest 79.0",
+		Numero:      79,
+		Complemento: "Apto 101",
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, "01001000", address.Cep)
+	require.Equal(t, "SP", address.Uf)
+
+	addressDTO.Complemento = "Apto 102"
+	address2, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+	require.NotEqual(t, address.ID, address2.ID)
+}
+
+// TestCreateAddressInOtherCity testa se é possivel criar o mesmo logradouro, bairro e numero em cidades diferentes.
+func TestCreateAddressInOtherCity(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 80.0",
+		Bairro:     "BairroTest 80.0",
+		Numero:     80,
+	}
+	addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	addressDTO.Cep = "20010000"
+	addressDTO.Cidade = "Rio de Janeiro"
+	addressDTO.Uf = "RJ"
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+	require.NotEmpty(t, address)
+}
+
+// TestCreateAddressWithInvalidCEP testa se não é possivel criar um endereço com o CEP invalido.
+func TestCreateAddressWithInvalidCEP(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "0100-1000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 81.0",
+		Bairro:     "BairroTest 81.0",
+		Numero:     81,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Equal(t, "cep: "+utils.InvalidCEP, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, address)
+}
+
+// TestCreateAddressWithInvalidUF testa se não é possivel criar um endereço com a UF invalida.
+func TestCreateAddressWithInvalidUF(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "XX",
+		Logradouro: "LogradouroTest 82.0",
+		Bairro:     "BairroTest 82.0",
+		Numero:     82,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Equal(t, "uf: "+utils.InvalidUF, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, address)
+}
+
+// TestCreateAddressWithInvalidCoordinates testa se não é possivel criar um endereço somente com a latitude.
+func TestCreateAddressWithInvalidCoordinates(t *testing.T) {
+	latitude := -23.55
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 83.0",
+		Bairro:     "BairroTest 83.0",
+		Numero:     83,
+		Latitude:   &latitude,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Equal(t, utils.InvalidCoordinates, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, address)
+}
+
+// TestUpdateAddressComplementAndCoordinates testa se é possivel atualizar o complemento e as coordenadas do endereço.
+func TestUpdateAddressComplementAndCoordinates(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 84.0",
+		Bairro:     "BairroTest 84.0",
+		Numero:     84,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	complement := "Casa 2"
+	latitude := -23.5505
+	longitude := -46.6333
+	addressUpdateDTO := dtos.AddressUpdateDTO{
+		Base: dtos.Base{
+			ID: address.ID,
+		},
+		Complemento: &complement,
+		Latitude:    &latitude,
+		Longitude:   &longitude,
+	}
+	addressUpdated, responseError := addressServiceTest.UpdateAddress(ctx, addressUpdateDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, complement, addressUpdated.Complemento)
+	require.Equal(t, latitude, *addressUpdated.Latitude)
+	require.Equal(t, longitude, *addressUpdated.Longitude)
+	require.Equal(t, address.Cep, addressUpdated.Cep)
+	require.Equal(t, address.Cidade, addressUpdated.Cidade)
+	require.Equal(t, address.Uf, addressUpdated.Uf)
+}
+
+// TestCreateAddressFromCEP testa se é possivel criar um endereço informando somente o CEP e o numero.
+func TestCreateAddressFromCEP(t *testing.T) {
+	*dbCep = append(*dbCep, entities.Cep{
+		Codigo:     "69900001",
+		Logradouro: "LogradouroTest 85.0",
+		Bairro:     "BairroTest 85.0",
+		Cidade:     "Rio Branco",
+		Uf:         "AC",
+	})
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:    "69900-001",
+		Numero: 85,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, "69900001", address.Cep)
+	require.Equal(t, "LogradouroTest 85.0", address.Logradouro)
+	require.Equal(t, "BairroTest 85.0", address.Bairro)
+	require.Equal(t, "Rio Branco", address.Cidade)
+	require.Equal(t, "AC", address.Uf)
+	require.Empty(t, address.Divergencias)
+}
+
+// TestCreateAddressWithCEPDivergence testa se os campos diferentes do diretorio de CEPs são marcados como divergentes.
+func TestCreateAddressWithCEPDivergence(t *testing.T) {
+	*dbCep = append(*dbCep, entities.Cep{
+		Codigo:     "69900002",
+		Logradouro: "LogradouroTest 86.0",
+		Bairro:     "BairroTest 86.0",
+		Cidade:     "Rio Branco",
+		Uf:         "AC",
+	})
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "69900002",
+		Cidade:     "rio branco",
+		Logradouro: "LogradouroTest 86.1",
+		Numero:     86,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, "LogradouroTest 86.1", address.Logradouro)
+	require.Equal(t, "BairroTest 86.0", address.Bairro)
+	require.Equal(t, "AC", address.Uf)
+	require.Equal(t, []entities.DivergenciaCep{
+		{Campo: "logradouro", Informado: "LogradouroTest 86.1", Diretorio: "LogradouroTest 86.0"},
+	}, address.Divergencias)
+}
+
+// TestCreateAddressWithUnknownCEPAndMissingFields testa se não é possivel criar um endereço incompleto com um CEP fora do diretorio.
+func TestCreateAddressWithUnknownCEPAndMissingFields(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:    "69900999",
+		Numero: 87,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Equal(t, "uf: "+utils.InvalidUF, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, address)
+}
+
+// TestCreateAddressWithAbbreviatedDuplicate testa se não é possivel criar um endereço igual a outro escrito de forma diferente.
+func TestCreateAddressWithAbbreviatedDuplicate(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "69900010",
+		Cidade:     "Rio Branco",
+		Uf:         "AC",
+		Logradouro: "Avenida LogradouroTest 88.0",
+		Bairro:     "Jardim Conceição",
+		Numero:     88,
+	}
+	_, _ = addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	addressDTO = dtos.AddressCreateDTO{
+		Cep:        "69900-010",
+		Cidade:     "RIO  BRANCO",
+		Uf:         "ac",
+		Logradouro: "Av. LogradouroTest 88.0",
+		Bairro:     "JD CONCEICAO",
+		Numero:     88,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Equal(t, utils.AddressAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, address)
+}
+
+// TestCreateAddressWithSimilarAddress testa se o cadastro de um endereço parecido com outro retorna os endereços semelhantes.
+func TestCreateAddressWithSimilarAddress(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "69900011",
+		Cidade:     "Rio Branco",
+		Uf:         "AC",
+		Logradouro: "Rua LogradouroTest Oitenta e Nove",
+		Bairro:     "BairroTest 89.0",
+		Numero:     89,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	addressDTO.Logradouro = "Rua LogradouroTest Oitenta Nove"
+	addressSimilar, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+	require.NotEmpty(t, addressSimilar.ID)
+	require.Len(t, addressSimilar.Semelhantes, 1)
+	require.Equal(t, address.ID, addressSimilar.Semelhantes[0].EnderecoID)
+	require.GreaterOrEqual(t, addressSimilar.Semelhantes[0].Similaridade, addressService.DuplicateSimilarity)
+
+	addressDTO.Complemento = "Apto 2"
+	addressWithComplement, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+	require.Empty(t, addressWithComplement.Semelhantes)
+}
+
+// TestFindDuplicateAddresses testa se o relatorio agrupa os endereços possivelmente duplicados.
+func TestFindDuplicateAddresses(t *testing.T) {
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "69900012",
+		Cidade:     "Rio Branco",
+		Uf:         "AC",
+		Logradouro: "Rua LogradouroTest Noventa",
+		Bairro:     "BairroTest 90.0",
+		Numero:     90,
+	}
+	first, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	addressDTO.Logradouro = "Rua LogradouroTest Noventta"
+	second, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	addressDTO.Logradouro = "Travessa Completamente Diferente"
+	_, _ = addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	clusters, responseError := addressServiceTest.FindDuplicateAddresses(ctx, addressService.DuplicateSimilarity)
+
+	require.Empty(t, responseError)
+
+	var cluster dtos.AddressDuplicateCluster
+	for _, clusterValue := range clusters {
+		if clusterValue.Enderecos[0].Cep == "69900012" {
+			cluster = clusterValue
+		}
+	}
+
+	require.Len(t, cluster.Enderecos, 2)
+	require.Equal(t, first.ID, cluster.Enderecos[0].ID)
+	require.Equal(t, second.ID, cluster.Enderecos[1].ID)
+	require.GreaterOrEqual(t, cluster.Similaridade, addressService.DuplicateSimilarity)
+}
@@ -0,0 +1,356 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"math"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/billing_service")
+
+// BillingService representa a interface de billingService.
+type BillingService interface {
+	RunBilling(ctx context.Context, competencia string) (dtos.BillingRunResponse, utils.ResponseError)
+	FindInvoiceByID(ctx context.Context, invoiceID string) (entities.Fatura, utils.ResponseError)
+	FindInvoices(ctx context.Context, clientID string, competencia string) ([]entities.Fatura, utils.ResponseError)
+}
+
+type billingService struct {
+	invoiceRepository       repositories.InvoiceRepository
+	contractRepository      repositories.ContractRepository
+	contractEventRepository repositories.ContractEventRepository
+	planRepository          repositories.PlanRepository
+	billingPolicy           policies.BillingPolicy
+	logger                  *slog.Logger
+}
+
+// billingDay representa o cliente, o estado e o plano de um contrato ao final de um dia da competencia.
+type billingDay struct {
+	clienteID string
+	estado    entities.ContractState
+	planoID   string
+}
+
+// RunBilling gera uma fatura por cliente na competencia informada, no formato 2026-10. Os clientes que
+// já possuem a fatura da competencia a mantêm, o que permite executar o faturamento mais de uma vez.
+func (service *billingService) RunBilling(ctx context.Context, competencia string) (dtos.BillingRunResponse, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "BillingService.RunBilling")
+	defer span.End()
+
+	// Apenas as competencias encerradas são faturadas, já que a fatura gerada não é mais recalculada.
+	month, err := time.ParseInLocation(dtos.CompetenciaLayout, competencia, time.Local)
+	if err != nil || month.AddDate(0, 1, 0).After(time.Now()) {
+		return dtos.BillingRunResponse{}, utils.NewResponseError(utils.InvalidCompetencia, http.StatusBadRequest)
+	}
+
+	contracts, err := service.contractRepository.FindContractsForBilling(ctx, month)
+	if err != nil {
+		return dtos.BillingRunResponse{}, utils.NewInternalResponseError(err)
+	}
+
+	linesByClient := map[string][]dtos.InvoiceLine{}
+	contractEvents := map[string][]entities.ContratoEvento{}
+	contractIDs := []string{}
+	planIDs := []string{}
+
+	for _, contract := range contracts {
+		contractIDs = append(contractIDs, contract.ID)
+		planIDs = append(planIDs, contract.PlanoID)
+	}
+
+	events, err := service.contractEventRepository.FindContractEventsByContractIDs(ctx, contractIDs)
+	if err != nil {
+		return dtos.BillingRunResponse{}, utils.NewInternalResponseError(err)
+	}
+
+	for _, event := range events {
+		contractEvents[event.ContratoID] = append(contractEvents[event.ContratoID], event)
+		planIDs = append(planIDs, event.PlanoAnteriorID, event.PlanoPosteriorID)
+	}
+
+	plans, err := service.planRepository.FindPlansByIDs(ctx, planIDs)
+	if err != nil {
+		return dtos.BillingRunResponse{}, utils.NewInternalResponseError(err)
+	}
+
+	plansByID := map[string]entities.Plano{}
+	for _, plan := range plans {
+		plansByID[plan.ID] = plan
+	}
+
+	for _, contract := range contracts {
+		for clientID, lines := range service.billContract(contract, contractEvents[contract.ID], plansByID, month) {
+			linesByClient[clientID] = append(linesByClient[clientID], lines...)
+		}
+	}
+
+	clientIDs := []string{}
+	for clientID := range linesByClient {
+		clientIDs = append(clientIDs, clientID)
+	}
+
+	sort.Strings(clientIDs)
+
+	billingRun := dtos.BillingRunResponse{Competencia: competencia, Faturas: []dtos.InvoiceResponse{}}
+
+	for _, clientID := range clientIDs {
+		invoice, err := service.invoiceRepository.FindInvoiceByClientIDAndCompetencia(ctx, clientID, competencia)
+		if err == nil {
+			billingRun.Existentes++
+			billingRun.Faturas = append(billingRun.Faturas, dtos.CreateInvoiceResponse(invoice))
+			continue
+		}
+
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return dtos.BillingRunResponse{}, utils.NewInternalResponseError(err)
+		}
+
+		lines := linesByClient[clientID]
+
+		invoice = entities.Fatura{
+			Base: entities.Base{
+				DataCriacao:     time.Now(),
+				DataAtualizacao: time.Now(),
+			},
+			ClienteID:   clientID,
+			Competencia: competencia,
+			Vencimento:  month.AddDate(0, 1, service.billingPolicy.DiaVencimento-1),
+		}
+
+		for _, line := range lines {
+			invoice.Total += line.Valor
+		}
+
+		invoice.Estado = dtos.InvoiceState(invoice, time.Now())
+
+		details, _ := json.Marshal(lines)
+		invoice.Detalhes = string(details)
+
+		invoice, err = service.invoiceRepository.CreateInvoice(ctx, invoice)
+		if err != nil {
+			return dtos.BillingRunResponse{}, utils.NewInternalResponseError(err)
+		}
+
+		service.logger.InfoContext(ctx, "invoice created", slog.String("fatura_id", invoice.ID),
+			slog.String("cliente_id", clientID), slog.String("competencia", competencia), slog.Int64("total", invoice.Total))
+
+		billingRun.Geradas++
+		billingRun.Faturas = append(billingRun.Faturas, dtos.CreateInvoiceResponse(invoice))
+	}
+
+	service.logger.InfoContext(ctx, "billing finished", slog.String("competencia", competencia),
+		slog.Int("geradas", billingRun.Geradas), slog.Int("existentes", billingRun.Existentes))
+
+	return billingRun, utils.ResponseError{}
+}
+
+func (service *billingService) FindInvoiceByID(ctx context.Context, invoiceID string) (entities.Fatura, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "BillingService.FindInvoiceByID")
+	defer span.End()
+
+	invoice, err := service.invoiceRepository.FindInvoiceByID(ctx, invoiceID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Fatura{}, utils.NewResponseError(utils.InvoiceNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Fatura{}, utils.NewInternalResponseError(err)
+	}
+
+	return invoice, utils.ResponseError{}
+}
+
+func (service *billingService) FindInvoices(ctx context.Context, clientID string, competencia string) ([]entities.Fatura, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "BillingService.FindInvoices")
+	defer span.End()
+
+	invoices, err := service.invoiceRepository.FindInvoices(ctx, clientID, competencia)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return invoices, utils.ResponseError{}
+}
+
+// billContract calcula os itens do contrato na competencia, agrupados pelo cliente dono do ponto em cada dia.
+// Os dias Em vigor são cobrados proporcionalmente ao preço do plano, os dias em Desativado Temporario pela
+// taxa da politica de faturamento e os dias cancelados, antes do cadastro ou a partir da remoção do contrato não
+// são cobrados.
+func (service *billingService) billContract(contract entities.Contrato, events []entities.ContratoEvento,
+	plans map[string]entities.Plano, month time.Time) map[string][]dtos.InvoiceLine {
+	lines := map[string][]dtos.InvoiceLine{}
+
+	days := billingDays(contract, events, month)
+	daysInMonth := len(days)
+
+	var current *dtos.InvoiceLine
+	var currentDay billingDay
+
+	closeLine := func() {
+		if current == nil {
+			return
+		}
+
+		current.Valor = int64(math.Round(float64(current.PrecoMensal) * float64(current.Dias) / float64(daysInMonth) * current.Percentual))
+		lines[currentDay.clienteID] = append(lines[currentDay.clienteID], *current)
+		current = nil
+	}
+
+	for i, day := range days {
+		plan, hasPlan := plans[day.planoID]
+
+		if day.estado != entities.VIGOR && day.estado != entities.DESATIVADO || !hasPlan || day.clienteID == "" {
+			closeLine()
+			continue
+		}
+
+		date := month.AddDate(0, 0, i).Format(dtos.DateLayout)
+
+		if current != nil && day == currentDay {
+			current.Dias++
+			current.Fim = date
+			continue
+		}
+
+		closeLine()
+
+		percent := 1.0
+		if day.estado == entities.DESATIVADO {
+			percent = service.billingPolicy.TaxaSuspensao
+		}
+
+		current = &dtos.InvoiceLine{
+			ContratoID:  contract.ID,
+			PontoID:     contract.PontoID,
+			PlanoID:     plan.ID,
+			PlanoNome:   plan.Nome,
+			Estado:      day.estado,
+			Inicio:      date,
+			Fim:         date,
+			Dias:        1,
+			DiasNoMes:   daysInMonth,
+			PrecoMensal: plan.PrecoMensal,
+			Percentual:  percent,
+		}
+		currentDay = day
+	}
+
+	closeLine()
+
+	return lines
+}
+
+// billingDays retorna o cliente, o estado e o plano do contrato ao final de cada dia da competencia, a partir
+// do historico do contrato. Os dias anteriores ao cadastro do contrato e os dias encerrados depois da remoção do
+// contrato retornam sem estado.
+func billingDays(contract entities.Contrato, events []entities.ContratoEvento, month time.Time) []billingDay {
+	events = append([]entities.ContratoEvento{}, events...)
+
+	sort.SliceStable(events, func(i, j int) bool {
+		return events[i].DataCriacao.Before(events[j].DataCriacao)
+	})
+
+	planEvents := []entities.ContratoEvento{}
+	transferEvents := []entities.ContratoEvento{}
+
+	for _, event := range events {
+		switch event.Tipo {
+		case entities.PLANO:
+			planEvents = append(planEvents, event)
+		case entities.TRANSFERENCIA:
+			transferEvents = append(transferEvents, event)
+		}
+	}
+
+	sort.SliceStable(planEvents, func(i, j int) bool {
+		return effectiveDate(planEvents[i]).Before(effectiveDate(planEvents[j]))
+	})
+
+	start := contract.DataCriacao
+	state := contract.Estado
+	planID := contract.PlanoID
+	clientID := contract.Ponto.ClienteID
+
+	if len(events) != 0 {
+		if start.IsZero() || events[0].DataCriacao.Before(start) {
+			start = events[0].DataCriacao
+		}
+
+		state = events[0].EstadoAnterior
+	}
+
+	if len(planEvents) != 0 {
+		planID = planEvents[0].PlanoAnteriorID
+	}
+
+	if len(transferEvents) != 0 {
+		clientID = transferEvents[0].ClienteAnteriorID
+	}
+
+	nextMonth := month.AddDate(0, 1, 0)
+	days := []billingDay{}
+
+	for day := month; day.Before(nextMonth); day = day.AddDate(0, 0, 1) {
+		dayEnd := day.AddDate(0, 0, 1)
+
+		for len(events) != 0 && events[0].DataCriacao.Before(dayEnd) {
+			state = events[0].EstadoPosterior
+			events = events[1:]
+		}
+
+		for len(planEvents) != 0 && effectiveDate(planEvents[0]).Before(dayEnd) {
+			planID = planEvents[0].PlanoPosteriorID
+			planEvents = planEvents[1:]
+		}
+
+		for len(transferEvents) != 0 && transferEvents[0].DataCriacao.Before(dayEnd) {
+			clientID = transferEvents[0].ClientePosteriorID
+			transferEvents = transferEvents[1:]
+		}
+
+		if !start.Before(dayEnd) || contract.DataRemocao.Valid && contract.DataRemocao.Time.Before(dayEnd) {
+			days = append(days, billingDay{})
+			continue
+		}
+
+		days = append(days, billingDay{clienteID: clientID, estado: state, planoID: planID})
+	}
+
+	return days
+}
+
+// effectiveDate retorna a data em que a troca de plano passa a valer.
+func effectiveDate(event entities.ContratoEvento) time.Time {
+	if event.DataEfetiva != nil {
+		return *event.DataEfetiva
+	}
+
+	return event.DataCriacao
+}
+
+// NewBillingService cria uma nova instancia de BillingService.
+func NewBillingService(invoiceRepository repositories.InvoiceRepository, contractRepository repositories.ContractRepository,
+	contractEventRepository repositories.ContractEventRepository, planRepository repositories.PlanRepository,
+	billingPolicy policies.BillingPolicy, logger *slog.Logger) BillingService {
+	return &billingService{
+		invoiceRepository:       invoiceRepository,
+		contractRepository:      contractRepository,
+		contractEventRepository: contractEventRepository,
+		planRepository:          planRepository,
+		billingPolicy:           billingPolicy,
+		logger:                  logger,
+	}
+}
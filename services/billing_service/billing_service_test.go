@@ -0,0 +1,298 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	billingService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/billing_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+	dbInvoice           = repositoriesFake.DBInvoice
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+	invoiceRepositoryFake           = repositoriesFake.NewInvoiceRepositoryFake(dbInvoice)
+
+	// Policies
+	clientPolicies = policies.Default()
+	billingPolicy  = policies.BillingPolicy{TaxaSuspensao: 0.5, DiaVencimento: 10, DiasCarencia: 15}
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	billingServiceTest         = billingService.NewBillingService(invoiceRepositoryFake, contractRepositoryFake, contractEventRepositoryFake, planRepositoryFake, billingPolicy, logNop)
+)
+
+// march data de um dia de março de 2024, competencia usada nos testes do faturamento.
+func march(day int, hour int) time.Time {
+	return time.Date(2024, time.March, day, hour, 0, 0, 0, time.Local)
+}
+
+// createBillingContract cadastra um contrato em março de 2024 para um novo cliente, com o historico informado.
+func createBillingContract(t *testing.T, name string, street string, plan entities.Plano, start time.Time, events []entities.ContratoEvento) (entities.Cliente, entities.Contrato) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: name,
+		Tipo: entities.FISICO,
+	}
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	require.Empty(t, responseError)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: street,
+		Bairro:     "BairroTest " + street,
+		Numero:     101,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	require.Empty(t, responseError)
+
+	contract, _ := contractRepositoryFake.CreateContract(ctx, entities.Contrato{
+		PontoID: point.ID,
+		Estado:  events[len(events)-1].EstadoPosterior,
+		PlanoID: plan.ID,
+	})
+
+	for i, contractValue := range *dbContract {
+		if contractValue.ID == contract.ID {
+			(*dbContract)[i].DataCriacao = start
+		}
+	}
+
+	for _, event := range events {
+		event.ContratoID = contract.ID
+		*dbContractEvent = append(*dbContractEvent, event)
+	}
+
+	return client, contract
+}
+
+// TestRunBilling testa se o faturamento cobra os dias de cada estado e plano proporcionalmente.
+func TestRunBilling(t *testing.T) {
+	basicPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 7.0", Velocidade: 100, PrecoMensal: 3100})
+	premiumPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 7.1", Velocidade: 500, PrecoMensal: 6200})
+
+	planChange := march(21, 0)
+	client, contract := createBillingContract(t, "Test 116.0", "LogradouroTest 101.0", premiumPlan, march(1, 10), []entities.ContratoEvento{
+		{Base: entities.Base{DataCriacao: march(1, 10)}, Tipo: entities.ESTADO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.VIGOR},
+		{Base: entities.Base{DataCriacao: march(1, 10)}, Tipo: entities.PLANO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.VIGOR, PlanoPosteriorID: basicPlan.ID},
+		{Base: entities.Base{DataCriacao: march(11, 12)}, Tipo: entities.ESTADO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.DESATIVADO},
+		{Base: entities.Base{DataCriacao: march(16, 9)}, Tipo: entities.ESTADO, EstadoAnterior: entities.DESATIVADO, EstadoPosterior: entities.VIGOR},
+		{Base: entities.Base{DataCriacao: march(15, 9)}, Tipo: entities.PLANO, EstadoAnterior: entities.DESATIVADO, EstadoPosterior: entities.DESATIVADO,
+			PlanoAnteriorID: basicPlan.ID, PlanoPosteriorID: premiumPlan.ID, DataEfetiva: &planChange},
+	})
+
+	billingRun, responseError := billingServiceTest.RunBilling(ctx, "2024-03")
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, billingRun.Geradas)
+	require.Equal(t, 0, billingRun.Existentes)
+	require.Len(t, billingRun.Faturas, 1)
+
+	invoice := billingRun.Faturas[0]
+
+	require.Equal(t, client.ID, invoice.ClienteID)
+	require.Equal(t, "2024-03", invoice.Competencia)
+	require.Equal(t, "2024-04-10", invoice.Vencimento)
+	require.Equal(t, entities.VENCIDA, invoice.Estado)
+	require.Len(t, invoice.Itens, 4)
+
+	require.Equal(t, contract.ID, invoice.Itens[0].ContratoID)
+	require.Equal(t, entities.VIGOR, invoice.Itens[0].Estado)
+	require.Equal(t, "2024-03-01", invoice.Itens[0].Inicio)
+	require.Equal(t, "2024-03-10", invoice.Itens[0].Fim)
+	require.Equal(t, 31, invoice.Itens[0].DiasNoMes)
+	require.Equal(t, int64(1000), invoice.Itens[0].Valor)
+
+	require.Equal(t, entities.DESATIVADO, invoice.Itens[1].Estado)
+	require.Equal(t, 5, invoice.Itens[1].Dias)
+	require.Equal(t, 0.5, invoice.Itens[1].Percentual)
+	require.Equal(t, int64(250), invoice.Itens[1].Valor)
+
+	require.Equal(t, basicPlan.ID, invoice.Itens[2].PlanoID)
+	require.Equal(t, "2024-03-20", invoice.Itens[2].Fim)
+	require.Equal(t, int64(500), invoice.Itens[2].Valor)
+
+	require.Equal(t, premiumPlan.ID, invoice.Itens[3].PlanoID)
+	require.Equal(t, 11, invoice.Itens[3].Dias)
+	require.Equal(t, int64(2200), invoice.Itens[3].Valor)
+
+	require.Equal(t, int64(3950), invoice.Total)
+}
+
+// TestRunBillingStopsAtCancellation testa se os dias antes do cadastro e após o cancelamento não são cobrados.
+func TestRunBillingStopsAtCancellation(t *testing.T) {
+	plan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 7.2", Velocidade: 100, PrecoMensal: 3100})
+
+	client, _ := createBillingContract(t, "Test 117.0", "LogradouroTest 102.0", plan, march(25, 8), []entities.ContratoEvento{
+		{Base: entities.Base{DataCriacao: march(25, 8)}, Tipo: entities.ESTADO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.VIGOR},
+		{Base: entities.Base{DataCriacao: march(25, 8)}, Tipo: entities.PLANO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.VIGOR, PlanoPosteriorID: plan.ID},
+		{Base: entities.Base{DataCriacao: march(28, 8)}, Tipo: entities.ESTADO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.DESATIVADO},
+		{Base: entities.Base{DataCriacao: march(29, 8)}, Tipo: entities.ESTADO, EstadoAnterior: entities.DESATIVADO, EstadoPosterior: entities.CANCELADO},
+	})
+
+	_, responseError := billingServiceTest.RunBilling(ctx, "2024-03")
+
+	require.Empty(t, responseError)
+
+	invoices, responseError := billingServiceTest.FindInvoices(ctx, client.ID, "2024-03")
+
+	require.Empty(t, responseError)
+	require.Len(t, invoices, 1)
+
+	invoice := dtos.CreateInvoiceResponse(invoices[0])
+
+	require.Len(t, invoice.Itens, 2)
+	require.Equal(t, "2024-03-25", invoice.Itens[0].Inicio)
+	require.Equal(t, 3, invoice.Itens[0].Dias)
+	require.Equal(t, 1, invoice.Itens[1].Dias)
+	require.Equal(t, int64(350), invoice.Total)
+}
+
+// TestRunBillingStopsAtRemoval testa se os dias em vigor antes da remoção do contrato e do seu ponto são cobrados,
+// e se os dias a partir da remoção não são cobrados.
+func TestRunBillingStopsAtRemoval(t *testing.T) {
+	plan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 7.3", Velocidade: 100, PrecoMensal: 3100})
+
+	client, contract := createBillingContract(t, "Test 118.0", "LogradouroTest 103.0", plan, march(1, 8), []entities.ContratoEvento{
+		{Base: entities.Base{DataCriacao: march(1, 8)}, Tipo: entities.ESTADO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.VIGOR},
+		{Base: entities.Base{DataCriacao: march(1, 8)}, Tipo: entities.PLANO, EstadoAnterior: entities.VIGOR, EstadoPosterior: entities.VIGOR, PlanoPosteriorID: plan.ID},
+	})
+
+	for i, contractValue := range *dbContract {
+		if contractValue.ID == contract.ID {
+			(*dbContract)[i].DataRemocao.Scan(march(11, 8))
+		}
+	}
+
+	for i, pointValue := range *dbPoint {
+		if pointValue.ID == contract.PontoID {
+			(*dbPoint)[i].DataRemocao.Scan(march(11, 8))
+		}
+	}
+
+	_, responseError := billingServiceTest.RunBilling(ctx, "2024-03")
+
+	require.Empty(t, responseError)
+
+	invoices, responseError := billingServiceTest.FindInvoices(ctx, client.ID, "2024-03")
+
+	require.Empty(t, responseError)
+	require.Len(t, invoices, 1)
+
+	invoice := dtos.CreateInvoiceResponse(invoices[0])
+
+	require.Len(t, invoice.Itens, 1)
+	require.Equal(t, "2024-03-01", invoice.Itens[0].Inicio)
+	require.Equal(t, "2024-03-10", invoice.Itens[0].Fim)
+	require.Equal(t, int64(1000), invoice.Total)
+}
+
+// TestRunBillingIdempotent testa se executar o faturamento novamente mantém as faturas já geradas.
+func TestRunBillingIdempotent(t *testing.T) {
+	_, responseError := billingServiceTest.RunBilling(ctx, "2024-03")
+
+	require.Empty(t, responseError)
+
+	invoices, _ := billingServiceTest.FindInvoices(ctx, "", "2024-03")
+
+	billingRun, responseError := billingServiceTest.RunBilling(ctx, "2024-03")
+
+	require.Empty(t, responseError)
+	require.Equal(t, 0, billingRun.Geradas)
+	require.Equal(t, len(invoices), billingRun.Existentes)
+
+	invoicesAfter, _ := billingServiceTest.FindInvoices(ctx, "", "2024-03")
+
+	require.Len(t, invoicesAfter, len(invoices))
+
+	invoice, responseError := billingServiceTest.FindInvoiceByID(ctx, billingRun.Faturas[0].ID)
+
+	require.Empty(t, responseError)
+	require.Equal(t, billingRun.Faturas[0].Total, invoice.Total)
+}
+
+// TestRunBillingWithInvalidCompetencia testa se não é possivel faturar uma competencia invalida, futura ou ainda
+// não encerrada.
+func TestRunBillingWithInvalidCompetencia(t *testing.T) {
+	currentMonth := time.Now().Format(dtos.CompetenciaLayout)
+	futureMonth := time.Now().AddDate(0, 2, 0).Format(dtos.CompetenciaLayout)
+
+	for _, competencia := range []string{"", "03/2024", "2024-13", currentMonth, futureMonth} {
+		billingRun, responseError := billingServiceTest.RunBilling(ctx, competencia)
+
+		require.Equal(t, utils.InvalidCompetencia, responseError.Message)
+		require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+		require.Empty(t, billingRun)
+	}
+
+	invoice, responseError := billingServiceTest.FindInvoiceByID(ctx, "invalid_id")
+
+	require.Equal(t, utils.InvoiceNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, invoice)
+}
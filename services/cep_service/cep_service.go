@@ -0,0 +1,263 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/cep_service")
+
+// Limites da importação do diretorio de CEPs. Cada lote é gravado em um unico comando.
+const (
+	importBatchSize = 1000
+	maxImportErrors = 100
+)
+
+// cepColumns nomes aceitos no cabeçalho do CSV para cada campo, incluindo os nomes das colunas do DNE dos Correios.
+var cepColumns = map[string][]string{
+	"cep":        {"cep"},
+	"logradouro": {"logradouro", "log_no", "log_nome"},
+	"bairro":     {"bairro", "bai_no"},
+	"cidade":     {"cidade", "localidade", "municipio", "loc_no"},
+	"uf":         {"uf", "ufe_sg"},
+}
+
+// CEPService representa a interface de cepService.
+type CEPService interface {
+	FindCEP(ctx context.Context, cep string) (entities.Cep, utils.ResponseError)
+	ImportCEPs(ctx context.Context, reader io.Reader) (dtos.CEPImportResult, utils.ResponseError)
+}
+
+type cepService struct {
+	cepRepository repositories.CEPRepository
+	logger        *slog.Logger
+}
+
+func (service *cepService) FindCEP(ctx context.Context, cep string) (entities.Cep, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "CEPService.FindCEP")
+	defer span.End()
+
+	code, ok := dtos.NormalizeCEP(cep)
+	if !ok {
+		return entities.Cep{}, utils.NewResponseError("cep: "+utils.InvalidCEP, http.StatusBadRequest)
+	}
+
+	cepFound, err := service.cepRepository.FindCEPByCode(ctx, code)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Cep{}, utils.NewResponseError(utils.CEPNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Cep{}, utils.NewInternalResponseError(err)
+	}
+
+	return cepFound, utils.ResponseError{}
+}
+
+func (service *cepService) ImportCEPs(ctx context.Context, reader io.Reader) (dtos.CEPImportResult, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "CEPService.ImportCEPs")
+	defer span.End()
+
+	result := dtos.CEPImportResult{Erros: []string{}}
+
+	bufferedReader := bufio.NewReader(reader)
+
+	// O delimitador é detectado pelo cabeçalho, aceitando arquivos separados por ponto e virgula ou virgula.
+	header, err := bufferedReader.Peek(bufferedReader.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return dtos.CEPImportResult{}, utils.NewResponseError(utils.InvalidCEPFile, http.StatusBadRequest)
+	}
+
+	csvReader := csv.NewReader(bufferedReader)
+	csvReader.Comma = ','
+	if firstLine, _, _ := strings.Cut(string(header), "\n"); strings.Contains(firstLine, ";") {
+		csvReader.Comma = ';'
+	}
+	csvReader.FieldsPerRecord = -1
+	csvReader.LazyQuotes = true
+
+	columns, err := csvReader.Read()
+	if err != nil {
+		return dtos.CEPImportResult{}, utils.NewResponseError(utils.InvalidCEPFile, http.StatusBadRequest)
+	}
+
+	indexes, ok := cepColumnIndexes(columns)
+	if !ok {
+		return dtos.CEPImportResult{}, utils.NewResponseError(utils.InvalidCEPFile+": cep, logradouro, bairro, cidade, uf",
+			http.StatusBadRequest)
+	}
+
+	ceps := []entities.Cep{}
+	positions := map[string]cepPosition{}
+	batch := 0
+	line := 1
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		line++
+
+		if err != nil {
+			addImportError(&result, fmt.Sprintf("linha %v: %v", line, err))
+			continue
+		}
+
+		cep, message := parseCEPRecord(record, indexes)
+		if message != "" {
+			addImportError(&result, fmt.Sprintf("linha %v: %v", line, message))
+			continue
+		}
+
+		// O CEP repetido no arquivo prevalece com a ultima linha, substituindo a anterior ainda não gravada. A
+		// linha repetida é contada como ignorada.
+		position, repeated := positions[cep.Codigo]
+		if repeated {
+			addImportError(&result, fmt.Sprintf("linha %v: cep: %v", line, utils.RepeatedCEP))
+		}
+
+		if repeated && position.batch == batch {
+			ceps[position.index] = cep
+			continue
+		}
+
+		positions[cep.Codigo] = cepPosition{batch: batch, index: len(ceps)}
+		ceps = append(ceps, cep)
+
+		if len(ceps) == importBatchSize {
+			responseError := service.saveCEPs(ctx, ceps)
+			if responseError != (utils.ResponseError{}) {
+				return dtos.CEPImportResult{}, responseError
+			}
+
+			ceps = []entities.Cep{}
+			batch++
+		}
+	}
+
+	if len(ceps) != 0 {
+		responseError := service.saveCEPs(ctx, ceps)
+		if responseError != (utils.ResponseError{}) {
+			return dtos.CEPImportResult{}, responseError
+		}
+	}
+
+	result.Importados = len(positions)
+
+	service.logger.InfoContext(ctx, "ceps imported", slog.Int("importados", result.Importados),
+		slog.Int("ignorados", result.Ignorados))
+
+	return result, utils.ResponseError{}
+}
+
+func (service *cepService) saveCEPs(ctx context.Context, ceps []entities.Cep) utils.ResponseError {
+	now := time.Now()
+
+	for i := range ceps {
+		ceps[i].DataCriacao = now
+		ceps[i].DataAtualizacao = now
+	}
+
+	err := service.cepRepository.SaveCEPs(ctx, ceps)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	return utils.ResponseError{}
+}
+
+// cepPosition posição do CEP no lote de gravação em que foi incluido.
+type cepPosition struct {
+	batch int
+	index int
+}
+
+// addImportError contabiliza a linha ignorada, guardando somente as primeiras mensagens de erro.
+func addImportError(result *dtos.CEPImportResult, message string) {
+	result.Ignorados++
+
+	if len(result.Erros) < maxImportErrors {
+		result.Erros = append(result.Erros, message)
+	}
+}
+
+// cepColumnIndexes localiza a posição de cada campo no cabeçalho do CSV.
+func cepColumnIndexes(columns []string) (map[string]int, bool) {
+	indexes := map[string]int{}
+
+	for i, column := range columns {
+		column = strings.ToLower(strings.TrimSpace(strings.TrimPrefix(column, "\uFEFF")))
+
+		for field, names := range cepColumns {
+			for _, name := range names {
+				if column == name {
+					indexes[field] = i
+				}
+			}
+		}
+	}
+
+	return indexes, len(indexes) == len(cepColumns)
+}
+
+// parseCEPRecord converte uma linha do CSV em um CEP, retornando a mensagem de erro quando a linha é invalida.
+func parseCEPRecord(record []string, indexes map[string]int) (entities.Cep, string) {
+	values := map[string]string{}
+
+	for field, index := range indexes {
+		if index >= len(record) {
+			return entities.Cep{}, "numero de colunas invalido"
+		}
+
+		values[field] = strings.TrimSpace(record[index])
+	}
+
+	code, ok := dtos.NormalizeCEP(values["cep"])
+	if !ok {
+		return entities.Cep{}, "cep: " + utils.InvalidCEP
+	}
+
+	uf, ok := dtos.NormalizeUF(values["uf"])
+	if !ok {
+		return entities.Cep{}, "uf: " + utils.InvalidUF
+	}
+
+	if values["cidade"] == "" {
+		return entities.Cep{}, "cidade: " + utils.InvalidNumberOfCaracter
+	}
+
+	cep := entities.Cep{
+		Codigo:     code,
+		Logradouro: values["logradouro"],
+		Bairro:     values["bairro"],
+		Cidade:     values["cidade"],
+		Uf:         uf,
+	}
+
+	return cep, ""
+}
+
+// NewCEPService cria uma nova instancia de CEPService.
+func NewCEPService(cepRepository repositories.CEPRepository, logger *slog.Logger) CEPService {
+	return &cepService{
+		cepRepository: cepRepository,
+		logger:        logger,
+	}
+}
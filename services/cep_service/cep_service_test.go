@@ -0,0 +1,163 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	cepService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/cep_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbCep = repositoriesFake.DBCep
+
+	// Fake Repositories
+	cepRepositoryFake = repositoriesFake.NewCEPRepositoryFake(dbCep)
+
+	// Services Tests
+	cepServiceTest = cepService.NewCEPService(cepRepositoryFake, logNop)
+)
+
+// TestImportCEPs testa se é possivel importar um arquivo separado por ponto e virgula.
+func TestImportCEPs(t *testing.T) {
+	file := "cep;logradouro;bairro;cidade;uf\n" +
+		"01001-000;Praça da Sé;Sé;São Paulo;sp\n" +
+		"01310100;Avenida Paulista;Bela Vista;São Paulo;SP\n"
+
+	result, responseError := cepServiceTest.ImportCEPs(ctx, strings.NewReader(file))
+
+	require.Empty(t, responseError)
+	require.Equal(t, 2, result.Importados)
+	require.Equal(t, 0, result.Ignorados)
+
+	cep, responseError := cepServiceTest.FindCEP(ctx, "01001-000")
+
+	require.Empty(t, responseError)
+	require.Equal(t, "01001000", cep.Codigo)
+	require.Equal(t, "Praça da Sé", cep.Logradouro)
+	require.Equal(t, "SP", cep.Uf)
+}
+
+// TestImportCEPsWithCommaAndDNEHeader testa se é possivel importar um arquivo separado por virgula com as colunas do DNE.
+func TestImportCEPsWithCommaAndDNEHeader(t *testing.T) {
+	file := "\uFEFFUFE_SG,LOC_NO,BAI_NO,LOG_NO,CEP\n" +
+		"RJ,Rio de Janeiro,Centro,Avenida Rio Branco,20040020\n"
+
+	result, responseError := cepServiceTest.ImportCEPs(ctx, strings.NewReader(file))
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, result.Importados)
+
+	cep, responseError := cepServiceTest.FindCEP(ctx, "20040020")
+
+	require.Empty(t, responseError)
+	require.Equal(t, "Rio de Janeiro", cep.Cidade)
+	require.Equal(t, "Avenida Rio Branco", cep.Logradouro)
+}
+
+// TestImportCEPsWithInvalidLines testa se as linhas invalidas são ignoradas sem impedir a importação das demais.
+func TestImportCEPsWithInvalidLines(t *testing.T) {
+	file := "cep;logradouro;bairro;cidade;uf\n" +
+		"3013001;Praça Sete;Centro;Belo Horizonte;MG\n" +
+		"30130010;Praça Sete;Centro;Belo Horizonte;XX\n" +
+		"30130010;Praça Sete;Centro;;MG\n" +
+		"30130010;Praça Sete\n" +
+		"30130010;Praça Sete de Setembro;Centro;Belo Horizonte;MG\n"
+
+	result, responseError := cepServiceTest.ImportCEPs(ctx, strings.NewReader(file))
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, result.Importados)
+	require.Equal(t, 4, result.Ignorados)
+	require.Len(t, result.Erros, 4)
+	require.Equal(t, "linha 2: cep: "+utils.InvalidCEP, result.Erros[0])
+}
+
+// TestImportCEPsUpdatesExistingCEP testa se a reimportação de um CEP atualiza os seus dados.
+func TestImportCEPsUpdatesExistingCEP(t *testing.T) {
+	file := "cep;logradouro;bairro;cidade;uf\n40015970;Praça da Inglaterra;Comercio;Salvador;BA\n"
+	_, _ = cepServiceTest.ImportCEPs(ctx, strings.NewReader(file))
+
+	file = "cep;logradouro;bairro;cidade;uf\n40015970;Praça da Inglaterra;Comércio;Salvador;BA\n"
+	result, responseError := cepServiceTest.ImportCEPs(ctx, strings.NewReader(file))
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, result.Importados)
+
+	cep, _ := cepServiceTest.FindCEP(ctx, "40015970")
+	count := 0
+
+	for _, cepValue := range *dbCep {
+		if cepValue.Codigo == "40015970" {
+			count++
+		}
+	}
+
+	require.Equal(t, "Comércio", cep.Bairro)
+	require.Equal(t, 1, count)
+}
+
+// TestImportCEPsWithRepeatedCEP testa se o CEP repetido no arquivo é gravado uma unica vez, com os dados da ultima linha.
+func TestImportCEPsWithRepeatedCEP(t *testing.T) {
+	file := "cep;logradouro;bairro;cidade;uf\n" +
+		"80010000;Rua XV;Centro;Curitiba;PR\n" +
+		"80020000;Rua Marechal Deodoro;Centro;Curitiba;PR\n" +
+		"80010000;Rua XV de Novembro;Centro;Curitiba;PR\n"
+
+	result, responseError := cepServiceTest.ImportCEPs(ctx, strings.NewReader(file))
+
+	require.Empty(t, responseError)
+	require.Equal(t, 2, result.Importados)
+	require.Equal(t, 1, result.Ignorados)
+	require.Equal(t, []string{"linha 4: cep: " + utils.RepeatedCEP}, result.Erros)
+
+	cep, _ := cepServiceTest.FindCEP(ctx, "80010000")
+	count := 0
+
+	for _, cepValue := range *dbCep {
+		if cepValue.Codigo == "80010000" {
+			count++
+		}
+	}
+
+	require.Equal(t, "Rua XV de Novembro", cep.Logradouro)
+	require.Equal(t, 1, count)
+}
+
+// TestImportCEPsWithInvalidHeader testa se não é possivel importar um arquivo sem as colunas obrigatorias.
+func TestImportCEPsWithInvalidHeader(t *testing.T) {
+	file := "cep;rua;cidade\n60175047;Avenida Beira Mar;Fortaleza\n"
+
+	result, responseError := cepServiceTest.ImportCEPs(ctx, strings.NewReader(file))
+
+	require.Contains(t, responseError.Message, utils.InvalidCEPFile)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, result)
+}
+
+// TestFindCEPNotFound testa se a pesquisa de um CEP fora do diretorio retorna não encontrado.
+func TestFindCEPNotFound(t *testing.T) {
+	cep, responseError := cepServiceTest.FindCEP(ctx, "99999999")
+
+	require.Equal(t, utils.CEPNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, cep)
+}
+
+// TestFindCEPWithInvalidCEP testa se não é possivel pesquisar um CEP invalido.
+func TestFindCEPWithInvalidCEP(t *testing.T) {
+	cep, responseError := cepServiceTest.FindCEP(ctx, "1234")
+
+	require.Equal(t, "cep: "+utils.InvalidCEP, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, cep)
+}
@@ -0,0 +1,266 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_merge_service")
+
+// ClientMergeService representa a interface de clientMergeService.
+type ClientMergeService interface {
+	MergeClients(ctx context.Context, clientMergeDTO dtos.ClientMergeDTO) (dtos.ClientMergePlan, utils.ResponseError)
+	FindClientMergesByClientID(ctx context.Context, clientID string) ([]entities.ClienteMesclagem, utils.ResponseError)
+}
+
+type clientMergeService struct {
+	clientMergeRepository repositories.ClientMergeRepository
+	clientRepository      repositories.ClientRepository
+	pointRepository       repositories.PointRepository
+	contractRepository    repositories.ContractRepository
+	contactRepository     repositories.ContactRepository
+	logger                *slog.Logger
+}
+
+// survivorPoint representa um ponto do sobrevivente durante a montagem do plano da mesclagem.
+type survivorPoint struct {
+	pointID    string
+	contractID string
+}
+
+func (service *clientMergeService) MergeClients(ctx context.Context, clientMergeDTO dtos.ClientMergeDTO) (dtos.ClientMergePlan, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientMergeService.MergeClients")
+	defer span.End()
+
+	plan, responseError := service.planMerge(ctx, clientMergeDTO)
+	if responseError != (utils.ResponseError{}) {
+		return dtos.ClientMergePlan{}, responseError
+	}
+
+	if plan.Previa {
+		return plan, utils.ResponseError{}
+	}
+
+	if len(plan.Conflitos) != 0 {
+		return dtos.ClientMergePlan{}, utils.NewResponseError(utils.ClientMergeConflict+": "+strings.Join(plan.Conflitos, "; "),
+			http.StatusConflict)
+	}
+
+	details, err := json.Marshal(plan)
+	if err != nil {
+		return dtos.ClientMergePlan{}, utils.NewInternalResponseError(err)
+	}
+
+	clientMerge := entities.ClienteMesclagem{
+		Base: entities.Base{
+			DataCriacao:     time.Now(),
+			DataAtualizacao: time.Now(),
+		},
+		SobreviventeID: plan.SobreviventeID,
+		DuplicadosIDs:  strings.Join(plan.DuplicadosIDs, ","),
+		Detalhes:       string(details),
+	}
+
+	clientMerge, err = service.clientMergeRepository.MergeClients(ctx, plan, clientMerge)
+	if err != nil {
+		return dtos.ClientMergePlan{}, utils.NewInternalResponseError(err)
+	}
+
+	plan.MesclagemID = clientMerge.ID
+
+	service.logger.InfoContext(ctx, "clients merged", slog.String("mesclagem_id", clientMerge.ID),
+		slog.String("cliente_id", plan.SobreviventeID), slog.String("duplicados_ids", clientMerge.DuplicadosIDs),
+		slog.Int("pontos_transferidos", len(plan.PontosTransferidos)), slog.Int("pontos_unificados", len(plan.PontosUnificados)))
+
+	return plan, utils.ResponseError{}
+}
+
+func (service *clientMergeService) FindClientMergesByClientID(ctx context.Context, clientID string) ([]entities.ClienteMesclagem, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientMergeService.FindClientMergesByClientID")
+	defer span.End()
+
+	clientMerges, err := service.clientMergeRepository.FindClientMergesByClientID(ctx, clientID)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return clientMerges, utils.ResponseError{}
+}
+
+// planMerge monta as alterações da mesclagem sem altera-las no banco de dados.
+func (service *clientMergeService) planMerge(ctx context.Context, clientMergeDTO dtos.ClientMergeDTO) (dtos.ClientMergePlan, utils.ResponseError) {
+	plan := dtos.ClientMergePlan{
+		Previa:               clientMergeDTO.Previa,
+		SobreviventeID:       clientMergeDTO.SobreviventeID,
+		DuplicadosIDs:        clientMergeDTO.DuplicadosIDs,
+		PontosTransferidos:   []dtos.PointTransfer{},
+		PontosUnificados:     []dtos.PointUnification{},
+		ContatosTransferidos: []dtos.ContactTransfer{},
+		ContatosDescartados:  []string{},
+		ContatosSubstituidos: map[string]string{},
+		Conflitos:            []string{},
+	}
+
+	clientIDs := map[string]bool{plan.SobreviventeID: true}
+	for _, duplicateID := range plan.DuplicadosIDs {
+		if clientIDs[duplicateID] {
+			return dtos.ClientMergePlan{}, utils.NewResponseError(utils.InvalidClientMerge, http.StatusBadRequest)
+		}
+
+		clientIDs[duplicateID] = true
+	}
+
+	for _, clientID := range append([]string{plan.SobreviventeID}, plan.DuplicadosIDs...) {
+		_, err := service.clientRepository.FindClientByID(ctx, clientID)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return dtos.ClientMergePlan{}, utils.NewResponseError(utils.ClientNotFound+": "+clientID, http.StatusNotFound)
+		}
+
+		if err != nil {
+			return dtos.ClientMergePlan{}, utils.NewInternalResponseError(err)
+		}
+	}
+
+	// Os pontos do sobrevivente por endereço, incluindo os pontos transferidos durante a montagem do plano.
+	survivorPoints := map[string]survivorPoint{}
+
+	points, err := service.pointRepository.FindPointsByClientID(ctx, plan.SobreviventeID)
+	if err != nil {
+		return dtos.ClientMergePlan{}, utils.NewInternalResponseError(err)
+	}
+
+	for _, point := range points {
+		contractID, responseError := service.findContractID(ctx, point.ID)
+		if responseError != (utils.ResponseError{}) {
+			return dtos.ClientMergePlan{}, responseError
+		}
+
+		survivorPoints[point.EnderecoID] = survivorPoint{pointID: point.ID, contractID: contractID}
+	}
+
+	// Os ids dos contatos do sobrevivente por tipo e valor, e os tipos que já possuem um contato principal.
+	survivorContacts := map[string]string{}
+	primaryTypes := map[entities.ContactType]bool{}
+
+	contacts, err := service.contactRepository.FindContactsByClientID(ctx, plan.SobreviventeID)
+	if err != nil {
+		return dtos.ClientMergePlan{}, utils.NewInternalResponseError(err)
+	}
+
+	for _, contact := range contacts {
+		survivorContacts[string(contact.Tipo)+"|"+contact.Valor] = contact.ID
+		primaryTypes[contact.Tipo] = primaryTypes[contact.Tipo] || contact.Principal
+	}
+
+	for _, duplicateID := range plan.DuplicadosIDs {
+		points, err := service.pointRepository.FindPointsByClientID(ctx, duplicateID)
+		if err != nil {
+			return dtos.ClientMergePlan{}, utils.NewInternalResponseError(err)
+		}
+
+		for _, point := range points {
+			contractID, responseError := service.findContractID(ctx, point.ID)
+			if responseError != (utils.ResponseError{}) {
+				return dtos.ClientMergePlan{}, responseError
+			}
+
+			target, ok := survivorPoints[point.EnderecoID]
+			if !ok {
+				plan.PontosTransferidos = append(plan.PontosTransferidos, dtos.PointTransfer{
+					PontoID:           point.ID,
+					ClienteAnteriorID: duplicateID,
+					EnderecoID:        point.EnderecoID,
+					ContratoID:        contractID,
+				})
+
+				survivorPoints[point.EnderecoID] = survivorPoint{pointID: point.ID, contractID: contractID}
+				continue
+			}
+
+			if contractID != "" && target.contractID != "" {
+				plan.Conflitos = append(plan.Conflitos, fmt.Sprintf("endereco %v: contratos %v e %v",
+					point.EnderecoID, target.contractID, contractID))
+				continue
+			}
+
+			plan.PontosUnificados = append(plan.PontosUnificados, dtos.PointUnification{
+				PontoID:             point.ID,
+				ClienteAnteriorID:   duplicateID,
+				PontoSobreviventeID: target.pointID,
+				EnderecoID:          point.EnderecoID,
+				ContratoID:          contractID,
+			})
+
+			if contractID != "" {
+				target.contractID = contractID
+				survivorPoints[point.EnderecoID] = target
+			}
+		}
+
+		contacts, err := service.contactRepository.FindContactsByClientID(ctx, duplicateID)
+		if err != nil {
+			return dtos.ClientMergePlan{}, utils.NewInternalResponseError(err)
+		}
+
+		for _, contact := range contacts {
+			key := string(contact.Tipo) + "|" + contact.Valor
+			if survivorContactID, ok := survivorContacts[key]; ok {
+				plan.ContatosDescartados = append(plan.ContatosDescartados, contact.ID)
+				plan.ContatosSubstituidos[contact.ID] = survivorContactID
+				continue
+			}
+
+			// O sobrevivente mantem o seu contato principal de cada tipo.
+			principal := contact.Principal && !primaryTypes[contact.Tipo]
+
+			plan.ContatosTransferidos = append(plan.ContatosTransferidos, dtos.ContactTransfer{
+				ContatoID: contact.ID,
+				Principal: principal,
+			})
+
+			survivorContacts[key] = contact.ID
+			primaryTypes[contact.Tipo] = primaryTypes[contact.Tipo] || principal
+		}
+	}
+
+	return plan, utils.ResponseError{}
+}
+
+// findContractID busca o id do contrato atual do ponto, retornando vazio quando o ponto não possui contrato.
+func (service *clientMergeService) findContractID(ctx context.Context, pointID string) (string, utils.ResponseError) {
+	contract, err := service.contractRepository.FindContractByPontoID(ctx, pointID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return "", utils.ResponseError{}
+	}
+
+	if err != nil {
+		return "", utils.NewInternalResponseError(err)
+	}
+
+	return contract.ID, utils.ResponseError{}
+}
+
+// NewClientMergeService cria uma nova instancia de ClientMergeService.
+func NewClientMergeService(clientMergeRepository repositories.ClientMergeRepository, clientRepository repositories.ClientRepository, pointRepository repositories.PointRepository, contractRepository repositories.ContractRepository, contactRepository repositories.ContactRepository, logger *slog.Logger) ClientMergeService {
+	return &clientMergeService{
+		clientMergeRepository: clientMergeRepository,
+		clientRepository:      clientRepository,
+		pointRepository:       pointRepository,
+		contractRepository:    contractRepository,
+		contactRepository:     contactRepository,
+		logger:                logger,
+	}
+}
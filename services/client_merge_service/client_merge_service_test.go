@@ -0,0 +1,355 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientMergeService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_merge_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+	dbClientMerge       = repositoriesFake.DBClientMerge
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	clientMergeRepositoryFake       = repositoriesFake.NewClientMergeRepositoryFake(dbClientMerge, dbClient, dbPoint, dbContract, dbContact, dbEquipment, dbServiceOrder, dbTicket)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	clientMergeServiceTest     = clientMergeService.NewClientMergeService(clientMergeRepositoryFake, clientRepositoryFake,
+		pointRepositoryFake, contractRepositoryFake, contactRepositoryFake, logNop)
+)
+
+// createMergeAddress cria um endereço para os testes da mesclagem de clientes.
+func createMergeAddress(t *testing.T, number int) entities.Endereco {
+	address, responseError := addressServiceTest.CreateAddress(ctx, dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest " + strconv.Itoa(number) + ".0",
+		Bairro:     "BairroTest " + strconv.Itoa(number) + ".0",
+		Numero:     number,
+	})
+	require.Empty(t, responseError)
+
+	return address
+}
+
+// createMergePoint cria um ponto, com contrato quando informado, para os testes da mesclagem de clientes.
+func createMergePoint(t *testing.T, clientID string, addressID string, withContract bool) (entities.Ponto, entities.Contrato) {
+	point, responseError := pointServiceTest.CreatePoint(ctx, dtos.PointCreateDTO{
+		ClienteID:  clientID,
+		EnderecoID: addressID,
+	})
+	require.Empty(t, responseError)
+
+	if !withContract {
+		return point, entities.Contrato{}
+	}
+
+	contract, responseError := contractServiceTest.CreateContract(ctx, dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+	})
+	require.Empty(t, responseError)
+
+	return point, contract
+}
+
+// TestMergeClients testa se é possivel mesclar clientes, transferindo os pontos, contratos e contatos do duplicado.
+func TestMergeClients(t *testing.T) {
+	survivor, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 99.0", Tipo: entities.FISICO})
+	duplicate, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 99.1", Tipo: entities.FISICO})
+
+	address := createMergeAddress(t, 91)
+	point, contract := createMergePoint(t, duplicate.ID, address.ID, true)
+
+	survivorContact, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: survivor.ID, Tipo: entities.EMAIL, Valor: "test99@example.com"})
+	sameContact, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: duplicate.ID, Tipo: entities.EMAIL, Valor: "test99@example.com"})
+	otherContact, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: duplicate.ID, Tipo: entities.EMAIL, Valor: "test99.1@example.com"})
+
+	plan, responseError := clientMergeServiceTest.MergeClients(ctx, dtos.ClientMergeDTO{
+		SobreviventeID: survivor.ID,
+		DuplicadosIDs:  []string{duplicate.ID},
+	})
+
+	require.Empty(t, responseError)
+	require.NotEmpty(t, plan.MesclagemID)
+	require.Equal(t, []dtos.PointTransfer{
+		{PontoID: point.ID, ClienteAnteriorID: duplicate.ID, EnderecoID: address.ID, ContratoID: contract.ID},
+	}, plan.PontosTransferidos)
+	require.Equal(t, []string{sameContact.ID}, plan.ContatosDescartados)
+	require.Equal(t, []dtos.ContactTransfer{{ContatoID: otherContact.ID, Principal: false}}, plan.ContatosTransferidos)
+
+	pointFound, _ := pointServiceTest.FindPointByID(ctx, point.ID)
+	require.Equal(t, survivor.ID, pointFound.ClienteID)
+
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
+	require.Equal(t, point.ID, contractFound.PontoID)
+
+	contacts, _ := contactServiceTest.FindContactsByClientID(ctx, survivor.ID)
+	require.Len(t, contacts, 2)
+	require.Equal(t, survivorContact.ID, contacts[0].ID)
+	require.True(t, contacts[0].Principal)
+
+	_, responseError = clientServiceTest.FindClientByID(ctx, duplicate.ID)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+
+	clientMerges, responseError := clientMergeServiceTest.FindClientMergesByClientID(ctx, survivor.ID)
+	require.Empty(t, responseError)
+	require.Len(t, clientMerges, 1)
+
+	clientMergeResponse := dtos.CreateClientMergeResponse(clientMerges[0])
+	require.Equal(t, []string{duplicate.ID}, clientMergeResponse.DuplicadosIDs)
+	require.Equal(t, plan.PontosTransferidos, clientMergeResponse.Detalhes.PontosTransferidos)
+}
+
+// TestMergeClientsWithSharedAddress testa se os pontos no mesmo endereço são unificados, transferindo o contrato do duplicado.
+func TestMergeClientsWithSharedAddress(t *testing.T) {
+	survivor, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 100.0", Tipo: entities.FISICO})
+	duplicate, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 100.1", Tipo: entities.FISICO})
+
+	address := createMergeAddress(t, 92)
+	survivorPoint, _ := createMergePoint(t, survivor.ID, address.ID, false)
+	duplicatePoint, contract := createMergePoint(t, duplicate.ID, address.ID, true)
+
+	plan, responseError := clientMergeServiceTest.MergeClients(ctx, dtos.ClientMergeDTO{
+		SobreviventeID: survivor.ID,
+		DuplicadosIDs:  []string{duplicate.ID},
+	})
+
+	require.Empty(t, responseError)
+	require.Empty(t, plan.PontosTransferidos)
+	require.Equal(t, []dtos.PointUnification{{
+		PontoID:             duplicatePoint.ID,
+		ClienteAnteriorID:   duplicate.ID,
+		PontoSobreviventeID: survivorPoint.ID,
+		EnderecoID:          address.ID,
+		ContratoID:          contract.ID,
+	}}, plan.PontosUnificados)
+
+	pointFound, responseError := pointServiceTest.FindPointByClientIDAndAddressID(ctx, survivor.ID, address.ID)
+	require.Empty(t, responseError)
+	require.Equal(t, survivorPoint.ID, pointFound.ID)
+
+	_, responseError = pointServiceTest.FindPointByID(ctx, duplicatePoint.ID)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
+	require.Equal(t, survivorPoint.ID, contractFound.PontoID)
+}
+
+// TestMergeClientsMovesPointHistory testa se a unificação dos pontos transfere a sequencia de contratos, as ordens
+// de serviço e os chamados do ponto duplicado, se os contratos do contato descartado passam para o contato do
+// sobrevivente e se as filiais do duplicado passam para o sobrevivente.
+func TestMergeClientsMovesPointHistory(t *testing.T) {
+	survivor, responseError := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 104.0", Tipo: entities.JURIDICO})
+	require.Empty(t, responseError)
+
+	duplicate, responseError := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 104.1", Tipo: entities.JURIDICO})
+	require.Empty(t, responseError)
+
+	branch, responseError := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{
+		Nome: "Test 104.2", Tipo: entities.JURIDICO, MatrizID: duplicate.ID})
+	require.Empty(t, responseError)
+
+	survivorContact, responseError := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: survivor.ID, Tipo: entities.EMAIL, Valor: "test104@example.com"})
+	require.Empty(t, responseError)
+
+	sameContact, responseError := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: duplicate.ID, Tipo: entities.EMAIL, Valor: "test104@example.com"})
+	require.Empty(t, responseError)
+
+	address := createMergeAddress(t, 95)
+	survivorPoint, _ := createMergePoint(t, survivor.ID, address.ID, false)
+	duplicatePoint, _ := createMergePoint(t, duplicate.ID, address.ID, false)
+
+	cancelledContract, err := contractRepositoryFake.CreateContract(ctx, entities.Contrato{
+		PontoID: duplicatePoint.ID, Estado: entities.CANCELADO, ContatoResponsavelID: sameContact.ID})
+	require.NoError(t, err)
+
+	contract, responseError := contractServiceTest.CreateContract(ctx, dtos.ContractCreateDTO{
+		PontoID: duplicatePoint.ID, Estado: entities.VIGOR, ContatoResponsavelID: sameContact.ID})
+	require.Empty(t, responseError)
+
+	serviceOrder, err := serviceOrderRepositoryFake.CreateServiceOrder(ctx, entities.OrdemServico{
+		Tipo: entities.REPARO, PontoID: duplicatePoint.ID})
+	require.NoError(t, err)
+
+	ticket, err := ticketRepositoryFake.CreateTicket(ctx, entities.Chamado{
+		ClienteID: duplicate.ID, PontoID: duplicatePoint.ID, Assunto: "Test 104.1"})
+	require.NoError(t, err)
+
+	plan, responseError := clientMergeServiceTest.MergeClients(ctx, dtos.ClientMergeDTO{
+		SobreviventeID: survivor.ID,
+		DuplicadosIDs:  []string{duplicate.ID},
+	})
+
+	require.Empty(t, responseError)
+	require.Equal(t, map[string]string{sameContact.ID: survivorContact.ID}, plan.ContatosSubstituidos)
+
+	contracts, err := contractRepositoryFake.FindContractsByPontoID(ctx, survivorPoint.ID)
+	require.NoError(t, err)
+	require.Len(t, contracts, 2)
+
+	for _, contractFound := range contracts {
+		require.Contains(t, []string{cancelledContract.ID, contract.ID}, contractFound.ID)
+		require.Equal(t, survivorContact.ID, contractFound.ContatoResponsavelID)
+	}
+
+	serviceOrderFound, err := serviceOrderRepositoryFake.FindServiceOrderByID(ctx, serviceOrder.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivorPoint.ID, serviceOrderFound.PontoID)
+
+	ticketFound, err := ticketRepositoryFake.FindTicketByID(ctx, ticket.ID)
+	require.NoError(t, err)
+	require.Equal(t, survivor.ID, ticketFound.ClienteID)
+	require.Equal(t, survivorPoint.ID, ticketFound.PontoID)
+
+	branchFound, responseError := clientServiceTest.FindClientByID(ctx, branch.ID)
+	require.Empty(t, responseError)
+	require.Equal(t, survivor.ID, branchFound.MatrizID)
+}
+
+// TestMergeClientsWithContractConflict testa se não é possivel mesclar clientes com contratos no mesmo endereço,
+// e se a prévia lista o conflito sem alterar os clientes.
+func TestMergeClientsWithContractConflict(t *testing.T) {
+	survivor, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 101.0", Tipo: entities.FISICO})
+	duplicate, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 101.1", Tipo: entities.FISICO})
+
+	address := createMergeAddress(t, 93)
+	_, _ = createMergePoint(t, survivor.ID, address.ID, true)
+	duplicatePoint, _ := createMergePoint(t, duplicate.ID, address.ID, true)
+
+	clientMergeDTO := dtos.ClientMergeDTO{
+		SobreviventeID: survivor.ID,
+		DuplicadosIDs:  []string{duplicate.ID},
+		Previa:         true,
+	}
+	plan, responseError := clientMergeServiceTest.MergeClients(ctx, clientMergeDTO)
+
+	require.Empty(t, responseError)
+	require.True(t, plan.Previa)
+	require.Empty(t, plan.MesclagemID)
+	require.Len(t, plan.Conflitos, 1)
+
+	clientMergeDTO.Previa = false
+	plan, responseError = clientMergeServiceTest.MergeClients(ctx, clientMergeDTO)
+
+	require.Contains(t, responseError.Message, utils.ClientMergeConflict)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, plan)
+
+	pointFound, _ := pointServiceTest.FindPointByID(ctx, duplicatePoint.ID)
+	require.Equal(t, duplicate.ID, pointFound.ClienteID)
+
+	_, responseError = clientServiceTest.FindClientByID(ctx, duplicate.ID)
+	require.Empty(t, responseError)
+}
+
+// TestMergeClientsPreview testa se a prévia da mesclagem retorna as alterações sem aplica-las.
+func TestMergeClientsPreview(t *testing.T) {
+	survivor, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 102.0", Tipo: entities.FISICO})
+	duplicate, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 102.1", Tipo: entities.FISICO})
+
+	address := createMergeAddress(t, 94)
+	point, _ := createMergePoint(t, duplicate.ID, address.ID, false)
+
+	plan, responseError := clientMergeServiceTest.MergeClients(ctx, dtos.ClientMergeDTO{
+		SobreviventeID: survivor.ID,
+		DuplicadosIDs:  []string{duplicate.ID},
+		Previa:         true,
+	})
+
+	require.Empty(t, responseError)
+	require.Len(t, plan.PontosTransferidos, 1)
+
+	pointFound, _ := pointServiceTest.FindPointByID(ctx, point.ID)
+	require.Equal(t, duplicate.ID, pointFound.ClienteID)
+
+	clientMerges, _ := clientMergeServiceTest.FindClientMergesByClientID(ctx, survivor.ID)
+	require.Empty(t, clientMerges)
+}
+
+// TestMergeClientsWithInvalidClients testa se não é possivel mesclar o cliente com ele mesmo ou com um cliente inexistente.
+func TestMergeClientsWithInvalidClients(t *testing.T) {
+	survivor, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 103.0", Tipo: entities.FISICO})
+
+	plan, responseError := clientMergeServiceTest.MergeClients(ctx, dtos.ClientMergeDTO{
+		SobreviventeID: survivor.ID,
+		DuplicadosIDs:  []string{survivor.ID},
+	})
+
+	require.Equal(t, utils.InvalidClientMerge, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, plan)
+
+	plan, responseError = clientMergeServiceTest.MergeClients(ctx, dtos.ClientMergeDTO{
+		SobreviventeID: survivor.ID,
+		DuplicadosIDs:  []string{"Test 103.1"},
+	})
+
+	require.Equal(t, utils.ClientNotFound+": Test 103.1", responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, plan)
+}
@@ -1,33 +1,50 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"strings"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
 	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
 	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/mashingan/smapping"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service")
+
 // ClientService representa a interface de clientService.
 type ClientService interface {
-	CreateClient(clientDTO dtos.ClientCreateDTO) (entities.Cliente, utils.ResponseError)
-	UpdateClient(clientDTO dtos.ClientUpdateDTO) (entities.Cliente, utils.ResponseError)
-	FindClientByID(clientID string) entities.Cliente
-	FindClientByName(name string) entities.Cliente
-	DeleteClientByID(clientID string) utils.ResponseError
-	FindClients(clientName string, clientType entities.ClientType) []entities.Cliente
+	CreateClient(ctx context.Context, clientDTO dtos.ClientCreateDTO) (entities.Cliente, utils.ResponseError)
+	UpdateClient(ctx context.Context, clientDTO dtos.ClientUpdateDTO) (entities.Cliente, utils.ResponseError)
+	FindClientByID(ctx context.Context, clientID string) (entities.Cliente, utils.ResponseError)
+	FindClientDetail(ctx context.Context, clientID string) (entities.Cliente, []entities.Chamado, utils.ResponseError)
+	FindClientByName(ctx context.Context, name string) (entities.Cliente, utils.ResponseError)
+	DeleteClientByID(ctx context.Context, clientID string) utils.ResponseError
+	FindClients(ctx context.Context, clientName string, clientType entities.ClientType, document string) ([]entities.Cliente, utils.ResponseError)
+	FindBranches(ctx context.Context, clientID string) ([]entities.Cliente, utils.ResponseError)
 }
 
 type clientService struct {
 	clientRepository repositories.ClientRepository
+	ticketRepository repositories.TicketRepository
 	pointService     services.PointService
+	contactService   contactService.ContactService
+	logger           *slog.Logger
 }
 
-func (service *clientService) CreateClient(clientDTO dtos.ClientCreateDTO) (entities.Cliente, utils.ResponseError) {
+func (service *clientService) CreateClient(ctx context.Context, clientDTO dtos.ClientCreateDTO) (entities.Cliente, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientService.CreateClient")
+	defer span.End()
+
 	client := entities.Cliente{}
 
 	err := smapping.FillStruct(&client, smapping.MapFields(&clientDTO))
@@ -36,33 +53,69 @@ func (service *clientService) CreateClient(clientDTO dtos.ClientCreateDTO) (enti
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	clientAlreadyExists := service.clientRepository.FindClientByName(clientDTO.Nome)
+	client.Documento = dtos.NormalizeDocument(client.Documento)
+
+	if client.MatrizID != "" {
+		responseError := service.validateParent(ctx, client.ID, client.Tipo, client.MatrizID)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Cliente{}, responseError
+		}
+	}
+
+	// Clientes sem documento não possuem restrição de unicidade.
+	clientAlreadyExists, err := entities.Cliente{}, repositories.ErrNotFound
+
+	if client.Documento != "" {
+		if !dtos.IsValidDocument(client.Tipo, client.Documento) {
+			return entities.Cliente{}, utils.NewResponseError("documento: "+utils.InvalidDocument, http.StatusBadRequest)
+		}
+
+		clientAlreadyExists, err = service.clientRepository.FindClientByDocument(ctx, client.Documento)
+		if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+			return entities.Cliente{}, utils.NewInternalResponseError(err)
+		}
+	}
 
 	switch {
 	case clientAlreadyExists.DataRemocao.Valid:
 		client.ID = clientAlreadyExists.ID
 
-		client, err := service.clientRepository.UpdateClient(client)
+		// O cliente restaurado pode possuir filiais, entao a hierarquia e verificada novamente.
+		if client.MatrizID != "" {
+			responseError := service.validateParent(ctx, client.ID, client.Tipo, client.MatrizID)
+			if responseError != (utils.ResponseError{}) {
+				return entities.Cliente{}, responseError
+			}
+		}
+
+		client, err := service.clientRepository.UpdateClient(ctx, client)
 		if err != nil {
-			return entities.Cliente{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return entities.Cliente{}, utils.NewInternalResponseError(err)
 		}
 
+		service.logger.InfoContext(ctx, "client restored", slog.String("cliente_id", client.ID))
+
 		return client, utils.ResponseError{}
 
-	case (clientAlreadyExists != entities.Cliente{}):
-		return entities.Cliente{}, utils.NewResponseError(utils.NameAlreadyExists, http.StatusConflict)
+	case err == nil:
+		return entities.Cliente{}, utils.NewResponseError(utils.DocumentAlreadyExists, http.StatusConflict)
 
 	default:
-		client, err := service.clientRepository.CreateClient(client)
+		client, err := service.clientRepository.CreateClient(ctx, client)
 		if err != nil {
-			return entities.Cliente{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return entities.Cliente{}, utils.NewInternalResponseError(err)
 		}
 
+		service.logger.InfoContext(ctx, "client created", slog.String("cliente_id", client.ID))
+
 		return client, utils.ResponseError{}
 	}
 }
 
-func (service *clientService) UpdateClient(clientDTO dtos.ClientUpdateDTO) (entities.Cliente, utils.ResponseError) {
+func (service *clientService) UpdateClient(ctx context.Context, clientDTO dtos.ClientUpdateDTO) (entities.Cliente, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientService.UpdateClient")
+	defer span.End()
+
 	client := entities.Cliente{}
 
 	err := smapping.FillStruct(&client, smapping.MapFields(&clientDTO))
@@ -71,10 +124,9 @@ func (service *clientService) UpdateClient(clientDTO dtos.ClientUpdateDTO) (enti
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	clientFound := service.clientRepository.FindClientByID(client.ID)
-
-	if clientFound == (entities.Cliente{}) {
-		return entities.Cliente{}, utils.NewResponseError(utils.ClientNotFound, http.StatusNotFound)
+	clientFound, responseError := service.FindClientByID(ctx, client.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Cliente{}, responseError
 	}
 
 	if client.Nome == "" {
@@ -93,57 +145,242 @@ func (service *clientService) UpdateClient(clientDTO dtos.ClientUpdateDTO) (enti
 		}
 	}
 
-	clientAlreadyExists := service.clientRepository.FindClientByName(client.Nome)
+	if client.Documento == "" {
+		client.Documento = clientFound.Documento
+	} else {
+		client.Documento = dtos.NormalizeDocument(client.Documento)
+	}
 
-	if (clientAlreadyExists != entities.Cliente{}) && (clientFound.ID != clientAlreadyExists.ID) {
-		return entities.Cliente{}, utils.NewResponseError(utils.NameAlreadyExists, http.StatusConflict)
+	if client.Documento != "" {
+		if !dtos.IsValidDocument(client.Tipo, client.Documento) {
+			return entities.Cliente{}, utils.NewResponseError("documento: "+utils.InvalidDocument, http.StatusBadRequest)
+		}
+
+		clientAlreadyExists, err := service.clientRepository.FindClientByDocument(ctx, client.Documento)
+		if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+			return entities.Cliente{}, utils.NewInternalResponseError(err)
+		}
+
+		if err == nil && clientFound.ID != clientAlreadyExists.ID {
+			return entities.Cliente{}, utils.NewResponseError(utils.DocumentAlreadyExists, http.StatusConflict)
+		}
 	}
 
+	if clientDTO.MatrizID == nil {
+		client.MatrizID = clientFound.MatrizID
+	} else {
+		client.MatrizID = strings.TrimSpace(*clientDTO.MatrizID)
+	}
+
+	if client.MatrizID != "" {
+		responseError := service.validateParent(ctx, client.ID, client.Tipo, client.MatrizID)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Cliente{}, responseError
+		}
+	}
+
+	// Apenas clientes juridicos podem ser matriz de outros clientes.
+	if client.Tipo != entities.JURIDICO {
+		branches, err := service.clientRepository.FindClientsByParentID(ctx, client.ID)
+		if err != nil {
+			return entities.Cliente{}, utils.NewInternalResponseError(err)
+		}
+
+		if len(branches) != 0 {
+			return entities.Cliente{}, utils.NewResponseError("tipo: "+utils.InvalidParentClient, http.StatusBadRequest)
+		}
+	}
+
+	client.CanalPreferido = clientFound.CanalPreferido
 	client.DataRemocao.Scan(nil)
-	client, err = service.clientRepository.UpdateClient(client)
+	client, err = service.clientRepository.UpdateClient(ctx, client)
 	if err != nil {
-		return entities.Cliente{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return entities.Cliente{}, utils.NewInternalResponseError(err)
 	}
 
+	service.logger.InfoContext(ctx, "client updated", slog.String("cliente_id", client.ID))
+
 	return client, utils.ResponseError{}
 }
 
-func (service *clientService) FindClientByID(clientID string) entities.Cliente {
-	return service.clientRepository.FindClientByID(clientID)
+func (service *clientService) FindClientByID(ctx context.Context, clientID string) (entities.Cliente, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientService.FindClientByID")
+	defer span.End()
+
+	client, err := service.clientRepository.FindClientByID(ctx, clientID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Cliente{}, utils.NewResponseError(utils.ClientNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Cliente{}, utils.NewInternalResponseError(err)
+	}
+
+	return client, utils.ResponseError{}
 }
 
-func (service *clientService) FindClientByName(name string) entities.Cliente {
-	return service.clientRepository.FindClientByName(name)
+// FindClientDetail busca o cliente pelo id com os chamados ainda não resolvidos, usado na visualização do cliente.
+func (service *clientService) FindClientDetail(ctx context.Context, clientID string) (entities.Cliente, []entities.Chamado, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientService.FindClientDetail")
+	defer span.End()
+
+	client, responseError := service.FindClientByID(ctx, clientID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Cliente{}, nil, responseError
+	}
+
+	openTickets, err := service.ticketRepository.FindTickets(ctx, dtos.TicketFilter{ClienteID: client.ID, Abertos: true})
+	if err != nil {
+		return entities.Cliente{}, nil, utils.NewInternalResponseError(err)
+	}
+
+	return client, openTickets, utils.ResponseError{}
 }
 
-func (service *clientService) DeleteClientByID(clientID string) utils.ResponseError {
-	clientFound := service.clientRepository.FindClientByID(clientID)
+func (service *clientService) FindClientByName(ctx context.Context, name string) (entities.Cliente, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientService.FindClientByName")
+	defer span.End()
 
-	if clientFound == (entities.Cliente{}) {
-		return utils.NewResponseError(utils.ClientNotFound, http.StatusNotFound)
+	client, err := service.clientRepository.FindClientByName(ctx, name)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Cliente{}, utils.NewResponseError(utils.ClientNotFound, http.StatusNotFound)
 	}
 
-	err := service.clientRepository.DeleteClient(clientFound)
 	if err != nil {
-		return utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return entities.Cliente{}, utils.NewInternalResponseError(err)
 	}
 
-	responseError := service.pointService.DeletePointsByClientID(clientID)
+	return client, utils.ResponseError{}
+}
+
+func (service *clientService) DeleteClientByID(ctx context.Context, clientID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "ClientService.DeleteClientByID")
+	defer span.End()
+
+	clientFound, responseError := service.FindClientByID(ctx, clientID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
+	}
+
+	err := service.clientRepository.DeleteClient(ctx, clientFound)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	responseError = service.pointService.DeletePointsByClientID(ctx, clientID)
 	if len(responseError.Message) != 0 {
 		return utils.NewResponseError(responseError.Message, responseError.StatusCode)
 	}
 
+	responseError = service.contactService.DeleteContactsByClientID(ctx, clientID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
+	}
+
+	service.logger.InfoContext(ctx, "client deleted", slog.String("cliente_id", clientID))
+
 	return utils.ResponseError{}
 }
 
-func (service *clientService) FindClients(clientName string, clientType entities.ClientType) []entities.Cliente {
-	return service.clientRepository.FindClients(clientName, clientType)
+func (service *clientService) FindClients(ctx context.Context, clientName string, clientType entities.ClientType, document string) ([]entities.Cliente, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientService.FindClients")
+	defer span.End()
+
+	clients, err := service.clientRepository.FindClients(ctx, clientName, clientType, dtos.NormalizeDocument(document))
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return clients, utils.ResponseError{}
+}
+
+// FindBranches retorna todas as filiais do cliente, diretas e indiretas, a partir das mais proximas.
+func (service *clientService) FindBranches(ctx context.Context, clientID string) ([]entities.Cliente, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ClientService.FindBranches")
+	defer span.End()
+
+	_, responseError := service.FindClientByID(ctx, clientID)
+	if responseError != (utils.ResponseError{}) {
+		return nil, responseError
+	}
+
+	branches := []entities.Cliente{}
+	visited := map[string]bool{clientID: true}
+	queue := []string{clientID}
+
+	for len(queue) != 0 {
+		children, err := service.clientRepository.FindClientsByParentID(ctx, queue[0])
+		if err != nil {
+			return nil, utils.NewInternalResponseError(err)
+		}
+
+		queue = queue[1:]
+
+		for _, child := range children {
+			if visited[child.ID] {
+				continue
+			}
+
+			visited[child.ID] = true
+			branches = append(branches, child)
+			queue = append(queue, child.ID)
+		}
+	}
+
+	return branches, utils.ResponseError{}
+}
+
+// validateParent verifica se a matriz existe, se ambos os clientes sao juridicos
+// e se a nova matriz nao e o proprio cliente ou uma de suas filiais.
+func (service *clientService) validateParent(ctx context.Context, clientID string, clientType entities.ClientType, parentID string) utils.ResponseError {
+	if clientType != entities.JURIDICO {
+		return utils.NewResponseError("matriz_id: "+utils.InvalidParentClient, http.StatusBadRequest)
+	}
+
+	parent, err := service.clientRepository.FindClientByID(ctx, parentID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return utils.NewResponseError("matriz_id: "+utils.ClientNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	if parent.Tipo != entities.JURIDICO {
+		return utils.NewResponseError("matriz_id: "+utils.InvalidParentClient, http.StatusBadRequest)
+	}
+
+	visited := map[string]bool{}
+
+	for ancestor := parent; ; {
+		if ancestor.ID == clientID {
+			return utils.NewResponseError("matriz_id: "+utils.ClientHierarchyCycle, http.StatusBadRequest)
+		}
+
+		if ancestor.MatrizID == "" || visited[ancestor.ID] {
+			return utils.ResponseError{}
+		}
+
+		visited[ancestor.ID] = true
+
+		ancestor, err = service.clientRepository.FindClientByID(ctx, ancestor.MatrizID)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return utils.ResponseError{}
+		}
+
+		if err != nil {
+			return utils.NewInternalResponseError(err)
+		}
+	}
 }
 
 // NewClientService cria uma nova instancia de ClientService.
-func NewClientService(clientRepository repositories.ClientRepository, pointService services.PointService) ClientService {
+func NewClientService(clientRepository repositories.ClientRepository, ticketRepository repositories.TicketRepository, pointService services.PointService, contactService contactService.ContactService, logger *slog.Logger) ClientService {
 	return &clientService{
 		clientRepository: clientRepository,
+		ticketRepository: ticketRepository,
 		pointService:     pointService,
+		contactService:   contactService,
+		logger:           logger,
 	}
 }
@@ -1,41 +1,70 @@
 package services_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
 	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
 	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
 	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
 	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/stretchr/testify/require"
 )
 
 var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
 	// Fake Databases
-	dbClient        = repositoriesFake.DBClient
-	dbAddress       = repositoriesFake.DBAddress
-	dbPoint         = repositoriesFake.DBPoint
-	dbContract      = repositoriesFake.DBContract
-	dbContractEvent = repositoriesFake.DBContractEvent
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
 
 	// Fake Repositories
-	clientRepositoryFake        = repositoriesFake.NewClientRepositoryFake(dbClient)
-	addressRepositoryFake       = repositoriesFake.NewAddressRepositoryFake(dbAddress)
-	pointRepositoryFake         = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress)
-	contractRepositoryFake      = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint)
-	contractEventRepositoryFake = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.Default()
 
 	// Services Tests
-	contractEventServiceTest = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake)
-	contractServiceTest      = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, contractEventServiceTest)
-	pointServiceTest         = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest)
-	clientServiceTest        = clientService.NewClientService(clientRepositoryFake, pointServiceTest)
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
 )
 
 // TestCreateClient testa se é possivel criar um novo cliente.
@@ -45,7 +74,7 @@ func TestCreateClient(t *testing.T) {
 		Tipo: entities.FISICO,
 	}
 
-	client, responseError := clientServiceTest.CreateClient(clientDTO)
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	require.Empty(t, responseError)
 
@@ -54,39 +83,40 @@ func TestCreateClient(t *testing.T) {
 	require.False(t, client.DataRemocao.Valid)
 }
 
-// TestCreateClient testa se não é possivel criar um novo cliente com um nome ja existente.
+// TestCreateClientWithNameExistent testa se é possivel criar dois clientes com o mesmo nome.
 func TestCreateClientWithNameExistent(t *testing.T) {
 	clientDTO := dtos.ClientCreateDTO{
 		Nome: "Test 2.0",
 		Tipo: entities.JURIDICO,
 	}
 
-	clientServiceTest.CreateClient(clientDTO)
-	client, responseError := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+	client2, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	require.NotEmpty(t, responseError)
-	require.Equal(t, utils.NameAlreadyExists, responseError.Message)
-	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, responseError)
 
-	require.Empty(t, client)
+	require.NotEmpty(t, client2)
+	require.NotEqual(t, client.ID, client2.ID)
+	require.Equal(t, client.Nome, client2.Nome)
 }
 
-// TestCreateClient testa se é possivel atualizar um cliente de removido para ativo.
+// TestCreateClientWithDeletedAtValid testa se é possivel atualizar um cliente de removido para ativo a partir do documento.
 func TestCreateClientWithDeletedAtValid(t *testing.T) {
 	clientDTO := dtos.ClientCreateDTO{
-		Nome: "Test 3.0",
-		Tipo: entities.ESPECIAL,
+		Nome:      "Test 3.0",
+		Tipo:      entities.ESPECIAL,
+		Documento: "935.411.347-80",
 	}
 
-	client, _ := clientServiceTest.CreateClient(clientDTO)
-	clientServiceTest.DeleteClientByID(client.ID)
-	client, responseError := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+	clientServiceTest.DeleteClientByID(ctx, client.ID)
+	clientRestored, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	require.Empty(t, responseError)
 
-	require.NotEmpty(t, client)
-	require.NotEqual(t, "", client.ID)
-	require.False(t, client.DataRemocao.Valid)
+	require.NotEmpty(t, clientRestored)
+	require.Equal(t, client.ID, clientRestored.ID)
+	require.False(t, clientRestored.DataRemocao.Valid)
 }
 
 // TestUpdateClient testa se é possivel atualizar um cliente existente.
@@ -95,7 +125,7 @@ func TestUpdateClient(t *testing.T) {
 		Nome: "Test 4.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	newName := "Test 4.1"
 	newType := entities.FISICO
@@ -106,7 +136,7 @@ func TestUpdateClient(t *testing.T) {
 		Nome: newName,
 		Tipo: newType,
 	}
-	clientUpdated, responseError := clientServiceTest.UpdateClient(clientUpdateDTO)
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -123,7 +153,7 @@ func TestUpdateClientWithoutName(t *testing.T) {
 		Nome: "Test 5.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	newType := entities.JURIDICO
 	clientUpdateDTO := dtos.ClientUpdateDTO{
@@ -132,7 +162,7 @@ func TestUpdateClientWithoutName(t *testing.T) {
 		},
 		Tipo: newType,
 	}
-	clientUpdated, responseError := clientServiceTest.UpdateClient(clientUpdateDTO)
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -143,31 +173,31 @@ func TestUpdateClientWithoutName(t *testing.T) {
 	require.False(t, clientUpdated.DataRemocao.Valid)
 }
 
-// TestUpdateClientWithNameExistent testa se não é possivel atualizar um cliente com um nome ja existente.
-func TestUpdateClientWithNameExistent(t *testing.T) {
+// TestUpdateClientWithDocumentExistent testa se não é possivel atualizar um cliente com um documento ja existente.
+func TestUpdateClientWithDocumentExistent(t *testing.T) {
 	clientDTO := dtos.ClientCreateDTO{
 		Nome: "Test 6.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	clientDTO2 := dtos.ClientCreateDTO{
-		Nome: "Test 7.0",
-		Tipo: entities.JURIDICO,
+		Nome:      "Test 7.0",
+		Tipo:      entities.FISICO,
+		Documento: "111.444.777-35",
 	}
-	client2, _ := clientServiceTest.CreateClient(clientDTO2)
+	client2, _ := clientServiceTest.CreateClient(ctx, clientDTO2)
 
 	clientUpdateDTO := dtos.ClientUpdateDTO{
 		Base: dtos.Base{
 			ID: client.ID,
 		},
-		Nome: client2.Nome,
-		Tipo: client2.Tipo,
+		Documento: client2.Documento,
 	}
-	clientUpdated, responseError := clientServiceTest.UpdateClient(clientUpdateDTO)
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
 
 	require.NotEmpty(t, responseError)
-	require.Equal(t, utils.NameAlreadyExists, responseError.Message)
+	require.Equal(t, utils.DocumentAlreadyExists, responseError.Message)
 	require.Equal(t, http.StatusConflict, responseError.StatusCode)
 
 	require.Empty(t, clientUpdated)
@@ -179,7 +209,7 @@ func TestUpdateClientWithInvalidName(t *testing.T) {
 		Nome: "Test 8.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	clientUpdateDTO := dtos.ClientUpdateDTO{
 		Base: dtos.Base{
@@ -188,7 +218,7 @@ func TestUpdateClientWithInvalidName(t *testing.T) {
 		Nome: "Te",
 		Tipo: client.Tipo,
 	}
-	clientUpdated, responseError := clientServiceTest.UpdateClient(clientUpdateDTO)
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, "nome: "+utils.InvalidNumberOfCaracter, responseError.Message)
@@ -203,7 +233,7 @@ func TestUpdateClientWithoutType(t *testing.T) {
 		Nome: "Test 9.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	newName := "Test 9.1"
 	clientUpdateDTO := dtos.ClientUpdateDTO{
@@ -212,7 +242,7 @@ func TestUpdateClientWithoutType(t *testing.T) {
 		},
 		Nome: newName,
 	}
-	clientUpdated, responseError := clientServiceTest.UpdateClient(clientUpdateDTO)
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -229,7 +259,7 @@ func TestUpdateClientWithInvalidType(t *testing.T) {
 		Nome: "Test 10.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	clientUpdateDTO := dtos.ClientUpdateDTO{
 		Base: dtos.Base{
@@ -238,7 +268,7 @@ func TestUpdateClientWithInvalidType(t *testing.T) {
 		Nome: client.Nome,
 		Tipo: "newType",
 	}
-	clientUpdated, responseError := clientServiceTest.UpdateClient(clientUpdateDTO)
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, "tipo: "+utils.InvalidClientType, responseError.Message)
@@ -253,7 +283,7 @@ func TestUpdateClientWithInvalidID(t *testing.T) {
 		Nome: "Test 11.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	clientUpdateDTO := dtos.ClientUpdateDTO{
 		Base: dtos.Base{
@@ -262,7 +292,7 @@ func TestUpdateClientWithInvalidID(t *testing.T) {
 		Nome: "Test 11.1",
 		Tipo: client.Tipo,
 	}
-	clientUpdated, responseError := clientServiceTest.UpdateClient(clientUpdateDTO)
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ClientNotFound, responseError.Message)
@@ -277,11 +307,12 @@ func TestFindClientByID(t *testing.T) {
 		Nome: "Test 13.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clientFound := clientServiceTest.FindClientByID(client.ID)
+	clientFound, responseError := clientServiceTest.FindClientByID(ctx, client.ID)
 
 	require.NotEmpty(t, clientFound)
+	require.Empty(t, responseError)
 	require.Equal(t, client, clientFound)
 }
 
@@ -291,11 +322,12 @@ func TestFindClientByIDWithInvalidID(t *testing.T) {
 		Nome: "Test 14.0",
 		Tipo: entities.JURIDICO,
 	}
-	clientServiceTest.CreateClient(clientDTO)
+	clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clientFound := clientServiceTest.FindClientByID("")
+	clientFound, responseError := clientServiceTest.FindClientByID(ctx, "")
 
 	require.Empty(t, clientFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindClientByIDWithDeletedAtValid testa se não é possivel buscar um cliente removido a partir do ID.
@@ -304,12 +336,13 @@ func TestFindClientByIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 15.0",
 		Tipo: entities.JURIDICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
-	clientServiceTest.DeleteClientByID(client.ID)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+	clientServiceTest.DeleteClientByID(ctx, client.ID)
 
-	clientFound := clientServiceTest.FindClientByID(client.ID)
+	clientFound, responseError := clientServiceTest.FindClientByID(ctx, client.ID)
 
 	require.Empty(t, clientFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindClientByName testa se é possivel buscar um cliente a partir do nome.
@@ -318,11 +351,12 @@ func TestFindClientByName(t *testing.T) {
 		Nome: "Test 16.0",
 		Tipo: entities.JURIDICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clientFound := clientServiceTest.FindClientByName(client.Nome)
+	clientFound, responseError := clientServiceTest.FindClientByName(ctx, client.Nome)
 
 	require.NotEmpty(t, clientFound)
+	require.Empty(t, responseError)
 	require.Equal(t, client, clientFound)
 }
 
@@ -332,11 +366,12 @@ func TestFindClientByNameWithInvalidName(t *testing.T) {
 		Nome: "Test 16.0",
 		Tipo: entities.ESPECIAL,
 	}
-	clientServiceTest.CreateClient(clientDTO)
+	clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clientFound := clientServiceTest.FindClientByName("")
+	clientFound, responseError := clientServiceTest.FindClientByName(ctx, "")
 
 	require.Empty(t, clientFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindClientByNameWithDeletedAtValid testa se é possivel buscar um cliente removido a partir do nome.
@@ -345,14 +380,15 @@ func TestFindClientByNameWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 18.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
-	clientServiceTest.DeleteClientByID(client.ID)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+	clientServiceTest.DeleteClientByID(ctx, client.ID)
 
-	clientFound := clientServiceTest.FindClientByName(client.Nome)
+	clientFound, responseError := clientServiceTest.FindClientByName(ctx, client.Nome)
 
 	client.DataRemocao.Scan(clientFound.DataRemocao.Time)
 
 	require.NotEmpty(t, clientFound)
+	require.Empty(t, responseError)
 	require.Equal(t, client, clientFound)
 }
 
@@ -362,11 +398,11 @@ func TestDeleteClientByID(t *testing.T) {
 		Nome: "Test 19.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	responseError := clientServiceTest.DeleteClientByID(client.ID)
+	responseError := clientServiceTest.DeleteClientByID(ctx, client.ID)
 
-	clientFound := clientServiceTest.FindClientByID(client.ID)
+	clientFound, _ := clientServiceTest.FindClientByID(ctx, client.ID)
 
 	require.Empty(t, responseError)
 	require.Empty(t, clientFound)
@@ -378,9 +414,9 @@ func TestDeleteClientByIDWithInvalidID(t *testing.T) {
 		Nome: "Test 20.0",
 		Tipo: entities.ESPECIAL,
 	}
-	clientServiceTest.CreateClient(clientDTO)
+	clientServiceTest.CreateClient(ctx, clientDTO)
 
-	responseError := clientServiceTest.DeleteClientByID("")
+	responseError := clientServiceTest.DeleteClientByID(ctx, "")
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ClientNotFound, responseError.Message)
@@ -393,10 +429,10 @@ func TestDeleteClientByIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 21.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clientServiceTest.DeleteClientByID(client.ID)
-	responseError := clientServiceTest.DeleteClientByID(client.ID)
+	clientServiceTest.DeleteClientByID(ctx, client.ID)
+	responseError := clientServiceTest.DeleteClientByID(ctx, client.ID)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ClientNotFound, responseError.Message)
@@ -409,11 +445,12 @@ func TestFindClientsByNameAndType(t *testing.T) {
 		Nome: "Test 22.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clients := clientServiceTest.FindClients(client.Nome, client.Tipo)
+	clients, responseError := clientServiceTest.FindClients(ctx, client.Nome, client.Tipo, "")
 
 	require.NotEmpty(t, clients)
+	require.Empty(t, responseError)
 	require.Greater(t, len(clients), 0)
 }
 
@@ -423,11 +460,12 @@ func TestFindClientsByName(t *testing.T) {
 		Nome: "Test 23.0",
 		Tipo: entities.JURIDICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clients := clientServiceTest.FindClients(client.Nome, "")
+	clients, responseError := clientServiceTest.FindClients(ctx, client.Nome, "", "")
 
 	require.NotEmpty(t, clients)
+	require.Empty(t, responseError)
 	require.Greater(t, len(clients), 0)
 }
 
@@ -437,11 +475,12 @@ func TestFindClientsByType(t *testing.T) {
 		Nome: "Test 24.0",
 		Tipo: entities.JURIDICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clients := clientServiceTest.FindClients("", client.Tipo)
+	clients, responseError := clientServiceTest.FindClients(ctx, "", client.Tipo, "")
 
 	require.NotEmpty(t, clients)
+	require.Empty(t, responseError)
 	require.Greater(t, len(clients), 0)
 }
 
@@ -451,11 +490,12 @@ func TestFindClients(t *testing.T) {
 		Nome: "Test 25.0",
 		Tipo: entities.ESPECIAL,
 	}
-	clientServiceTest.CreateClient(clientDTO)
+	clientServiceTest.CreateClient(ctx, clientDTO)
 
-	clients := clientServiceTest.FindClients("", "")
+	clients, responseError := clientServiceTest.FindClients(ctx, "", "", "")
 
 	require.NotEmpty(t, clients)
+	require.Empty(t, responseError)
 	require.Greater(t, len(clients), 0)
 }
 
@@ -465,14 +505,297 @@ func TestFindClientsWithDeteletAtValid(t *testing.T) {
 		Nome: "Test 26.0",
 		Tipo: entities.ESPECIAL,
 	}
-	clientServiceTest.CreateClient(clientDTO)
+	clientServiceTest.CreateClient(ctx, clientDTO)
 
 	for i := range *dbClient {
 		(*dbClient)[i].DataRemocao.Scan(time.Now())
 	}
 
-	clients := clientServiceTest.FindClients("", "")
+	clients, responseError := clientServiceTest.FindClients(ctx, "", "", "")
 
 	require.Empty(t, clients)
+	require.Empty(t, responseError)
 	require.Equal(t, len(clients), 0)
 }
+
+// TestFindClientByIDWithDeadlineExceeded testa se a busca de um cliente retorna 504 quando o prazo da requisição expirou.
+func TestFindClientByIDWithDeadlineExceeded(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 76.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	expiredCtx, cancel := context.WithDeadline(ctx, time.Now())
+	defer cancel()
+
+	clientFound, responseError := clientServiceTest.FindClientByID(expiredCtx, client.ID)
+
+	require.Empty(t, clientFound)
+	require.Equal(t, http.StatusGatewayTimeout, responseError.StatusCode)
+	require.Equal(t, utils.RequestTimeout, responseError.Message)
+}
+
+// TestCreateClientWithDocument testa se é possivel criar um cliente com documento, que é salvo sem formatação.
+func TestCreateClientWithDocument(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      "Test 77.0",
+		Tipo:      entities.FISICO,
+		Documento: "529.982.247-25",
+	}
+
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	require.Empty(t, responseError)
+
+	require.NotEmpty(t, client)
+	require.Equal(t, "52998224725", client.Documento)
+}
+
+// TestCreateClientWithAlphanumericCNPJ testa se é possivel criar um cliente juridico com o CNPJ alfanumerico.
+func TestCreateClientWithAlphanumericCNPJ(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      "Test 78.0",
+		Tipo:      entities.JURIDICO,
+		Documento: "12.abc.345/01de-35",
+	}
+
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	require.Empty(t, responseError)
+
+	require.NotEmpty(t, client)
+	require.Equal(t, "12ABC34501DE35", client.Documento)
+}
+
+// TestCreateClientWithDocumentExistent testa se não é possivel criar um cliente com um documento ja existente.
+func TestCreateClientWithDocumentExistent(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      "Test 79.0",
+		Tipo:      entities.JURIDICO,
+		Documento: "11.222.333/0001-81",
+	}
+	clientServiceTest.CreateClient(ctx, clientDTO)
+
+	clientDTO.Nome = "Test 79.1"
+	clientDTO.Documento = "11222333000181"
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	require.NotEmpty(t, responseError)
+	require.Equal(t, utils.DocumentAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+
+	require.Empty(t, client)
+}
+
+// TestCreateClientWithInvalidDocument testa se não é possivel criar um cliente com digitos verificadores invalidos.
+func TestCreateClientWithInvalidDocument(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      "Test 80.0",
+		Tipo:      entities.FISICO,
+		Documento: "529.982.247-26",
+	}
+
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	require.NotEmpty(t, responseError)
+	require.Equal(t, "documento: "+utils.InvalidDocument, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+
+	require.Empty(t, client)
+}
+
+// TestCreateClientWithDocumentOfOtherType testa se não é possivel criar um cliente fisico com CNPJ.
+func TestCreateClientWithDocumentOfOtherType(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      "Test 81.0",
+		Tipo:      entities.FISICO,
+		Documento: "11.222.333/0001-81",
+	}
+
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	require.NotEmpty(t, responseError)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+
+	require.Empty(t, client)
+}
+
+// TestUpdateClientTypeWithInvalidDocument testa se não é possivel mudar o tipo do cliente quando o documento atual não é valido para o novo tipo.
+func TestUpdateClientTypeWithInvalidDocument(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      "Test 82.0",
+		Tipo:      entities.FISICO,
+		Documento: "123.456.789-09",
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	clientUpdateDTO := dtos.ClientUpdateDTO{
+		Base: dtos.Base{
+			ID: client.ID,
+		},
+		Tipo: entities.JURIDICO,
+	}
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
+
+	require.NotEmpty(t, responseError)
+	require.Equal(t, "documento: "+utils.InvalidDocument, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+
+	require.Empty(t, clientUpdated)
+}
+
+// TestFindClientsByDocument testa se é possivel listar os clientes a partir do documento, com ou sem formatação.
+func TestFindClientsByDocument(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      "Test 83.0",
+		Tipo:      entities.ESPECIAL,
+		Documento: "04252011000110",
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	clients, responseError := clientServiceTest.FindClients(ctx, "", "", "04.252.011/0001-10")
+
+	require.Empty(t, responseError)
+	require.Len(t, clients, 1)
+	require.Equal(t, client.ID, clients[0].ID)
+}
+
+// TestCreateClientWithParent testa se é possivel criar uma filial de um cliente juridico.
+func TestCreateClientWithParent(t *testing.T) {
+	parentDTO := dtos.ClientCreateDTO{
+		Nome: "Test 107.0",
+		Tipo: entities.JURIDICO,
+	}
+	parent, _ := clientServiceTest.CreateClient(ctx, parentDTO)
+
+	branchDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 107.1",
+		Tipo:     entities.JURIDICO,
+		MatrizID: parent.ID,
+	}
+	branch, responseError := clientServiceTest.CreateClient(ctx, branchDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, parent.ID, branch.MatrizID)
+
+	individualDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 107.2",
+		Tipo:     entities.FISICO,
+		MatrizID: parent.ID,
+	}
+	individual, responseError := clientServiceTest.CreateClient(ctx, individualDTO)
+
+	require.Equal(t, "matriz_id: "+utils.InvalidParentClient, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, individual)
+
+	orphanDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 107.3",
+		Tipo:     entities.JURIDICO,
+		MatrizID: "6b6e2a9c-0000-0000-0000-000000000000",
+	}
+	orphan, responseError := clientServiceTest.CreateClient(ctx, orphanDTO)
+
+	require.Equal(t, "matriz_id: "+utils.ClientNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, orphan)
+}
+
+// TestUpdateClientParentWithCycle testa se não é possivel tornar uma filial, ou o proprio cliente, a sua matriz.
+func TestUpdateClientParentWithCycle(t *testing.T) {
+	parentDTO := dtos.ClientCreateDTO{
+		Nome: "Test 108.0",
+		Tipo: entities.JURIDICO,
+	}
+	parent, _ := clientServiceTest.CreateClient(ctx, parentDTO)
+
+	branchDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 108.1",
+		Tipo:     entities.JURIDICO,
+		MatrizID: parent.ID,
+	}
+	branch, _ := clientServiceTest.CreateClient(ctx, branchDTO)
+
+	subBranchDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 108.2",
+		Tipo:     entities.JURIDICO,
+		MatrizID: branch.ID,
+	}
+	subBranch, _ := clientServiceTest.CreateClient(ctx, subBranchDTO)
+
+	clientUpdateDTO := dtos.ClientUpdateDTO{
+		Base: dtos.Base{
+			ID: parent.ID,
+		},
+		MatrizID: &subBranch.ID,
+	}
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
+
+	require.Equal(t, "matriz_id: "+utils.ClientHierarchyCycle, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, clientUpdated)
+
+	clientUpdateDTO.MatrizID = &parent.ID
+	clientUpdated, responseError = clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
+
+	require.Equal(t, "matriz_id: "+utils.ClientHierarchyCycle, responseError.Message)
+	require.Empty(t, clientUpdated)
+
+	typeUpdateDTO := dtos.ClientUpdateDTO{
+		Base: dtos.Base{
+			ID: branch.ID,
+		},
+		Tipo: entities.ESPECIAL,
+	}
+	clientUpdated, responseError = clientServiceTest.UpdateClient(ctx, typeUpdateDTO)
+
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, clientUpdated)
+}
+
+// TestFindBranches testa se é possivel listar as filiais diretas e indiretas de um cliente.
+func TestFindBranches(t *testing.T) {
+	parentDTO := dtos.ClientCreateDTO{
+		Nome: "Test 109.0",
+		Tipo: entities.JURIDICO,
+	}
+	parent, _ := clientServiceTest.CreateClient(ctx, parentDTO)
+
+	branchDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 109.1",
+		Tipo:     entities.JURIDICO,
+		MatrizID: parent.ID,
+	}
+	branch, _ := clientServiceTest.CreateClient(ctx, branchDTO)
+
+	subBranchDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 109.2",
+		Tipo:     entities.JURIDICO,
+		MatrizID: branch.ID,
+	}
+	subBranch, _ := clientServiceTest.CreateClient(ctx, subBranchDTO)
+
+	branches, responseError := clientServiceTest.FindBranches(ctx, parent.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, branches, 2)
+	require.Equal(t, branch.ID, branches[0].ID)
+	require.Equal(t, subBranch.ID, branches[1].ID)
+
+	noParent := ""
+	clientUpdateDTO := dtos.ClientUpdateDTO{
+		Base: dtos.Base{
+			ID: branch.ID,
+		},
+		MatrizID: &noParent,
+	}
+	clientUpdated, responseError := clientServiceTest.UpdateClient(ctx, clientUpdateDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, "", clientUpdated.MatrizID)
+
+	branches, _ = clientServiceTest.FindBranches(ctx, parent.ID)
+
+	require.Empty(t, branches)
+}
@@ -0,0 +1,406 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service")
+
+// ContactService representa a interface de contactService.
+type ContactService interface {
+	CreateContact(ctx context.Context, contactDTO dtos.ContactCreateDTO) (entities.Contato, utils.ResponseError)
+	UpdateContact(ctx context.Context, contactDTO dtos.ContactUpdateDTO) (entities.Contato, utils.ResponseError)
+	FindContactByID(ctx context.Context, clientID string, contactID string) (entities.Contato, utils.ResponseError)
+	FindContactsByClientID(ctx context.Context, clientID string) ([]entities.Contato, utils.ResponseError)
+	DeleteContactByID(ctx context.Context, clientID string, contactID string) utils.ResponseError
+	DeleteContactsByClientID(ctx context.Context, clientID string) utils.ResponseError
+	UpdatePreferredChannel(ctx context.Context, clientID string, channelDTO dtos.PreferredChannelUpdateDTO) (entities.Cliente, utils.ResponseError)
+}
+
+type contactService struct {
+	contactRepository repositories.ContactRepository
+	clientRepository  repositories.ClientRepository
+	logger            *slog.Logger
+}
+
+func (service *contactService) CreateContact(ctx context.Context, contactDTO dtos.ContactCreateDTO) (entities.Contato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContactService.CreateContact")
+	defer span.End()
+
+	_, responseError := service.findClient(ctx, contactDTO.ClienteID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contato{}, responseError
+	}
+
+	if !dtos.IsValidContactType(contactDTO.Tipo) {
+		return entities.Contato{}, utils.NewResponseError("tipo: "+utils.InvalidContactType, http.StatusBadRequest)
+	}
+
+	value, responseError := normalizeContactValue(contactDTO.Tipo, contactDTO.Valor)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contato{}, responseError
+	}
+
+	contact := entities.Contato{
+		ClienteID: contactDTO.ClienteID,
+		Tipo:      contactDTO.Tipo,
+		Valor:     value,
+		Principal: contactDTO.Principal,
+	}
+
+	contacts, err := service.contactRepository.FindContactsByClientID(ctx, contact.ClienteID)
+	if err != nil {
+		return entities.Contato{}, utils.NewInternalResponseError(err)
+	}
+
+	// O primeiro contato de cada tipo é sempre o principal.
+	if len(contactsOfType(contacts, contact.Tipo)) == 0 {
+		contact.Principal = true
+	}
+
+	contactAlreadyExists, err := service.contactRepository.FindContactByClientIDAndValue(ctx,
+		contact.ClienteID, contact.Tipo, contact.Valor)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Contato{}, utils.NewInternalResponseError(err)
+	}
+
+	switch {
+	case contactAlreadyExists.DataRemocao.Valid:
+		contact.ID = contactAlreadyExists.ID
+
+		contact, err = service.contactRepository.UpdateContact(ctx, contact)
+		if err != nil {
+			return entities.Contato{}, utils.NewInternalResponseError(err)
+		}
+
+		service.logger.InfoContext(ctx, "contact restored", slog.String("contato_id", contact.ID))
+
+	case err == nil:
+		return entities.Contato{}, utils.NewResponseError(utils.ContactAlreadyExists, http.StatusConflict)
+
+	default:
+		contact, err = service.contactRepository.CreateContact(ctx, contact)
+		if err != nil {
+			return entities.Contato{}, utils.NewInternalResponseError(err)
+		}
+
+		service.logger.InfoContext(ctx, "contact created", slog.String("contato_id", contact.ID))
+	}
+
+	if contact.Principal {
+		responseError = service.unsetOtherPrincipals(ctx, contacts, contact)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contato{}, responseError
+		}
+	}
+
+	return contact, utils.ResponseError{}
+}
+
+func (service *contactService) UpdateContact(ctx context.Context, contactDTO dtos.ContactUpdateDTO) (entities.Contato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContactService.UpdateContact")
+	defer span.End()
+
+	contact, responseError := service.FindContactByID(ctx, contactDTO.ClienteID, contactDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contato{}, responseError
+	}
+
+	if contactDTO.Valor != "" {
+		value, responseError := normalizeContactValue(contact.Tipo, contactDTO.Valor)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contato{}, responseError
+		}
+
+		if value != contact.Valor {
+			contactAlreadyExists, err := service.contactRepository.FindContactByClientIDAndValue(ctx,
+				contact.ClienteID, contact.Tipo, value)
+			if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+				return entities.Contato{}, utils.NewInternalResponseError(err)
+			}
+
+			if err == nil && contactAlreadyExists.ID != contact.ID {
+				return entities.Contato{}, utils.NewResponseError(utils.ContactAlreadyExists, http.StatusConflict)
+			}
+
+			// Um novo valor precisa ser verificado novamente.
+			contact.Valor = value
+			contact.Verificado = false
+		}
+	}
+
+	if contactDTO.Verificado != nil {
+		contact.Verificado = *contactDTO.Verificado
+	}
+
+	wasPrincipal := contact.Principal
+	if contactDTO.Principal != nil {
+		contact.Principal = *contactDTO.Principal
+	}
+
+	contacts, err := service.contactRepository.FindContactsByClientID(ctx, contact.ClienteID)
+	if err != nil {
+		return entities.Contato{}, utils.NewInternalResponseError(err)
+	}
+
+	// O unico contato do tipo não pode deixar de ser o principal.
+	if wasPrincipal && !contact.Principal && len(contactsOfType(contacts, contact.Tipo)) == 1 {
+		contact.Principal = true
+	}
+
+	contact, err = service.contactRepository.UpdateContact(ctx, contact)
+	if err != nil {
+		return entities.Contato{}, utils.NewInternalResponseError(err)
+	}
+
+	switch {
+	case contact.Principal && !wasPrincipal:
+		responseError = service.unsetOtherPrincipals(ctx, contacts, contact)
+	case !contact.Principal && wasPrincipal:
+		responseError = service.promotePrincipal(ctx, contacts, contact)
+	}
+
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contato{}, responseError
+	}
+
+	service.logger.InfoContext(ctx, "contact updated", slog.String("contato_id", contact.ID))
+
+	return contact, utils.ResponseError{}
+}
+
+func (service *contactService) FindContactByID(ctx context.Context, clientID string, contactID string) (entities.Contato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContactService.FindContactByID")
+	defer span.End()
+
+	contact, err := service.contactRepository.FindContactByID(ctx, contactID)
+	if errors.Is(err, repositories.ErrNotFound) || (err == nil && contact.ClienteID != clientID) {
+		return entities.Contato{}, utils.NewResponseError(utils.ContactNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Contato{}, utils.NewInternalResponseError(err)
+	}
+
+	return contact, utils.ResponseError{}
+}
+
+func (service *contactService) FindContactsByClientID(ctx context.Context, clientID string) ([]entities.Contato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContactService.FindContactsByClientID")
+	defer span.End()
+
+	_, responseError := service.findClient(ctx, clientID)
+	if responseError != (utils.ResponseError{}) {
+		return nil, responseError
+	}
+
+	contacts, err := service.contactRepository.FindContactsByClientID(ctx, clientID)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return contacts, utils.ResponseError{}
+}
+
+func (service *contactService) DeleteContactByID(ctx context.Context, clientID string, contactID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "ContactService.DeleteContactByID")
+	defer span.End()
+
+	contactFound, responseError := service.FindContactByID(ctx, clientID, contactID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
+	}
+
+	err := service.contactRepository.DeleteContact(ctx, contactFound)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	contacts, err := service.contactRepository.FindContactsByClientID(ctx, clientID)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	if contactFound.Principal {
+		responseError = service.promotePrincipal(ctx, contacts, contactFound)
+		if responseError != (utils.ResponseError{}) {
+			return responseError
+		}
+	}
+
+	// Sem contatos do tipo, o canal deixa de ser o preferido do cliente.
+	if len(contactsOfType(contacts, contactFound.Tipo)) == 0 {
+		client, responseError := service.findClient(ctx, clientID)
+		if responseError != (utils.ResponseError{}) {
+			return responseError
+		}
+
+		if client.CanalPreferido == contactFound.Tipo {
+			client.CanalPreferido = ""
+
+			_, err = service.clientRepository.UpdateClient(ctx, client)
+			if err != nil {
+				return utils.NewInternalResponseError(err)
+			}
+		}
+	}
+
+	service.logger.InfoContext(ctx, "contact deleted", slog.String("contato_id", contactID))
+
+	return utils.ResponseError{}
+}
+
+func (service *contactService) DeleteContactsByClientID(ctx context.Context, clientID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "ContactService.DeleteContactsByClientID")
+	defer span.End()
+
+	contacts, err := service.contactRepository.FindContactsByClientID(ctx, clientID)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	if len(contacts) == 0 {
+		return utils.ResponseError{}
+	}
+
+	for _, contact := range contacts {
+		err = service.contactRepository.DeleteContact(ctx, contact)
+		if err != nil {
+			return utils.NewInternalResponseError(err)
+		}
+	}
+
+	service.logger.InfoContext(ctx, "contacts deleted", slog.String("cliente_id", clientID), slog.Int("total", len(contacts)))
+
+	return utils.ResponseError{}
+}
+
+func (service *contactService) UpdatePreferredChannel(ctx context.Context, clientID string, channelDTO dtos.PreferredChannelUpdateDTO) (entities.Cliente, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContactService.UpdatePreferredChannel")
+	defer span.End()
+
+	client, responseError := service.findClient(ctx, clientID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Cliente{}, responseError
+	}
+
+	if !dtos.IsValidContactType(channelDTO.CanalPreferido) {
+		return entities.Cliente{}, utils.NewResponseError("canal_preferido: "+utils.InvalidContactType, http.StatusBadRequest)
+	}
+
+	contacts, err := service.contactRepository.FindContactsByClientID(ctx, clientID)
+	if err != nil {
+		return entities.Cliente{}, utils.NewInternalResponseError(err)
+	}
+
+	if len(contactsOfType(contacts, channelDTO.CanalPreferido)) == 0 {
+		return entities.Cliente{}, utils.NewResponseError(utils.PreferredChannelNotFound, http.StatusBadRequest)
+	}
+
+	client.CanalPreferido = channelDTO.CanalPreferido
+
+	client, err = service.clientRepository.UpdateClient(ctx, client)
+	if err != nil {
+		return entities.Cliente{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "preferred channel updated", slog.String("cliente_id", client.ID),
+		slog.String("canal_preferido", string(client.CanalPreferido)))
+
+	return client, utils.ResponseError{}
+}
+
+func (service *contactService) findClient(ctx context.Context, clientID string) (entities.Cliente, utils.ResponseError) {
+	client, err := service.clientRepository.FindClientByID(ctx, clientID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Cliente{}, utils.NewResponseError(utils.ClientNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Cliente{}, utils.NewInternalResponseError(err)
+	}
+
+	return client, utils.ResponseError{}
+}
+
+// unsetOtherPrincipals garante que o contato seja o unico principal do seu tipo.
+func (service *contactService) unsetOtherPrincipals(ctx context.Context, contacts []entities.Contato, principal entities.Contato) utils.ResponseError {
+	for _, contact := range contactsOfType(contacts, principal.Tipo) {
+		if contact.ID == principal.ID || !contact.Principal {
+			continue
+		}
+
+		contact.Principal = false
+
+		_, err := service.contactRepository.UpdateContact(ctx, contact)
+		if err != nil {
+			return utils.NewInternalResponseError(err)
+		}
+	}
+
+	return utils.ResponseError{}
+}
+
+// promotePrincipal torna principal o contato mais antigo do mesmo tipo quando o principal atual deixa de ser.
+func (service *contactService) promotePrincipal(ctx context.Context, contacts []entities.Contato, previous entities.Contato) utils.ResponseError {
+	for _, contact := range contactsOfType(contacts, previous.Tipo) {
+		if contact.ID == previous.ID {
+			continue
+		}
+
+		contact.Principal = true
+
+		_, err := service.contactRepository.UpdateContact(ctx, contact)
+		if err != nil {
+			return utils.NewInternalResponseError(err)
+		}
+
+		return utils.ResponseError{}
+	}
+
+	return utils.ResponseError{}
+}
+
+func contactsOfType(contacts []entities.Contato, contactType entities.ContactType) []entities.Contato {
+	contactsFound := []entities.Contato{}
+
+	for _, contact := range contacts {
+		if contact.Tipo == contactType {
+			contactsFound = append(contactsFound, contact)
+		}
+	}
+
+	return contactsFound
+}
+
+func normalizeContactValue(contactType entities.ContactType, value string) (string, utils.ResponseError) {
+	value, ok := dtos.NormalizeContactValue(contactType, value)
+	if ok {
+		return value, utils.ResponseError{}
+	}
+
+	if contactType == entities.EMAIL {
+		return "", utils.NewResponseError("valor: "+utils.InvalidEmail, http.StatusBadRequest)
+	}
+
+	return "", utils.NewResponseError("valor: "+utils.InvalidPhone, http.StatusBadRequest)
+}
+
+// NewContactService cria uma nova instancia de ContactService.
+func NewContactService(contactRepository repositories.ContactRepository, clientRepository repositories.ClientRepository, logger *slog.Logger) ContactService {
+	return &contactService{
+		contactRepository: contactRepository,
+		clientRepository:  clientRepository,
+		logger:            logger,
+	}
+}
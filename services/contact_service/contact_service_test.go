@@ -0,0 +1,291 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+)
+
+// TestCreateContact testa se é possivel criar um novo contato, normalizando o telefone para o formato E.164.
+func TestCreateContact(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 84.0", Tipo: entities.FISICO})
+
+	contactDTO := dtos.ContactCreateDTO{
+		ClienteID: client.ID,
+		Tipo:      entities.TELEFONE,
+		Valor:     "(11) 98765-4321",
+	}
+
+	contact, responseError := contactServiceTest.CreateContact(ctx, contactDTO)
+
+	require.Empty(t, responseError)
+
+	require.NotEmpty(t, contact)
+	require.NotEqual(t, "", contact.ID)
+	require.Equal(t, "+5511987654321", contact.Valor)
+	require.True(t, contact.Principal)
+	require.False(t, contact.Verificado)
+}
+
+// TestCreateContactWithEmail testa se é possivel criar um contato de email, salvo em letras minusculas.
+func TestCreateContactWithEmail(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 85.0", Tipo: entities.FISICO})
+
+	contactDTO := dtos.ContactCreateDTO{
+		ClienteID: client.ID,
+		Tipo:      entities.EMAIL,
+		Valor:     " Fulano.Silva@Example.com.br ",
+	}
+
+	contact, responseError := contactServiceTest.CreateContact(ctx, contactDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, "fulano.silva@example.com.br", contact.Valor)
+}
+
+// TestCreateContactWithInvalidEmail testa se não é possivel criar um contato com um email invalido.
+func TestCreateContactWithInvalidEmail(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 86.0", Tipo: entities.FISICO})
+
+	for _, email := range []string{"fulano", "fulano@", "Fulano <fulano@example.com>", "fulano@example"} {
+		contactDTO := dtos.ContactCreateDTO{
+			ClienteID: client.ID,
+			Tipo:      entities.EMAIL,
+			Valor:     email,
+		}
+
+		contact, responseError := contactServiceTest.CreateContact(ctx, contactDTO)
+
+		require.Equal(t, "valor: "+utils.InvalidEmail, responseError.Message, email)
+		require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+		require.Empty(t, contact)
+	}
+}
+
+// TestCreateContactWithInvalidPhone testa se não é possivel criar um contato com um telefone invalido.
+func TestCreateContactWithInvalidPhone(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 87.0", Tipo: entities.FISICO})
+
+	for _, phone := range []string{"98765-4321", "+1 415 555 2671", "(11) 88765-4321", "(01) 98765-4321"} {
+		contactDTO := dtos.ContactCreateDTO{
+			ClienteID: client.ID,
+			Tipo:      entities.WHATSAPP,
+			Valor:     phone,
+		}
+
+		contact, responseError := contactServiceTest.CreateContact(ctx, contactDTO)
+
+		require.Equal(t, "valor: "+utils.InvalidPhone, responseError.Message, phone)
+		require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+		require.Empty(t, contact)
+	}
+}
+
+// TestCreateContactWithValueExistent testa se não é possivel criar um contato ja existente para o cliente.
+func TestCreateContactWithValueExistent(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 88.0", Tipo: entities.FISICO})
+
+	contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.TELEFONE, Valor: "2133334444"})
+	contact, responseError := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: client.ID,
+		Tipo:      entities.TELEFONE,
+		Valor:     "+55 (21) 3333-4444",
+	})
+
+	require.Equal(t, utils.ContactAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, contact)
+}
+
+// TestCreateContactWithInvalidClientID testa se não é possivel criar um contato para um cliente inexistente.
+func TestCreateContactWithInvalidClientID(t *testing.T) {
+	contact, responseError := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		Tipo:  entities.EMAIL,
+		Valor: "fulano@example.com",
+	})
+
+	require.Equal(t, utils.ClientNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, contact)
+}
+
+// TestCreateContactPrincipal testa se um novo contato principal substitui o principal anterior do mesmo tipo.
+func TestCreateContactPrincipal(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 89.0", Tipo: entities.FISICO})
+
+	first, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.EMAIL, Valor: "a@example.com"})
+	second, responseError := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: client.ID,
+		Tipo:      entities.EMAIL,
+		Valor:     "b@example.com",
+		Principal: true,
+	})
+
+	require.Empty(t, responseError)
+	require.True(t, second.Principal)
+
+	firstFound, _ := contactServiceTest.FindContactByID(ctx, client.ID, first.ID)
+	require.False(t, firstFound.Principal)
+}
+
+// TestUpdateContact testa se a alteração do valor do contato remove a sua verificação.
+func TestUpdateContact(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 90.0", Tipo: entities.FISICO})
+	contact, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.EMAIL, Valor: "c@example.com"})
+
+	verified := true
+	contactVerified, responseError := contactServiceTest.UpdateContact(ctx, dtos.ContactUpdateDTO{
+		Base:       dtos.Base{ID: contact.ID},
+		ClienteID:  client.ID,
+		Verificado: &verified,
+	})
+
+	require.Empty(t, responseError)
+	require.True(t, contactVerified.Verificado)
+
+	contactUpdated, responseError := contactServiceTest.UpdateContact(ctx, dtos.ContactUpdateDTO{
+		Base:      dtos.Base{ID: contact.ID},
+		ClienteID: client.ID,
+		Valor:     "d@example.com",
+	})
+
+	require.Empty(t, responseError)
+	require.Equal(t, "d@example.com", contactUpdated.Valor)
+	require.False(t, contactUpdated.Verificado)
+}
+
+// TestUpdateContactWithOtherClientID testa se não é possivel atualizar o contato de outro cliente.
+func TestUpdateContactWithOtherClientID(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 91.0", Tipo: entities.FISICO})
+	client2, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 92.0", Tipo: entities.FISICO})
+	contact, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.EMAIL, Valor: "e@example.com"})
+
+	contactUpdated, responseError := contactServiceTest.UpdateContact(ctx, dtos.ContactUpdateDTO{
+		Base:      dtos.Base{ID: contact.ID},
+		ClienteID: client2.ID,
+		Valor:     "f@example.com",
+	})
+
+	require.Equal(t, utils.ContactNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, contactUpdated)
+}
+
+// TestDeleteContactByID testa se ao excluir o contato principal o proximo contato do mesmo tipo se torna o principal.
+func TestDeleteContactByID(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 93.0", Tipo: entities.FISICO})
+	first, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.EMAIL, Valor: "g@example.com"})
+	second, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.EMAIL, Valor: "h@example.com"})
+
+	responseError := contactServiceTest.DeleteContactByID(ctx, client.ID, first.ID)
+	require.Empty(t, responseError)
+
+	_, responseError = contactServiceTest.FindContactByID(ctx, client.ID, first.ID)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+
+	secondFound, responseError := contactServiceTest.FindContactByID(ctx, client.ID, second.ID)
+	require.Empty(t, responseError)
+	require.True(t, secondFound.Principal)
+}
+
+// TestUpdatePreferredChannel testa se é possivel definir o canal preferido do cliente e se ele é removido junto do ultimo contato do tipo.
+func TestUpdatePreferredChannel(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 94.0", Tipo: entities.FISICO})
+	contact, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.WHATSAPP, Valor: "11987654321"})
+
+	clientUpdated, responseError := contactServiceTest.UpdatePreferredChannel(ctx, client.ID,
+		dtos.PreferredChannelUpdateDTO{CanalPreferido: entities.WHATSAPP})
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.WHATSAPP, clientUpdated.CanalPreferido)
+
+	contactServiceTest.DeleteContactByID(ctx, client.ID, contact.ID)
+
+	clientFound, _ := clientServiceTest.FindClientByID(ctx, client.ID)
+	require.Equal(t, entities.ContactType(""), clientFound.CanalPreferido)
+}
+
+// TestUpdatePreferredChannelWithoutContact testa se não é possivel definir um canal preferido sem contato do tipo.
+func TestUpdatePreferredChannelWithoutContact(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 95.0", Tipo: entities.FISICO})
+
+	clientUpdated, responseError := contactServiceTest.UpdatePreferredChannel(ctx, client.ID,
+		dtos.PreferredChannelUpdateDTO{CanalPreferido: entities.EMAIL})
+
+	require.Equal(t, utils.PreferredChannelNotFound, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, clientUpdated)
+}
+
+// TestDeleteClientCascadeContacts testa se a exclusão do cliente também exclui os seus contatos.
+func TestDeleteClientCascadeContacts(t *testing.T) {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 96.0", Tipo: entities.FISICO})
+	contact, _ := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{ClienteID: client.ID, Tipo: entities.EMAIL, Valor: "i@example.com"})
+
+	responseError := clientServiceTest.DeleteClientByID(ctx, client.ID)
+	require.Empty(t, responseError)
+
+	contactFound, responseError := contactServiceTest.FindContactByID(ctx, client.ID, contact.ID)
+	require.Empty(t, contactFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+}
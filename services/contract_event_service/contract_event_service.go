@@ -1,28 +1,41 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
 	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/mashingan/smapping"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service")
+
 // ContractEventService representa a interface de ContractEventService.
 type ContractEventService interface {
-	CreateContractEvent(contractEventDTO dtos.ContratoEventCreateDTO) (entities.ContratoEvento, utils.ResponseError)
-	FindContractEventsByContractID(contractID string) []entities.ContratoEvento
+	CreateContractEvent(ctx context.Context, contractEventDTO dtos.ContratoEventCreateDTO) (entities.ContratoEvento, utils.ResponseError)
+	FindContractEventsByContractID(ctx context.Context, contractID string) ([]entities.ContratoEvento, utils.ResponseError)
+	FindScheduledPlanEvents(ctx context.Context, until time.Time) ([]entities.ContratoEvento, utils.ResponseError)
 }
 
 type contractEventService struct {
 	contractEventRepository repositories.ContractEventRepository
 	contractRepository      repositories.ContractRepository
+	logger                  *slog.Logger
 }
 
-func (service *contractEventService) CreateContractEvent(contractEventDTO dtos.ContratoEventCreateDTO) (entities.ContratoEvento, utils.ResponseError) {
+func (service *contractEventService) CreateContractEvent(ctx context.Context, contractEventDTO dtos.ContratoEventCreateDTO) (entities.ContratoEvento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractEventService.CreateContractEvent")
+	defer span.End()
+
 	contractEvent := entities.ContratoEvento{}
 
 	err := smapping.FillStruct(&contractEvent, smapping.MapFields(&contractEventDTO))
@@ -31,28 +44,65 @@ func (service *contractEventService) CreateContractEvent(contractEventDTO dtos.C
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	contractFound := service.contractRepository.FindContractByID(contractEvent.ContratoID)
-	if contractFound == (entities.Contrato{}) {
+	if contractEvent.Tipo == "" {
+		contractEvent.Tipo = entities.ESTADO
+	}
+
+	// O faturamento reconstroi o estado do contrato em cada dia a partir da data dos eventos.
+	contractEvent.DataCriacao = time.Now()
+	contractEvent.DataAtualizacao = time.Now()
+
+	_, err = service.contractRepository.FindContractByID(ctx, contractEvent.ContratoID)
+	if errors.Is(err, repositories.ErrNotFound) {
 		return entities.ContratoEvento{},
 			utils.NewResponseError(utils.ContractNotFound, http.StatusNotFound)
 	}
 
-	contractEvent, err = service.contractEventRepository.CreateContractEvent(contractEvent)
 	if err != nil {
-		return entities.ContratoEvento{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return entities.ContratoEvento{}, utils.NewInternalResponseError(err)
 	}
 
+	contractEvent, err = service.contractEventRepository.CreateContractEvent(ctx, contractEvent)
+	if err != nil {
+		return entities.ContratoEvento{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "contract event created", slog.String("contrato_id", contractEvent.ContratoID),
+		slog.String("tipo", string(contractEvent.Tipo)), slog.String("estado_anterior", string(contractEvent.EstadoAnterior)), slog.String("estado_posterior", string(contractEvent.EstadoPosterior)))
+
 	return contractEvent, utils.ResponseError{}
 }
 
-func (service *contractEventService) FindContractEventsByContractID(contractID string) []entities.ContratoEvento {
-	return service.contractEventRepository.FindContractEventsByContractID(contractID)
+func (service *contractEventService) FindContractEventsByContractID(ctx context.Context, contractID string) ([]entities.ContratoEvento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractEventService.FindContractEventsByContractID")
+	defer span.End()
+
+	contractEvents, err := service.contractEventRepository.FindContractEventsByContractID(ctx, contractID)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return contractEvents, utils.ResponseError{}
+}
+
+// FindScheduledPlanEvents pesquisa as trocas de plano agendadas que passaram a valer até a data informada.
+func (service *contractEventService) FindScheduledPlanEvents(ctx context.Context, until time.Time) ([]entities.ContratoEvento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractEventService.FindScheduledPlanEvents")
+	defer span.End()
+
+	contractEvents, err := service.contractEventRepository.FindScheduledPlanEvents(ctx, until)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return contractEvents, utils.ResponseError{}
 }
 
 // NewContractEventService cria uma nova instancia de ContractEventService.
-func NewContractEventService(contractEventRepository repositories.ContractEventRepository, contractRepository repositories.ContractRepository) ContractEventService {
+func NewContractEventService(contractEventRepository repositories.ContractEventRepository, contractRepository repositories.ContractRepository, logger *slog.Logger) ContractEventService {
 	return &contractEventService{
 		contractEventRepository: contractEventRepository,
 		contractRepository:      contractRepository,
+		logger:                  logger,
 	}
 }
@@ -1,42 +1,73 @@
 package services_test
 
 import (
+	"context"
 	"net/http"
 	"testing"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
 	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
 	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
 	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
 	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
 	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/stretchr/testify/require"
 )
 
 var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
 	// Fake Databases
-	dbClient        = repositoriesFake.DBClient
-	dbAddress       = repositoriesFake.DBAddress
-	dbPoint         = repositoriesFake.DBPoint
-	dbContract      = repositoriesFake.DBContract
-	dbContractEvent = repositoriesFake.DBContractEvent
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
 
 	// Fake Repositories
-	clientRepositoryFake        = repositoriesFake.NewClientRepositoryFake(dbClient)
-	addressRepositoryFake       = repositoriesFake.NewAddressRepositoryFake(dbAddress)
-	pointRepositoryFake         = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress)
-	contractRepositoryFake      = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint)
-	contractEventRepositoryFake = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.Default()
 
 	// Services Tests
-	contractEventServiceTest = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake)
-	contractServiceTest      = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, contractEventServiceTest)
-	pointServiceTest         = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest)
-	clientServiceTest        = clientService.NewClientService(clientRepositoryFake, pointServiceTest)
-	addressServiceTest       = addressService.NewAddressService(addressRepositoryFake, pointServiceTest)
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
 )
 
 // TestCreateContractEvent testa se é possivel criar um novo evento contrato.
@@ -45,33 +76,36 @@ func TestCreateContractEvent(t *testing.T) {
 		Nome: "Test 73.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 76.0",
 		Bairro:     "BairroTest 76.0",
 		Numero:     76,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	contractEventDTO := dtos.ContratoEventCreateDTO{
 		EstadoAnterior:  entities.VIGOR,
 		EstadoPosterior: entities.DESATIVADO,
 		ContratoID:      contract.ID,
 	}
-	contractEvent, responseError := contractEventServiceTest.CreateContractEvent(contractEventDTO)
+	contractEvent, responseError := contractEventServiceTest.CreateContractEvent(ctx, contractEventDTO)
 
 	require.Empty(t, responseError)
 
@@ -86,7 +120,7 @@ func TestCreateContractEventWithInvalidContractID(t *testing.T) {
 		EstadoPosterior: entities.DESATIVADO,
 		ContratoID:      "",
 	}
-	contractEvent, responseError := contractEventServiceTest.CreateContractEvent(contractEventDTO)
+	contractEvent, responseError := contractEventServiceTest.CreateContractEvent(ctx, contractEventDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ContractNotFound, responseError.Message)
@@ -101,52 +135,57 @@ func TestFindContractEventsByContractID(t *testing.T) {
 		Nome: "Test 74.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 77.0",
 		Bairro:     "BairroTest 77.0",
 		Numero:     77,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	contractEventDTO := dtos.ContratoEventCreateDTO{
 		EstadoAnterior:  entities.VIGOR,
 		EstadoPosterior: entities.DESATIVADO,
 		ContratoID:      contract.ID,
 	}
-	contractEventServiceTest.CreateContractEvent(contractEventDTO)
+	contractEventServiceTest.CreateContractEvent(ctx, contractEventDTO)
 
 	contractEventDTO2 := dtos.ContratoEventCreateDTO{
 		EstadoAnterior:  entities.DESATIVADO,
 		EstadoPosterior: entities.CANCELADO,
 		ContratoID:      contract.ID,
 	}
-	contractEventServiceTest.CreateContractEvent(contractEventDTO2)
+	contractEventServiceTest.CreateContractEvent(ctx, contractEventDTO2)
 
-	contractEvents := contractEventServiceTest.FindContractEventsByContractID(contract.ID)
+	contractEvents, responseError := contractEventServiceTest.FindContractEventsByContractID(ctx, contract.ID)
 
 	require.NotEmpty(t, contractEvents)
+	require.Empty(t, responseError)
 	require.Greater(t, len(contractEvents), 0)
 }
 
 // TestFindContractEventsByContractIDWithInvalidID testa se não é possivel listar os eventos de um contrato a partir do seu ID invalido.
 func TestFindContractEventsByContractIDWithInvalidID(t *testing.T) {
-	contractEvents := contractEventServiceTest.FindContractEventsByContractID("")
+	contractEvents, responseError := contractEventServiceTest.FindContractEventsByContractID(ctx, "")
 
 	require.Empty(t, contractEvents)
+	require.Empty(t, responseError)
 }
 
 // TestFindContractEventsByContractIDWithDeletedAtValid testa se é possivel listar os eventos de um contrato removido a partir do seu ID.
@@ -155,44 +194,48 @@ func TestFindContractEventsByContractIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 75.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 78.0",
 		Bairro:     "BairroTest 78.0",
 		Numero:     78,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	contractServiceTest.DeleteContractByID(contract.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	contractServiceTest.DeleteContractByID(ctx, contract.ID)
 
 	contractEventDTO := dtos.ContratoEventCreateDTO{
 		EstadoAnterior:  entities.VIGOR,
 		EstadoPosterior: entities.DESATIVADO,
 		ContratoID:      contract.ID,
 	}
-	contractEventServiceTest.CreateContractEvent(contractEventDTO)
+	contractEventServiceTest.CreateContractEvent(ctx, contractEventDTO)
 
 	contractEventDTO2 := dtos.ContratoEventCreateDTO{
 		EstadoAnterior:  entities.DESATIVADO,
 		EstadoPosterior: entities.CANCELADO,
 		ContratoID:      contract.ID,
 	}
-	contractEventServiceTest.CreateContractEvent(contractEventDTO2)
+	contractEventServiceTest.CreateContractEvent(ctx, contractEventDTO2)
 
-	contractEvents := contractEventServiceTest.FindContractEventsByContractID(contract.ID)
+	contractEvents, responseError := contractEventServiceTest.FindContractEventsByContractID(ctx, contract.ID)
 
 	require.NotEmpty(t, contractEvents)
+	require.Empty(t, responseError)
 	require.Greater(t, len(contractEvents), 0)
 }
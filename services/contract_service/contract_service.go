@@ -1,35 +1,70 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
 	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/mashingan/smapping"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service")
+
+// RenewalWindow quantidade de dias padrão da pesquisa dos contratos a renovar.
+const RenewalWindow = 30
+
 // ContractService representa a interface de contractService.
 type ContractService interface {
-	CreateContract(contractDTO dtos.ContractCreateDTO) (entities.Contrato, utils.ResponseError)
-	UpdateContract(contractDTO dtos.ContractUpdateDTO) (entities.Contrato, utils.ResponseError)
-	FindContractByID(contractID string) entities.Contrato
-	FindContractByPontoID(pontoID string) entities.Contrato
-	DeleteContractByID(contractID string) utils.ResponseError
-	DeleteContractByPontoID(pontoID string) utils.ResponseError
-	FindContracts(clientID string, addressID string) []entities.Contrato
+	CreateContract(ctx context.Context, contractDTO dtos.ContractCreateDTO) (entities.Contrato, utils.ResponseError)
+	UpdateContract(ctx context.Context, contractDTO dtos.ContractUpdateDTO) (entities.Contrato, utils.ResponseError)
+	FindContractByID(ctx context.Context, contractID string) (entities.Contrato, utils.ResponseError)
+	FindContractByPontoID(ctx context.Context, pontoID string) (entities.Contrato, utils.ResponseError)
+	FindContractsByPontoID(ctx context.Context, pontoID string) ([]entities.Contrato, utils.ResponseError)
+	FindCurrentContractsByPontoIDs(ctx context.Context, pontoIDs []string) (map[string]entities.Contrato, utils.ResponseError)
+	DeleteContractByID(ctx context.Context, contractID string) utils.ResponseError
+	DeleteContractByPontoID(ctx context.Context, pontoID string) utils.ResponseError
+	FindContracts(ctx context.Context, clientID string, addressID string, includeBranches bool) ([]entities.Contrato, utils.ResponseError)
+	ChangePlan(ctx context.Context, planChangeDTO dtos.ContractPlanChangeDTO) (entities.Contrato, utils.ResponseError)
+	FindContractsForRenewal(ctx context.Context, days int) ([]entities.Contrato, utils.ResponseError)
+	RenewContract(ctx context.Context, renewalDTO dtos.ContractRenewalDTO) (entities.Contrato, utils.ResponseError)
+	ApplyScheduledPlanChanges(ctx context.Context, now time.Time) (int, utils.ResponseError)
+	ValidateResponsibleContact(ctx context.Context, clientID string, contactID string) utils.ResponseError
 }
 
 type contractService struct {
-	contractRepository   repositories.ContractRepository
-	pointRepository      repositories.PointRepository
-	contractEventService services.ContractEventService
+	contractRepository     repositories.ContractRepository
+	pointRepository        repositories.PointRepository
+	clientRepository       repositories.ClientRepository
+	contactRepository      repositories.ContactRepository
+	planRepository         repositories.PlanRepository
+	serviceOrderRepository repositories.ServiceOrderRepository
+	contractEventService   services.ContractEventService
+	contractVersionService contractVersionService.ContractVersionService
+	equipmentService       equipmentService.EquipmentService
+	clientPolicies         policies.ClientPolicies
+	logger                 *slog.Logger
 }
 
-func (service *contractService) CreateContract(contractDTO dtos.ContractCreateDTO) (entities.Contrato, utils.ResponseError) {
+func (service *contractService) CreateContract(ctx context.Context, contractDTO dtos.ContractCreateDTO) (entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.CreateContract")
+	defer span.End()
+
 	contract := entities.Contrato{}
 
 	err := smapping.FillStruct(&contract, smapping.MapFields(&contractDTO))
@@ -38,60 +73,111 @@ func (service *contractService) CreateContract(contractDTO dtos.ContractCreateDT
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	pontoExists := service.pointRepository.FindPointByID(contract.PontoID)
-	if pontoExists == (entities.Ponto{}) {
+	point, err := service.pointRepository.FindPointByID(ctx, contract.PontoID)
+	if errors.Is(err, repositories.ErrNotFound) {
 		return entities.Contrato{}, utils.NewResponseError(utils.PointNotFound, http.StatusNotFound)
 	}
 
-	contractAlreadyExists := service.contractRepository.FindContractByPontoID(contract.PontoID)
+	if err != nil {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
 
-	switch {
-	case contractAlreadyExists.DataRemocao.Valid:
-		contract.ID = contractAlreadyExists.ID
+	client, err := service.clientRepository.FindClientByID(ctx, point.ClienteID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
 
-		contract, err := service.contractRepository.UpdateContract(contract)
-		if err != nil {
-			return entities.Contrato{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
-		}
+	contract.ContatoResponsavelID = strings.TrimSpace(contract.ContatoResponsavelID)
 
-		contractEventDTO := dtos.ContratoEventCreateDTO{
-			ContratoID:      contract.ID,
-			EstadoAnterior:  contractAlreadyExists.Estado,
-			EstadoPosterior: contract.Estado,
+	responseError := service.ValidateResponsibleContact(ctx, point.ClienteID, contract.ContatoResponsavelID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	err = service.clientPolicies.CheckResponsibleContact(client.Tipo, contract.ContatoResponsavelID)
+	if err != nil {
+		return entities.Contrato{}, utils.NewResponseError(err.Error(), http.StatusUnprocessableEntity)
+	}
+
+	plan := entities.Plano{}
+
+	if contract.PlanoID != "" {
+		plan, err = service.planRepository.FindPlanByID(ctx, contract.PlanoID)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.Contrato{}, utils.NewResponseError("plano_id: "+utils.PlanNotFound, http.StatusNotFound)
 		}
 
-		_, responseError := service.contractEventService.CreateContractEvent(contractEventDTO)
-		if len(responseError.Message) != 0 {
-			return entities.Contrato{}, utils.NewResponseError(responseError.Message, responseError.StatusCode)
+		if err != nil {
+			return entities.Contrato{}, utils.NewInternalResponseError(err)
 		}
+	}
 
-		return contract, utils.ResponseError{}
+	responseError = setContractDates(&contract, plan, time.Now())
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
 
-	case (contractAlreadyExists != entities.Contrato{}):
+	// O ponto pode ter uma sequencia de contratos, com no maximo um contrato não cancelado.
+	_, err = service.contractRepository.FindContractByPontoID(ctx, contract.PontoID)
+	if err == nil {
 		return entities.Contrato{}, utils.NewResponseError(utils.ContractAlreadyExists, http.StatusConflict)
+	}
 
-	default:
-		contract, err := service.contractRepository.CreateContract(contract)
-		if err != nil {
-			return entities.Contrato{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
-		}
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
 
-		contractEventDTO := dtos.ContratoEventCreateDTO{
-			ContratoID:      contract.ID,
-			EstadoAnterior:  contract.Estado,
-			EstadoPosterior: contract.Estado,
-		}
+	contract, err = service.contractRepository.CreateContract(ctx, contract)
+	if errors.Is(err, repositories.ErrAlreadyExists) {
+		return entities.Contrato{}, utils.NewResponseError(utils.ContractAlreadyExists, http.StatusConflict)
+	}
+
+	if err != nil {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
 
-		_, responseError := service.contractEventService.CreateContractEvent(contractEventDTO)
-		if len(responseError.Message) != 0 {
-			return entities.Contrato{}, utils.NewResponseError(responseError.Message, responseError.StatusCode)
+	contractEventDTO := dtos.ContratoEventCreateDTO{
+		ContratoID:      contract.ID,
+		EstadoAnterior:  contract.Estado,
+		EstadoPosterior: contract.Estado,
+	}
+
+	_, responseError = service.contractEventService.CreateContractEvent(ctx, contractEventDTO)
+	if len(responseError.Message) != 0 {
+		return entities.Contrato{}, utils.NewResponseError(responseError.Message, responseError.StatusCode)
+	}
+
+	if contract.PlanoID != "" {
+		responseError = service.createPlanEvent(ctx, contract, "", contract.PlanoID, time.Now())
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contrato{}, responseError
 		}
+	}
 
-		return contract, utils.ResponseError{}
+	contractVersionDTO := createContractVersionDTO(contract, plan, entities.CADASTRO, contractStart(contract))
+
+	_, responseError = service.contractVersionService.CreateContractVersion(ctx, contractVersionDTO)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
 	}
+
+	if contract.Estado == entities.PENDENTE {
+		responseError = service.openServiceOrder(ctx, contract, entities.INSTALACAO, "Instalação do contrato")
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contrato{}, responseError
+		}
+	}
+
+	service.logger.InfoContext(ctx, "contract created", slog.String("contrato_id", contract.ID),
+		slog.String("ponto_id", contract.PontoID))
+
+	return contract, utils.ResponseError{}
 }
 
-func (service *contractService) UpdateContract(contractDTO dtos.ContractUpdateDTO) (entities.Contrato, utils.ResponseError) {
+func (service *contractService) UpdateContract(ctx context.Context, contractDTO dtos.ContractUpdateDTO) (entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.UpdateContract")
+	defer span.End()
+
 	contract := entities.Contrato{}
 
 	err := smapping.FillStruct(&contract, smapping.MapFields(&contractDTO))
@@ -100,82 +186,654 @@ func (service *contractService) UpdateContract(contractDTO dtos.ContractUpdateDT
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	contractFound := service.contractRepository.FindContractByID(contract.ID)
-	if contractFound == (entities.Contrato{}) {
-		return entities.Contrato{}, utils.NewResponseError(utils.ContractNotFound, http.StatusNotFound)
+	contractFound, responseError := service.FindContractByID(ctx, contract.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
 	}
 
 	if !dtos.IsAuthorized(contractFound.Estado, contractDTO.Estado) {
 		return entities.Contrato{}, utils.NewResponseError(utils.Unathorized, http.StatusUnauthorized)
 	}
 
+	// Os motivos das rotinas da API identificam as suspensões que o pagamento reativa, e não podem ser informados
+	// pelo usuario.
+	motive := strings.TrimSpace(contractDTO.Motivo)
+	if !contractDTO.Automatica && (motive == entities.INADIMPLENCIA || motive == entities.PAGAMENTO) {
+		return entities.Contrato{}, utils.NewResponseError(utils.ReservedContractMotive, http.StatusBadRequest)
+	}
+
+	client := contractFound.Ponto.Cliente
+	contract.ContatoResponsavelID = contractFound.ContatoResponsavelID
+
+	// A politica do contato responsavel é verificada apenas quando o contato é alterado ou removido, para que as
+	// mudanças de estado dos contratos anteriores à politica não sejam bloqueadas.
+	if contractDTO.ContatoResponsavelID != nil {
+		contract.ContatoResponsavelID = strings.TrimSpace(*contractDTO.ContatoResponsavelID)
+
+		responseError = service.ValidateResponsibleContact(ctx, client.ID, contract.ContatoResponsavelID)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contrato{}, responseError
+		}
+
+		err = service.clientPolicies.CheckResponsibleContact(client.Tipo, contract.ContatoResponsavelID)
+		if err != nil {
+			return entities.Contrato{}, utils.NewResponseError(err.Error(), http.StatusUnprocessableEntity)
+		}
+	}
+
+	if contractDTO.Automatica && contract.Estado == entities.DESATIVADO {
+		err = service.clientPolicies.CheckAutomaticSuspension(client.Tipo)
+		if err != nil {
+			return entities.Contrato{}, utils.NewResponseError(err.Error(), http.StatusUnprocessableEntity)
+		}
+	}
+
+	now := time.Now()
+
+	contract.DataAssinatura = contractFound.DataAssinatura
+	contract.DataAtivacao = contractFound.DataAtivacao
+	contract.DataFimFidelidade = contractFound.DataFimFidelidade
+	contract.DataTermino = contractFound.DataTermino
+	contract.MultaRescisao = contractFound.MultaRescisao
+
+	// Os contratos cadastrados antes das datas do contrato foram assinados no seu cadastro.
+	if contract.DataAssinatura == nil && !contractFound.DataCriacao.IsZero() {
+		signature := contractFound.DataCriacao
+		contract.DataAssinatura = &signature
+	}
+
+	// A conclusão da instalação ativa o contrato pendente, iniciando a fidelidade na data da ativação.
+	activation := contract.Estado == entities.VIGOR && (contractFound.Estado == entities.PENDENTE || contract.DataAtivacao == nil)
+	if activation {
+		contract.DataAtivacao = &now
+		contract.DataFimFidelidade = nil
+	}
+
+	responseError = setContractDates(&contract, contractFound.Plano, now)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	if contract.Estado == entities.CANCELADO {
+		contract.MultaRescisao = dtos.TerminationFee(contract, contractFound.Plano, now)
+	}
+
 	contract.PontoID = contractFound.PontoID
+	contract.PlanoID = contractFound.PlanoID
+	contract.VersaoID = contractFound.VersaoID
 	contract.DataRemocao.Scan(nil)
-	contract, err = service.contractRepository.UpdateContract(contract)
+	contract, err = service.contractRepository.UpdateContract(ctx, contract)
 	if err != nil {
-		return entities.Contrato{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
 	}
 
 	contractEventDTO := dtos.ContratoEventCreateDTO{
 		ContratoID:      contract.ID,
 		EstadoAnterior:  contractFound.Estado,
 		EstadoPosterior: contract.Estado,
+		Motivo:          motive,
 	}
 
-	_, responseError := service.contractEventService.CreateContractEvent(contractEventDTO)
+	_, responseError = service.contractEventService.CreateContractEvent(ctx, contractEventDTO)
 	if len(responseError.Message) != 0 {
 		return entities.Contrato{}, utils.NewResponseError(responseError.Message, responseError.StatusCode)
 	}
 
+	// O cancelamento de um contrato instalado exige a retirada dos equipamentos do ponto.
+	if contract.Estado == entities.CANCELADO && contractFound.Estado != entities.PENDENTE {
+		responseError = service.openServiceOrder(ctx, contract, entities.RETIRADA, "Retirada de equipamento do contrato cancelado")
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contrato{}, responseError
+		}
+
+		responseError = service.equipmentService.FlagEquipmentsForReturn(ctx, contract.PontoID, entities.CONTRATO_CANCELADO)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contrato{}, responseError
+		}
+	}
+
+	// A ativação inicia a fidelidade, alterando os termos do contrato.
+	if activation {
+		activatedContract := contract
+		activatedContract.Versao = contractFound.Versao
+
+		contractVersionDTO := createContractVersionDTO(activatedContract, contractFound.Plano, entities.ATIVACAO, *contract.DataAtivacao)
+
+		_, responseError = service.contractVersionService.CreateContractVersion(ctx, contractVersionDTO)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contrato{}, responseError
+		}
+	}
+
+	service.logger.InfoContext(ctx, "contract updated", slog.String("contrato_id", contract.ID),
+		slog.String("estado", string(contract.Estado)), slog.String("motivo", contractEventDTO.Motivo),
+		slog.Int64("multa_rescisao", contract.MultaRescisao))
+
 	return contract, utils.ResponseError{}
 }
 
-func (service *contractService) FindContractByID(contractID string) entities.Contrato {
-	return service.contractRepository.FindContractByID(contractID)
+func (service *contractService) FindContractByID(ctx context.Context, contractID string) (entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.FindContractByID")
+	defer span.End()
+
+	contract, err := service.contractRepository.FindContractByID(ctx, contractID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Contrato{}, utils.NewResponseError(utils.ContractNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
+
+	return contract, utils.ResponseError{}
 }
 
-func (service *contractService) FindContractByPontoID(pontoID string) entities.Contrato {
-	return service.contractRepository.FindContractByPontoID(pontoID)
+func (service *contractService) FindContractByPontoID(ctx context.Context, pontoID string) (entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.FindContractByPontoID")
+	defer span.End()
+
+	contract, err := service.contractRepository.FindContractByPontoID(ctx, pontoID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Contrato{}, utils.NewResponseError(utils.ContractNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
+
+	return contract, utils.ResponseError{}
 }
 
-func (service *contractService) DeleteContractByID(contractID string) utils.ResponseError {
-	contractFound := service.contractRepository.FindContractByID(contractID)
+// FindCurrentContractsByPontoIDs pesquisa em uma consulta os contratos atuais dos pontos, indexados pelo id do
+// ponto. Os pontos sem contrato não cancelado ficam fora do mapa.
+func (service *contractService) FindCurrentContractsByPontoIDs(ctx context.Context, pontoIDs []string) (map[string]entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.FindCurrentContractsByPontoIDs")
+	defer span.End()
 
-	if contractFound == (entities.Contrato{}) {
-		return utils.NewResponseError(utils.ContractNotFound, http.StatusNotFound)
+	contracts, err := service.contractRepository.FindCurrentContractsByPontoIDs(ctx, pontoIDs)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	contractsByPoint := map[string]entities.Contrato{}
+	for _, contract := range contracts {
+		contractsByPoint[contract.PontoID] = contract
 	}
 
-	err := service.contractRepository.DeleteContract(contractFound)
+	return contractsByPoint, utils.ResponseError{}
+}
+
+// FindContractsByPontoID pesquisa todos os contratos do ponto, do mais antigo ao atual.
+func (service *contractService) FindContractsByPontoID(ctx context.Context, pontoID string) ([]entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.FindContractsByPontoID")
+	defer span.End()
+
+	contracts, err := service.contractRepository.FindContractsByPontoID(ctx, pontoID)
 	if err != nil {
-		return utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return nil, utils.NewInternalResponseError(err)
 	}
 
+	return contracts, utils.ResponseError{}
+}
+
+func (service *contractService) DeleteContractByID(ctx context.Context, contractID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "ContractService.DeleteContractByID")
+	defer span.End()
+
+	contractFound, responseError := service.FindContractByID(ctx, contractID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
+	}
+
+	err := service.contractRepository.DeleteContract(ctx, contractFound)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "contract deleted", slog.String("contrato_id", contractID))
+
 	return utils.ResponseError{}
 }
 
-func (service *contractService) DeleteContractByPontoID(pontoID string) utils.ResponseError {
-	contract := service.contractRepository.FindContractByPontoID(pontoID)
-	if contract == (entities.Contrato{}) {
-		return utils.ResponseError{}
+func (service *contractService) DeleteContractByPontoID(ctx context.Context, pontoID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "ContractService.DeleteContractByPontoID",
+		trace.WithAttributes(attribute.String("ponto.id", pontoID)))
+	defer span.End()
+
+	contracts, err := service.contractRepository.FindContractsByPontoID(ctx, pontoID)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	for _, contract := range contracts {
+		err = service.contractRepository.DeleteContract(ctx, contract)
+		if err != nil {
+			return utils.NewInternalResponseError(err)
+		}
+
+		service.logger.InfoContext(ctx, "contract deleted", slog.String("contrato_id", contract.ID))
+	}
+
+	return utils.ResponseError{}
+}
+
+func (service *contractService) FindContracts(ctx context.Context, clientID string, addressID string, includeBranches bool) ([]entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.FindContracts")
+	defer span.End()
+
+	contracts, err := service.contractRepository.FindContracts(ctx, clientID, addressID, includeBranches)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return contracts, utils.ResponseError{}
+}
+
+// ChangePlan troca o plano do contrato, registrando no historico a troca e a data em que ela passa a valer. A
+// troca com a data efetiva futura é apenas agendada, e o contrato mantém o plano atual até que
+// ApplyScheduledPlanChanges a aplique.
+func (service *contractService) ChangePlan(ctx context.Context, planChangeDTO dtos.ContractPlanChangeDTO) (entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.ChangePlan")
+	defer span.End()
+
+	contract, responseError := service.FindContractByID(ctx, planChangeDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	if contract.Estado == entities.CANCELADO {
+		return entities.Contrato{}, utils.NewResponseError(utils.InvalidPlanChange, http.StatusBadRequest)
+	}
+
+	now := time.Now()
+
+	effectiveDate := now
+	if planChangeDTO.DataEfetiva != nil {
+		effectiveDate = *planChangeDTO.DataEfetiva
+	}
+
+	contractEvents, responseError := service.contractEventService.FindContractEventsByContractID(ctx, contract.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	for _, contractEvent := range contractEvents {
+		if contractEvent.Tipo == entities.PLANO && contractEvent.DataEfetiva != nil && effectiveDate.Before(*contractEvent.DataEfetiva) {
+			return entities.Contrato{}, utils.NewResponseError("data_efetiva: "+utils.InvalidEffectiveDate, http.StatusBadRequest)
+		}
 	}
 
-	err := service.contractRepository.DeleteContract(contract)
+	// A troca parte do plano da ultima troca registrada, que pode estar agendada.
+	previousPlanID := contract.PlanoID
+	if lastPlanEvent := lastPlanEvent(contractEvents, nil); lastPlanEvent != nil {
+		previousPlanID = lastPlanEvent.PlanoPosteriorID
+	}
+
+	if previousPlanID == planChangeDTO.PlanoID {
+		return entities.Contrato{}, utils.NewResponseError(utils.InvalidPlanChange, http.StatusBadRequest)
+	}
+
+	plan, err := service.planRepository.FindPlanByID(ctx, planChangeDTO.PlanoID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Contrato{}, utils.NewResponseError("plano_id: "+utils.PlanNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
+
+	if effectiveDate.After(now) {
+		responseError = service.createPlanEvent(ctx, contract, previousPlanID, plan.ID, effectiveDate)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Contrato{}, responseError
+		}
+
+		service.logger.InfoContext(ctx, "contract plan change scheduled", slog.String("contrato_id", contract.ID),
+			slog.String("plano_anterior_id", previousPlanID), slog.String("plano_id", plan.ID),
+			slog.Time("data_efetiva", effectiveDate))
+
+		return contract, utils.ResponseError{}
+	}
+
+	previousPlan := contract.Plano
+
+	contract, responseError = service.applyPlanChange(ctx, contract, plan, effectiveDate)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	responseError = service.createPlanEvent(ctx, contract, previousPlan.ID, plan.ID, effectiveDate)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	change := "upgrade"
+	switch {
+	case previousPlan.ID == "":
+		change = "adesao"
+	case plan.PrecoMensal < previousPlan.PrecoMensal:
+		change = "downgrade"
+	}
+
+	service.logger.InfoContext(ctx, "contract plan changed", slog.String("contrato_id", contract.ID),
+		slog.String("plano_anterior_id", previousPlan.ID), slog.String("plano_id", plan.ID),
+		slog.String("mudanca", change), slog.Time("data_efetiva", effectiveDate))
+
+	return contract, utils.ResponseError{}
+}
+
+// ApplyScheduledPlanChanges aplica as trocas de plano agendadas que passaram a valer até a data informada,
+// retornando a quantidade de contratos alterados. O contrato recebe o plano da sua ultima troca com a data
+// efetiva até essa data, o que permite executar a rotina mais de uma vez.
+func (service *contractService) ApplyScheduledPlanChanges(ctx context.Context, now time.Time) (int, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.ApplyScheduledPlanChanges")
+	defer span.End()
+
+	scheduledEvents, responseError := service.contractEventService.FindScheduledPlanEvents(ctx, now)
+	if responseError != (utils.ResponseError{}) {
+		return 0, responseError
+	}
+
+	applied := 0
+	checked := map[string]bool{}
+
+	for _, scheduledEvent := range scheduledEvents {
+		if checked[scheduledEvent.ContratoID] {
+			continue
+		}
+
+		checked[scheduledEvent.ContratoID] = true
+
+		contract, responseError := service.FindContractByID(ctx, scheduledEvent.ContratoID)
+		if responseError.StatusCode == http.StatusNotFound {
+			continue
+		}
+
+		if responseError != (utils.ResponseError{}) {
+			return applied, responseError
+		}
+
+		contractEvents, responseError := service.contractEventService.FindContractEventsByContractID(ctx, contract.ID)
+		if responseError != (utils.ResponseError{}) {
+			return applied, responseError
+		}
+
+		currentPlanEvent := lastPlanEvent(contractEvents, &now)
+		if contract.Estado == entities.CANCELADO || currentPlanEvent == nil || currentPlanEvent.PlanoPosteriorID == contract.PlanoID {
+			continue
+		}
+
+		// Os planos removidos do catalogo depois do agendamento continuam valendo para a troca.
+		plans, err := service.planRepository.FindPlansByIDs(ctx, []string{currentPlanEvent.PlanoPosteriorID})
+		if err != nil {
+			return applied, utils.NewInternalResponseError(err)
+		}
+
+		if len(plans) == 0 {
+			service.logger.WarnContext(ctx, "scheduled plan not found", slog.String("contrato_id", contract.ID),
+				slog.String("plano_id", currentPlanEvent.PlanoPosteriorID))
+			continue
+		}
+
+		_, responseError = service.applyPlanChange(ctx, contract, plans[0], *currentPlanEvent.DataEfetiva)
+		if responseError != (utils.ResponseError{}) {
+			return applied, responseError
+		}
+
+		service.logger.InfoContext(ctx, "scheduled contract plan change applied", slog.String("contrato_id", contract.ID),
+			slog.String("plano_anterior_id", contract.PlanoID), slog.String("plano_id", plans[0].ID),
+			slog.Time("data_efetiva", *currentPlanEvent.DataEfetiva))
+
+		applied++
+	}
+
+	return applied, utils.ResponseError{}
+}
+
+// applyPlanChange altera o plano do contrato, registrando a nova versão dos termos a partir da data efetiva.
+func (service *contractService) applyPlanChange(ctx context.Context, contract entities.Contrato, plan entities.Plano, effectiveDate time.Time) (entities.Contrato, utils.ResponseError) {
+	currentVersion := contract.Versao
+
+	contract.PlanoID = plan.ID
+	contract.Plano = entities.Plano{}
+	contract.Ponto = entities.Ponto{}
+	contract.Versao = nil
+
+	contract, err := service.contractRepository.UpdateContract(ctx, contract)
+	if err != nil {
+		return entities.Contrato{}, utils.NewInternalResponseError(err)
+	}
+
+	contract.Versao = currentVersion
+	contractVersionDTO := createContractVersionDTO(contract, plan, entities.TROCA_PLANO, effectiveDate)
+
+	contractVersion, responseError := service.contractVersionService.CreateContractVersion(ctx, contractVersionDTO)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	contract.Plano = plan
+	contract.VersaoID = contractVersion.ID
+	contract.Versao = &contractVersion
+
+	return contract, utils.ResponseError{}
+}
+
+// FindContractsForRenewal pesquisa os contratos ativos cuja data de termino, ou o fim da fidelidade quando o
+// contrato não possui data de termino, está nos proximos dias informados.
+func (service *contractService) FindContractsForRenewal(ctx context.Context, days int) ([]entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.FindContractsForRenewal")
+	defer span.End()
+
+	if days < 1 || days > 365 {
+		return nil, utils.NewResponseError(utils.InvalidRenewalWindow, http.StatusBadRequest)
+	}
+
+	// A janela começa no inicio do dia, já que as datas de termino não possuem horario.
+	now := time.Now()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+
+	contracts, err := service.contractRepository.FindContractsEndingBetween(ctx, today, today.AddDate(0, 0, days))
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return contracts, utils.ResponseError{}
+}
+
+// RenewContract renova o contrato até a nova data de termino, registrando a renovação como uma nova versão do
+// contrato a partir da data efetiva. O cadastro do contrato não é alterado, ele apenas aponta para a nova versão.
+func (service *contractService) RenewContract(ctx context.Context, renewalDTO dtos.ContractRenewalDTO) (entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractService.RenewContract")
+	defer span.End()
+
+	contract, responseError := service.FindContractByID(ctx, renewalDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	if contract.Estado == entities.CANCELADO {
+		return entities.Contrato{}, utils.NewResponseError(utils.InvalidRenewal, http.StatusBadRequest)
+	}
+
+	effectiveDate := time.Now()
+	if renewalDTO.DataEfetiva != nil {
+		effectiveDate = *renewalDTO.DataEfetiva
+	}
+
+	endDate := renewalDTO.DataTermino
+	termination := dtos.ContractTermination(contract)
+
+	if !endDate.After(effectiveDate) || (termination != nil && !endDate.After(*termination)) {
+		return entities.Contrato{}, utils.NewResponseError("data_termino: "+utils.InvalidRenewal, http.StatusBadRequest)
+	}
+
+	renewedContract := contract
+	renewedContract.DataTermino = &endDate
+	renewedContract.Versao = nil
+
+	contractVersionDTO := createContractVersionDTO(renewedContract, contract.Plano, entities.RENOVACAO, effectiveDate)
+
+	contractVersion, responseError := service.contractVersionService.CreateContractVersion(ctx, contractVersionDTO)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Contrato{}, responseError
+	}
+
+	service.logger.InfoContext(ctx, "contract renewed", slog.String("contrato_id", contract.ID),
+		slog.Time("data_termino", endDate), slog.Time("data_efetiva", effectiveDate))
+
+	contract.VersaoID = contractVersion.ID
+	contract.Versao = &contractVersion
+
+	return contract, utils.ResponseError{}
+}
+
+// createPlanEvent registra no historico do contrato a troca do plano anterior pelo plano atual.
+func (service *contractService) createPlanEvent(ctx context.Context, contract entities.Contrato, previousPlanID string, planID string, effectiveDate time.Time) utils.ResponseError {
+	contractEventDTO := dtos.ContratoEventCreateDTO{
+		Tipo:             entities.PLANO,
+		ContratoID:       contract.ID,
+		EstadoAnterior:   contract.Estado,
+		EstadoPosterior:  contract.Estado,
+		PlanoAnteriorID:  previousPlanID,
+		PlanoPosteriorID: planID,
+		DataEfetiva:      &effectiveDate,
+	}
+
+	_, responseError := service.contractEventService.CreateContractEvent(ctx, contractEventDTO)
+
+	return responseError
+}
+
+// lastPlanEvent retorna a troca de plano com a maior data efetiva, considerando apenas as trocas que passaram a
+// valer até a data informada, quando a data não é nula.
+func lastPlanEvent(contractEvents []entities.ContratoEvento, until *time.Time) *entities.ContratoEvento {
+	var last *entities.ContratoEvento
+
+	for i, contractEvent := range contractEvents {
+		if contractEvent.Tipo != entities.PLANO || contractEvent.DataEfetiva == nil {
+			continue
+		}
+
+		if until != nil && contractEvent.DataEfetiva.After(*until) {
+			continue
+		}
+
+		if last == nil || !contractEvent.DataEfetiva.Before(*last.DataEfetiva) {
+			last = &contractEvents[i]
+		}
+	}
+
+	return last
+}
+
+// setContractDates preenche a data de assinatura não informada e, no contrato em vigor, a data de ativação,
+// calculando o fim da fidelidade do plano a partir da ativação.
+func setContractDates(contract *entities.Contrato, plan entities.Plano, now time.Time) utils.ResponseError {
+	if contract.DataAssinatura == nil {
+		contract.DataAssinatura = &now
+	}
+
+	if contract.DataAtivacao == nil && contract.Estado == entities.VIGOR {
+		contract.DataAtivacao = contract.DataAssinatura
+	}
+
+	start := contract.DataAssinatura
+
+	if contract.DataAtivacao != nil {
+		if contract.DataAtivacao.Before(*contract.DataAssinatura) {
+			return utils.NewResponseError("data_ativacao: "+utils.InvalidContractDates, http.StatusBadRequest)
+		}
+
+		start = contract.DataAtivacao
+	}
+
+	if contract.DataTermino != nil && !contract.DataTermino.After(*start) {
+		return utils.NewResponseError("data_termino: "+utils.InvalidContractDates, http.StatusBadRequest)
+	}
+
+	if contract.DataFimFidelidade == nil && contract.DataAtivacao != nil {
+		contract.DataFimFidelidade = dtos.FidelityEnd(*contract.DataAtivacao, plan)
+	}
+
+	return utils.ResponseError{}
+}
+
+// openServiceOrder abre a ordem de serviço do trabalho fisico exigido pela alteração do contrato.
+func (service *contractService) openServiceOrder(ctx context.Context, contract entities.Contrato, serviceOrderType entities.ServiceOrderType, description string) utils.ResponseError {
+	serviceOrder := entities.OrdemServico{
+		Tipo:       serviceOrderType,
+		Estado:     entities.ORDEM_ABERTA,
+		PontoID:    contract.PontoID,
+		ContratoID: contract.ID,
+		Descricao:  description,
+	}
+
+	serviceOrder, err := service.serviceOrderRepository.CreateServiceOrder(ctx, serviceOrder)
 	if err != nil {
-		return utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return utils.NewInternalResponseError(err)
 	}
 
+	service.logger.InfoContext(ctx, "service order opened", slog.String("ordem_servico_id", serviceOrder.ID),
+		slog.String("tipo", string(serviceOrder.Tipo)), slog.String("contrato_id", contract.ID))
+
 	return utils.ResponseError{}
 }
 
-func (service *contractService) FindContracts(clientID string, addressID string) []entities.Contrato {
-	return service.contractRepository.FindContracts(clientID, addressID)
+// createContractVersionDTO cria a versão do contrato com os termos atuais do contrato e do plano.
+func createContractVersionDTO(contract entities.Contrato, plan entities.Plano, reason entities.ContractVersionReason, effectiveDate time.Time) dtos.ContractVersionCreateDTO {
+	return dtos.ContractVersionCreateDTO{
+		ContratoID:  contract.ID,
+		Motivo:      reason,
+		DataEfetiva: effectiveDate,
+		Termos:      dtos.CreateContractTerms(contract, plan),
+	}
+}
+
+// contractStart retorna a data de inicio do contrato, que é a ativação ou, antes dela, a assinatura.
+// Os contratos cadastrados antes das datas do contrato começam no seu cadastro.
+func contractStart(contract entities.Contrato) time.Time {
+	switch {
+	case contract.DataAtivacao != nil:
+		return *contract.DataAtivacao
+	case contract.DataAssinatura != nil:
+		return *contract.DataAssinatura
+	default:
+		return contract.DataCriacao
+	}
+}
+
+// ValidateResponsibleContact verifica se o contato responsavel, quando informado, pertence ao cliente do contrato.
+func (service *contractService) ValidateResponsibleContact(ctx context.Context, clientID string, contactID string) utils.ResponseError {
+	if contactID == "" {
+		return utils.ResponseError{}
+	}
+
+	contact, err := service.contactRepository.FindContactByID(ctx, contactID)
+	if errors.Is(err, repositories.ErrNotFound) || (err == nil && contact.ClienteID != clientID) {
+		return utils.NewResponseError("contato_responsavel_id: "+utils.ContactNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	return utils.ResponseError{}
 }
 
 // NewContractService cria uma nova instancia de ContractService.
-func NewContractService(contractRepository repositories.ContractRepository, pointRepository repositories.PointRepository, contractEventService services.ContractEventService) ContractService {
+func NewContractService(contractRepository repositories.ContractRepository, pointRepository repositories.PointRepository, clientRepository repositories.ClientRepository, contactRepository repositories.ContactRepository, planRepository repositories.PlanRepository, serviceOrderRepository repositories.ServiceOrderRepository, contractEventService services.ContractEventService, contractVersionService contractVersionService.ContractVersionService, equipmentService equipmentService.EquipmentService, clientPolicies policies.ClientPolicies, logger *slog.Logger) ContractService {
 	return &contractService{
-		contractRepository:   contractRepository,
-		pointRepository:      pointRepository,
-		contractEventService: contractEventService,
+		contractRepository:     contractRepository,
+		pointRepository:        pointRepository,
+		clientRepository:       clientRepository,
+		contactRepository:      contactRepository,
+		planRepository:         planRepository,
+		serviceOrderRepository: serviceOrderRepository,
+		contractEventService:   contractEventService,
+		contractVersionService: contractVersionService,
+		equipmentService:       equipmentService,
+		clientPolicies:         clientPolicies,
+		logger:                 logger,
 	}
 }
@@ -1,43 +1,78 @@
 package services_test
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
 	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
 	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
 	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
 	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
 	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/stretchr/testify/require"
 )
 
 var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
 	// Fake Databases
-	dbClient        = repositoriesFake.DBClient
-	dbAddress       = repositoriesFake.DBAddress
-	dbPoint         = repositoriesFake.DBPoint
-	dbContract      = repositoriesFake.DBContract
-	dbContractEvent = repositoriesFake.DBContractEvent
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
 
 	// Fake Repositories
-	clientRepositoryFake        = repositoriesFake.NewClientRepositoryFake(dbClient)
-	addressRepositoryFake       = repositoriesFake.NewAddressRepositoryFake(dbAddress)
-	pointRepositoryFake         = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress)
-	contractRepositoryFake      = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint)
-	contractEventRepositoryFake = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.ClientPolicies{
+		entities.ESPECIAL: {IsentoSuspensaoAutomatica: true},
+		entities.JURIDICO: {ExigeContatoResponsavel: true},
+	}
 
 	// Services Tests
-	contractEventServiceTest = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake)
-	contractServiceTest      = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, contractEventServiceTest)
-	pointServiceTest         = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest)
-	clientServiceTest        = clientService.NewClientService(clientRepositoryFake, pointServiceTest)
-	addressServiceTest       = addressService.NewAddressService(addressRepositoryFake, pointServiceTest)
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
 )
 
 // TestCreateContract testa se é possivel criar um novo contrato.
@@ -46,26 +81,29 @@ func TestCreateContract(t *testing.T) {
 		Nome: "Test 52.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 55.0",
 		Bairro:     "BairroTest 55.0",
 		Numero:     55,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, responseError := contractServiceTest.CreateContract(contractDTO)
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	require.Empty(t, responseError)
 
@@ -80,27 +118,30 @@ func TestCreateContractWithContractExists(t *testing.T) {
 		Nome: "Test 53.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 56.0",
 		Bairro:     "BairroTest 56.0",
 		Numero:     56,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contract, responseError := contractServiceTest.CreateContract(contractDTO)
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ContractAlreadyExists, responseError.Message)
@@ -115,7 +156,7 @@ func TestCreateContractWithInvalidPointID(t *testing.T) {
 		Estado:  entities.VIGOR,
 		PontoID: "",
 	}
-	contract, responseError := contractServiceTest.CreateContract(contractDTO)
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.PointNotFound, responseError.Message)
@@ -130,28 +171,31 @@ func TestCreateContractWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 54.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 57.0",
 		Bairro:     "BairroTest 57.0",
 		Numero:     57,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	contractServiceTest.DeleteContractByID(contract.ID)
-	contract, responseError := contractServiceTest.CreateContract(contractDTO)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	contractServiceTest.DeleteContractByID(ctx, contract.ID)
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	require.Empty(t, responseError)
 
@@ -166,26 +210,29 @@ func TestUpdateContract(t *testing.T) {
 		Nome: "Test 55.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 58.0",
 		Bairro:     "BairroTest 58.0",
 		Numero:     58,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	newState := entities.DESATIVADO
 	contractUpdateDTO := dtos.ContractUpdateDTO{
@@ -194,7 +241,7 @@ func TestUpdateContract(t *testing.T) {
 		},
 		Estado: newState,
 	}
-	contractUpdated, responseError := contractServiceTest.UpdateContract(contractUpdateDTO)
+	contractUpdated, responseError := contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
 
 	require.Empty(t, responseError)
 
@@ -210,26 +257,29 @@ func TestUpdateContractWithInvalidState(t *testing.T) {
 		Nome: "Test 56.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 59.0",
 		Bairro:     "BairroTest 59.0",
 		Numero:     59,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
 
 	contractUpdateDTO := dtos.ContractUpdateDTO{
 		Base: dtos.Base{
@@ -237,7 +287,7 @@ func TestUpdateContractWithInvalidState(t *testing.T) {
 		},
 		Estado: entities.CANCELADO,
 	}
-	contractUpdated, responseError := contractServiceTest.UpdateContract(contractUpdateDTO)
+	contractUpdated, responseError := contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.Unathorized, responseError.Message)
@@ -254,7 +304,7 @@ func TestUpdateContractWithInvalidID(t *testing.T) {
 		},
 		Estado: entities.DESATIVADO,
 	}
-	contractUpdated, responseError := contractServiceTest.UpdateContract(contractUpdateDTO)
+	contractUpdated, responseError := contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ContractNotFound, responseError.Message)
@@ -269,30 +319,34 @@ func TestFindContractByID(t *testing.T) {
 		Nome: "Test 57.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 60.0",
 		Bairro:     "BairroTest 60.0",
 		Numero:     60,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
 
-	contractFound := contractServiceTest.FindContractByID(contract.ID)
+	contractFound, responseError := contractServiceTest.FindContractByID(ctx, contract.ID)
 
 	require.NotEmpty(t, contractFound)
+	require.Empty(t, responseError)
 	require.Equal(t, client.ID, contractFound.Ponto.Cliente.ID)
 	require.Equal(t, address.ID, contractFound.Ponto.Endereco.ID)
 }
@@ -303,29 +357,33 @@ func TestFindContractByIDWithInvalidID(t *testing.T) {
 		Nome: "Test 58.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 61.0",
 		Bairro:     "BairroTest 61.0",
 		Numero:     61,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contractFound := contractServiceTest.FindContractByID("")
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contractFound, responseError := contractServiceTest.FindContractByID(ctx, "")
 
 	require.Empty(t, contractFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindContractByIDWithDeletedAtValid testa se não é possivel buscar um contrato removido a partir do ID.
@@ -334,30 +392,34 @@ func TestFindContractByIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 59.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 62.0",
 		Bairro:     "BairroTest 62.0",
 		Numero:     62,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	contractServiceTest.DeleteContractByID(contract.ID)
-	contractFound := contractServiceTest.FindContractByID(contract.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	contractServiceTest.DeleteContractByID(ctx, contract.ID)
+	contractFound, responseError := contractServiceTest.FindContractByID(ctx, contract.ID)
 
 	require.Empty(t, contractFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindContractByPontoID testa se é possivel buscar um contrato não removido a partir do ID do ponto.
@@ -366,29 +428,33 @@ func TestFindContractByPontoID(t *testing.T) {
 		Nome: "Test 60.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 63.0",
 		Bairro:     "BairroTest 63.0",
 		Numero:     63,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contractFound := contractServiceTest.FindContractByPontoID(point.ID)
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contractFound, responseError := contractServiceTest.FindContractByPontoID(ctx, point.ID)
 
 	require.NotEmpty(t, contractFound)
+	require.Empty(t, responseError)
 }
 
 // TestFindContractByPontoIDWithInvalidPointID testa se não é possivel buscar um contrato não removido a partir do ID invalido do ponto.
@@ -397,62 +463,75 @@ func TestFindContractByPontoIDWithInvalidPointID(t *testing.T) {
 		Nome: "Test 61.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 64.0",
 		Bairro:     "BairroTest 64.0",
 		Numero:     64,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contractFound := contractServiceTest.FindContractByPontoID("")
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contractFound, responseError := contractServiceTest.FindContractByPontoID(ctx, "")
 
 	require.Empty(t, contractFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
-// TestFindContractByPontoIDWithDeletedAtValid testa se é possivel buscar um contrato removido a partir do ID do ponto.
+// TestFindContractByPontoIDWithDeletedAtValid testa se o contrato removido deixa de ser o contrato atual do ponto.
 func TestFindContractByPontoIDWithDeletedAtValid(t *testing.T) {
 	clientDTO := dtos.ClientCreateDTO{
 		Nome: "Test 62.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 65.0",
 		Bairro:     "BairroTest 65.0",
 		Numero:     65,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	contractServiceTest.DeleteContractByID(contract.ID)
-	contractFound := contractServiceTest.FindContractByPontoID(point.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	contractServiceTest.DeleteContractByID(ctx, contract.ID)
+	contractFound, responseError := contractServiceTest.FindContractByPontoID(ctx, point.ID)
 
-	require.NotEmpty(t, contractFound)
-	require.True(t, contractFound.DataRemocao.Valid)
+	require.Empty(t, contractFound)
+	require.Equal(t, utils.ContractNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+
+	newContract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+	require.NotEqual(t, contract.ID, newContract.ID)
 }
 
 // TestDeleteContractByID testa se é possivel excluir um contrato a partir do ID.
@@ -461,28 +540,31 @@ func TestDeleteContractByID(t *testing.T) {
 		Nome: "Test 63.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 66.0",
 		Bairro:     "BairroTest 66.0",
 		Numero:     66,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	responseError := contractServiceTest.DeleteContractByID(contract.ID)
-	contractFound := contractServiceTest.FindContractByID(contract.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	responseError := contractServiceTest.DeleteContractByID(ctx, contract.ID)
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
 
 	require.Empty(t, responseError)
 	require.Empty(t, contractFound)
@@ -494,28 +576,31 @@ func TestDeleteContractByIDWithInvalidID(t *testing.T) {
 		Nome: "Test 64.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 67.0",
 		Bairro:     "BairroTest 67.0",
 		Numero:     67,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	responseError := contractServiceTest.DeleteContractByID("")
-	contractFound := contractServiceTest.FindContractByID(contract.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	responseError := contractServiceTest.DeleteContractByID(ctx, "")
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ContractNotFound, responseError.Message)
@@ -530,28 +615,31 @@ func TestDeleteContractByIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 65.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 68.0",
 		Bairro:     "BairroTest 68.0",
 		Numero:     68,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	contractServiceTest.DeleteContractByID(contract.ID)
-	responseError := contractServiceTest.DeleteContractByID(contract.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	contractServiceTest.DeleteContractByID(ctx, contract.ID)
+	responseError := contractServiceTest.DeleteContractByID(ctx, contract.ID)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ContractNotFound, responseError.Message)
@@ -564,28 +652,31 @@ func TestDeleteContractByPontoID(t *testing.T) {
 		Nome: "Test 66.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 69.0",
 		Bairro:     "BairroTest 69.0",
 		Numero:     69,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	responseError := contractServiceTest.DeleteContractByPontoID(point.ID)
-	contractFound := contractServiceTest.FindContractByID(contract.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	responseError := contractServiceTest.DeleteContractByPontoID(ctx, point.ID)
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
 
 	require.Empty(t, responseError)
 
@@ -598,30 +689,34 @@ func TestDeleteContractByPontoIDWithInvalidPointID(t *testing.T) {
 		Nome: "Test 67.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 70.0",
 		Bairro:     "BairroTest 70.0",
 		Numero:     70,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contract, _ := contractServiceTest.CreateContract(contractDTO)
-	contractServiceTest.DeleteContractByPontoID("")
-	contractFound := contractServiceTest.FindContractByID(contract.ID)
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+	contractServiceTest.DeleteContractByPontoID(ctx, "")
+	contractFound, responseError := contractServiceTest.FindContractByID(ctx, contract.ID)
 
 	require.NotEmpty(t, contractFound)
+	require.Empty(t, responseError)
 	require.False(t, contractFound.DataRemocao.Valid)
 }
 
@@ -631,29 +726,33 @@ func TestFindContracts(t *testing.T) {
 		Nome: "Test 68.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 71.0",
 		Bairro:     "BairroTest 71.0",
 		Numero:     71,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contracts := contractServiceTest.FindContracts("", "")
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contracts, responseError := contractServiceTest.FindContracts(ctx, "", "", false)
 
 	require.NotEmpty(t, contracts)
+	require.Empty(t, responseError)
 	require.Greater(t, len(contracts), 0)
 }
 
@@ -663,29 +762,33 @@ func TestFindContractsByClientIDAndAddressID(t *testing.T) {
 		Nome: "Test 69.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 72.0",
 		Bairro:     "BairroTest 72.0",
 		Numero:     72,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contracts := contractServiceTest.FindContracts(client.ID, address.ID)
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contracts, responseError := contractServiceTest.FindContracts(ctx, client.ID, address.ID, false)
 
 	require.NotEmpty(t, contracts)
+	require.Empty(t, responseError)
 	require.Greater(t, len(contracts), 0)
 }
 
@@ -695,29 +798,33 @@ func TestFindContractsByClientID(t *testing.T) {
 		Nome: "Test 70.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 73.0",
 		Bairro:     "BairroTest 73.0",
 		Numero:     73,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contracts := contractServiceTest.FindContracts(client.ID, "")
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contracts, responseError := contractServiceTest.FindContracts(ctx, client.ID, "", false)
 
 	require.NotEmpty(t, contracts)
+	require.Empty(t, responseError)
 	require.Greater(t, len(contracts), 0)
 }
 
@@ -727,29 +834,33 @@ func TestFindContractsByAddressID(t *testing.T) {
 		Nome: "Test 71.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 74.0",
 		Bairro:     "BairroTest 74.0",
 		Numero:     74,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
-	contracts := contractServiceTest.FindContracts("", address.ID)
+	contractServiceTest.CreateContract(ctx, contractDTO)
+	contracts, responseError := contractServiceTest.FindContracts(ctx, "", address.ID, false)
 
 	require.NotEmpty(t, contracts)
+	require.Empty(t, responseError)
 	require.Greater(t, len(contracts), 0)
 }
 
@@ -759,33 +870,734 @@ func TestFindContractsWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 72.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 75.0",
 		Bairro:     "BairroTest 75.0",
 		Numero:     75,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	contractDTO := dtos.ContractCreateDTO{
 		PontoID: point.ID,
 		Estado:  entities.VIGOR,
 	}
-	contractServiceTest.CreateContract(contractDTO)
+	contractServiceTest.CreateContract(ctx, contractDTO)
 
 	for i := range *dbContract {
 		(*dbContract)[i].DataRemocao.Scan(time.Now())
 	}
 
-	contracts := contractServiceTest.FindContracts("", "")
+	contracts, responseError := contractServiceTest.FindContracts(ctx, "", "", false)
 
 	require.Empty(t, contracts)
+	require.Empty(t, responseError)
 	require.Equal(t, len(contracts), 0)
 }
+
+// TestFindContractsIncludingBranches testa se é possivel listar e resumir os contratos da matriz e das suas filiais.
+func TestFindContractsIncludingBranches(t *testing.T) {
+	parentDTO := dtos.ClientCreateDTO{
+		Nome: "Test 110.0",
+		Tipo: entities.JURIDICO,
+	}
+	parent, _ := clientServiceTest.CreateClient(ctx, parentDTO)
+
+	branchDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 110.1",
+		Tipo:     entities.JURIDICO,
+		MatrizID: parent.ID,
+	}
+	branch, _ := clientServiceTest.CreateClient(ctx, branchDTO)
+
+	subBranchDTO := dtos.ClientCreateDTO{
+		Nome:     "Test 110.2",
+		Tipo:     entities.JURIDICO,
+		MatrizID: branch.ID,
+	}
+	subBranch, _ := clientServiceTest.CreateClient(ctx, subBranchDTO)
+
+	for i, client := range []entities.Cliente{parent, branch, subBranch} {
+		addressDTO := dtos.AddressCreateDTO{
+			Cep:        "01001000",
+			Cidade:     "São Paulo",
+			Uf:         "SP",
+			Logradouro: "LogradouroTest 95.0",
+			Bairro:     "BairroTest 95.0",
+			Numero:     950 + i,
+		}
+		address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+		pointDTO := dtos.PointCreateDTO{
+			ClienteID:  client.ID,
+			EnderecoID: address.ID,
+		}
+		point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+		contactDTO := dtos.ContactCreateDTO{
+			ClienteID: client.ID,
+			Tipo:      entities.EMAIL,
+			Valor:     fmt.Sprintf("grupo%d@test.com", i),
+		}
+		contact, _ := contactServiceTest.CreateContact(ctx, contactDTO)
+
+		contractDTO := dtos.ContractCreateDTO{
+			PontoID:              point.ID,
+			Estado:               entities.VIGOR,
+			ContatoResponsavelID: contact.ID,
+		}
+		contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+
+		if client.ID == subBranch.ID {
+			contractUpdateDTO := dtos.ContractUpdateDTO{
+				Base: dtos.Base{
+					ID: contract.ID,
+				},
+				Estado: entities.DESATIVADO,
+			}
+			contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+		}
+	}
+
+	contracts, responseError := contractServiceTest.FindContracts(ctx, parent.ID, "", false)
+
+	require.Empty(t, responseError)
+	require.Len(t, contracts, 1)
+
+	contracts, responseError = contractServiceTest.FindContracts(ctx, parent.ID, "", true)
+
+	require.Empty(t, responseError)
+	require.Len(t, contracts, 3)
+
+	summary := dtos.CreateContractSummaryResponse(parent.ID, contracts)
+
+	require.Equal(t, 3, summary.Total)
+	require.Equal(t, 2, summary.PorEstado[entities.VIGOR])
+	require.Equal(t, 1, summary.PorEstado[entities.DESATIVADO])
+	require.Len(t, summary.Clientes, 3)
+	require.Equal(t, parent.ID, summary.Clientes[0].ClienteID)
+	require.Equal(t, 1, summary.Clientes[2].PorEstado[entities.DESATIVADO])
+}
+
+// TestContractWithoutResponsibleContact testa se a politica exige o contato responsavel no cadastro dos contratos
+// de clientes juridicos e na alteração do contato, sem bloquear as mudanças de estado.
+func TestContractWithoutResponsibleContact(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 111.0",
+		Tipo: entities.JURIDICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	otherClientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 111.1",
+		Tipo: entities.JURIDICO,
+	}
+	otherClient, _ := clientServiceTest.CreateClient(ctx, otherClientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 96.0",
+		Bairro:     "BairroTest 96.0",
+		Numero:     96,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Equal(t, http.StatusUnprocessableEntity, responseError.StatusCode)
+	require.Contains(t, responseError.Message, "juridico contracts require a responsible contact")
+	require.Empty(t, contract)
+
+	otherContactDTO := dtos.ContactCreateDTO{
+		ClienteID: otherClient.ID,
+		Tipo:      entities.EMAIL,
+		Valor:     "outro111@test.com",
+	}
+	otherContact, _ := contactServiceTest.CreateContact(ctx, otherContactDTO)
+
+	contractDTO.ContatoResponsavelID = otherContact.ID
+	contract, responseError = contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Equal(t, "contato_responsavel_id: "+utils.ContactNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, contract)
+
+	contactDTO := dtos.ContactCreateDTO{
+		ClienteID: client.ID,
+		Tipo:      entities.EMAIL,
+		Valor:     "responsavel111@test.com",
+	}
+	contact, _ := contactServiceTest.CreateContact(ctx, contactDTO)
+
+	contractDTO.ContatoResponsavelID = contact.ID
+	contract, responseError = contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, contact.ID, contract.ContatoResponsavelID)
+
+	contractUpdateDTO := dtos.ContractUpdateDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		Estado: entities.DESATIVADO,
+	}
+	contractUpdated, responseError := contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, contact.ID, contractUpdated.ContatoResponsavelID)
+	require.Equal(t, entities.DESATIVADO, contractUpdated.Estado)
+
+	noContact := ""
+	contractUpdateDTO.ContatoResponsavelID = &noContact
+	contractUpdateDTO.Estado = entities.VIGOR
+	contractUpdated, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Equal(t, http.StatusUnprocessableEntity, responseError.StatusCode)
+	require.Empty(t, contractUpdated)
+
+	// O contrato anterior à politica, sem o contato responsavel, continua podendo mudar de estado.
+	for i, contractValue := range *dbContract {
+		if contractValue.ID == contract.ID {
+			(*dbContract)[i].ContatoResponsavelID = ""
+		}
+	}
+
+	contractUpdateDTO.ContatoResponsavelID = nil
+	contractUpdateDTO.Estado = entities.CANCELADO
+	contractUpdated, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.CANCELADO, contractUpdated.Estado)
+}
+
+// TestUpdateContractAutomaticSuspensionOfEspecialClient testa se os contratos de clientes especiais não são suspensos automaticamente.
+func TestUpdateContractAutomaticSuspensionOfEspecialClient(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 112.0",
+		Tipo: entities.ESPECIAL,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 97.0",
+		Bairro:     "BairroTest 97.0",
+		Numero:     97,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+	}
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	contractUpdateDTO := dtos.ContractUpdateDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		Estado:     entities.DESATIVADO,
+		Automatica: true,
+	}
+	contractUpdated, responseError := contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Equal(t, http.StatusUnprocessableEntity, responseError.StatusCode)
+	require.Contains(t, responseError.Message, "especial clients are exempt from automatic suspension")
+	require.Empty(t, contractUpdated)
+
+	contractUpdateDTO.Automatica = false
+	contractUpdated, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.DESATIVADO, contractUpdated.Estado)
+}
+
+// TestChangeContractPlan testa se é possivel trocar o plano do contrato, registrando a troca no historico.
+func TestChangeContractPlan(t *testing.T) {
+	basicPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 6.0", Velocidade: 100, PrecoMensal: 5990})
+	premiumPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 6.1", Velocidade: 500, PrecoMensal: 11990})
+
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 115.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 100.0",
+		Bairro:     "BairroTest 100.0",
+		Numero:     100,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+		PlanoID: basicPlan.ID,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	planChangeDTO := dtos.ContractPlanChangeDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		PlanoID: premiumPlan.ID,
+	}
+	contractUpdated, responseError := contractServiceTest.ChangePlan(ctx, planChangeDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, premiumPlan.ID, contractUpdated.PlanoID)
+	require.Equal(t, premiumPlan.ID, dtos.CreateContractResponse(contractUpdated).Plano.ID)
+
+	contractEvents, _ := contractEventServiceTest.FindContractEventsByContractID(ctx, contract.ID)
+	planEvents := []entities.ContratoEvento{}
+
+	for _, contractEvent := range contractEvents {
+		if contractEvent.Tipo == entities.PLANO {
+			planEvents = append(planEvents, contractEvent)
+		}
+	}
+
+	require.Len(t, planEvents, 2)
+	require.Equal(t, basicPlan.ID, planEvents[1].PlanoAnteriorID)
+	require.Equal(t, premiumPlan.ID, planEvents[1].PlanoPosteriorID)
+
+	contractUpdated, responseError = contractServiceTest.ChangePlan(ctx, planChangeDTO)
+
+	require.Equal(t, utils.InvalidPlanChange, responseError.Message)
+	require.Empty(t, contractUpdated)
+
+	pastDate := time.Now().AddDate(0, -1, 0)
+	planChangeDTO.PlanoID = basicPlan.ID
+	planChangeDTO.DataEfetiva = &pastDate
+	contractUpdated, responseError = contractServiceTest.ChangePlan(ctx, planChangeDTO)
+
+	require.Equal(t, "data_efetiva: "+utils.InvalidEffectiveDate, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, contractUpdated)
+}
+
+// TestScheduleContractPlanChange testa se a troca de plano com a data efetiva futura é agendada, mantendo o plano
+// atual do contrato até que a rotina das trocas agendadas a aplique.
+func TestScheduleContractPlanChange(t *testing.T) {
+	basicPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 6.2", Velocidade: 100, PrecoMensal: 5990})
+	premiumPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 6.3", Velocidade: 500, PrecoMensal: 11990})
+
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 115.1",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 100.1",
+		Bairro:     "BairroTest 100.1",
+		Numero:     1001,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+		PlanoID: basicPlan.ID,
+	}
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	effectiveDate := time.Now().AddDate(0, 1, 0)
+	planChangeDTO := dtos.ContractPlanChangeDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		PlanoID:     premiumPlan.ID,
+		DataEfetiva: &effectiveDate,
+	}
+	contractUpdated, responseError := contractServiceTest.ChangePlan(ctx, planChangeDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, basicPlan.ID, contractUpdated.PlanoID)
+
+	contractEvents, _ := contractEventServiceTest.FindContractEventsByContractID(ctx, contract.ID)
+	scheduledEvent := contractEvents[len(contractEvents)-1]
+
+	require.Equal(t, entities.PLANO, scheduledEvent.Tipo)
+	require.Equal(t, basicPlan.ID, scheduledEvent.PlanoAnteriorID)
+	require.Equal(t, premiumPlan.ID, scheduledEvent.PlanoPosteriorID)
+	require.True(t, effectiveDate.Equal(*scheduledEvent.DataEfetiva))
+
+	contractUpdated, responseError = contractServiceTest.ChangePlan(ctx, planChangeDTO)
+
+	require.Equal(t, utils.InvalidPlanChange, responseError.Message)
+	require.Empty(t, contractUpdated)
+
+	planChangeDTO.PlanoID = basicPlan.ID
+	planChangeDTO.DataEfetiva = nil
+	contractUpdated, responseError = contractServiceTest.ChangePlan(ctx, planChangeDTO)
+
+	require.Equal(t, "data_efetiva: "+utils.InvalidEffectiveDate, responseError.Message)
+	require.Empty(t, contractUpdated)
+
+	applied, responseError := contractServiceTest.ApplyScheduledPlanChanges(ctx, time.Now())
+
+	require.Empty(t, responseError)
+	require.Zero(t, applied)
+
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
+	require.Equal(t, basicPlan.ID, contractFound.PlanoID)
+
+	applied, responseError = contractServiceTest.ApplyScheduledPlanChanges(ctx, effectiveDate.AddDate(0, 0, 1))
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, applied)
+
+	contractFound, _ = contractServiceTest.FindContractByID(ctx, contract.ID)
+	require.Equal(t, premiumPlan.ID, contractFound.PlanoID)
+
+	versions, _ := contractVersionServiceTest.FindContractVersions(ctx, contract.ID)
+	lastVersion := versions[len(versions)-1]
+
+	require.Equal(t, entities.TROCA_PLANO, lastVersion.Motivo)
+	require.True(t, effectiveDate.Equal(lastVersion.DataEfetiva))
+
+	applied, responseError = contractServiceTest.ApplyScheduledPlanChanges(ctx, effectiveDate.AddDate(0, 0, 1))
+
+	require.Empty(t, responseError)
+	require.Zero(t, applied)
+}
+
+// TestCreateContractWithDates testa se o contrato calcula o fim da fidelidade a partir da ativação e valida as datas.
+func TestCreateContractWithDates(t *testing.T) {
+	plan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 8.0", Velocidade: 300, PrecoMensal: 9990, MesesFidelidade: 12, MultaFidelidade: 12000})
+
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 123.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 108.0",
+		Bairro:     "BairroTest 108.0",
+		Numero:     108,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	signature := time.Date(2024, time.January, 10, 0, 0, 0, 0, time.Local)
+	activation := time.Date(2024, time.January, 15, 0, 0, 0, 0, time.Local)
+	invalidActivation := time.Date(2024, time.January, 5, 0, 0, 0, 0, time.Local)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID:        point.ID,
+		Estado:         entities.VIGOR,
+		PlanoID:        plan.ID,
+		DataAssinatura: &signature,
+		DataAtivacao:   &invalidActivation,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Equal(t, "data_ativacao: "+utils.InvalidContractDates, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, contract)
+
+	contractDTO.DataAtivacao = &activation
+	contractDTO.DataTermino = &signature
+	contract, responseError = contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Equal(t, "data_termino: "+utils.InvalidContractDates, responseError.Message)
+	require.Empty(t, contract)
+
+	contractDTO.DataTermino = nil
+	contract, responseError = contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+	require.True(t, signature.Equal(*contract.DataAssinatura))
+	require.True(t, activation.Equal(*contract.DataAtivacao))
+	require.True(t, activation.AddDate(1, 0, 0).Equal(*contract.DataFimFidelidade))
+	require.Nil(t, contract.DataTermino)
+}
+
+// TestCancelContractWithTerminationFee testa se o cancelamento antes do fim da fidelidade calcula a multa proporcional.
+func TestCancelContractWithTerminationFee(t *testing.T) {
+	plan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 8.1", Velocidade: 300, PrecoMensal: 9990, MesesFidelidade: 12, MultaFidelidade: 12000})
+
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 124.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 109.0",
+		Bairro:     "BairroTest 109.0",
+		Numero:     109,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	activation := time.Now().AddDate(0, -3, 0)
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID:        point.ID,
+		Estado:         entities.VIGOR,
+		PlanoID:        plan.ID,
+		DataAssinatura: &activation,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	for _, state := range []entities.ContractState{entities.DESATIVADO, entities.CANCELADO} {
+		contractUpdateDTO := dtos.ContractUpdateDTO{
+			Base: dtos.Base{
+				ID: contract.ID,
+			},
+			Estado: state,
+		}
+		contract, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+		require.Empty(t, responseError)
+	}
+
+	expectedFee := dtos.TerminationFee(contract, plan, time.Now())
+
+	require.InDelta(t, 9000, contract.MultaRescisao, 100)
+	require.InDelta(t, expectedFee, contract.MultaRescisao, 1)
+	require.Equal(t, contract.MultaRescisao, dtos.CreateContractResponse(contract).MultaRescisao)
+
+	contract.DataFimFidelidade = &activation
+
+	require.Zero(t, dtos.TerminationFee(contract, plan, time.Now()))
+}
+
+// TestFindContractsForRenewal testa se os contratos em vigor com o termino nos proximos dias são listados para a
+// renovação.
+func TestFindContractsForRenewal(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 125.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 110.0",
+		Bairro:     "BairroTest 110.0",
+		Numero:     110,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	endDate := time.Now().AddDate(0, 0, 10)
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID:     point.ID,
+		Estado:      entities.VIGOR,
+		DataTermino: &endDate,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	contracts, responseError := contractServiceTest.FindContractsForRenewal(ctx, contractService.RenewalWindow)
+
+	require.Empty(t, responseError)
+
+	contractIDs := []string{}
+	for _, contractValue := range contracts {
+		contractIDs = append(contractIDs, contractValue.ID)
+	}
+
+	require.Contains(t, contractIDs, contract.ID)
+
+	contracts, responseError = contractServiceTest.FindContractsForRenewal(ctx, 5)
+
+	require.Empty(t, responseError)
+
+	for _, contractValue := range contracts {
+		require.NotEqual(t, contract.ID, contractValue.ID)
+	}
+
+	contractUpdateDTO := dtos.ContractUpdateDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		Estado: entities.DESATIVADO,
+	}
+	_, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Empty(t, responseError)
+
+	contracts, responseError = contractServiceTest.FindContractsForRenewal(ctx, contractService.RenewalWindow)
+
+	require.Empty(t, responseError)
+
+	for _, contractValue := range contracts {
+		require.NotEqual(t, contract.ID, contractValue.ID)
+	}
+
+	contracts, responseError = contractServiceTest.FindContractsForRenewal(ctx, 0)
+
+	require.Equal(t, utils.InvalidRenewalWindow, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, contracts)
+}
+
+// TestRenewContract testa se a renovação registra a nova data de termino em uma nova versão, sem alterar o
+// cadastro do contrato, e valida a nova data.
+func TestRenewContract(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 128.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 113.0",
+		Bairro:     "BairroTest 113.0",
+		Numero:     113,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	endDate := time.Now().AddDate(1, 0, 0)
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID:     point.ID,
+		Estado:      entities.VIGOR,
+		DataTermino: &endDate,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	renewalDTO := dtos.ContractRenewalDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		DataTermino: endDate.AddDate(-1, 6, 0),
+	}
+	contractRenewed, responseError := contractServiceTest.RenewContract(ctx, renewalDTO)
+
+	require.Equal(t, "data_termino: "+utils.InvalidRenewal, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, contractRenewed)
+
+	renewalDTO.DataTermino = endDate.AddDate(1, 0, 0)
+	contractRenewed, responseError = contractServiceTest.RenewContract(ctx, renewalDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, renewalDTO.DataTermino.Format(dtos.DateLayout), dtos.ContractTermination(contractRenewed).Format(dtos.DateLayout))
+
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
+
+	require.True(t, endDate.Equal(*contractFound.DataTermino))
+	require.Equal(t, contractRenewed.VersaoID, contractFound.VersaoID)
+	require.Equal(t, renewalDTO.DataTermino.Format(dtos.DateLayout), dtos.ContractTermination(contractFound).Format(dtos.DateLayout))
+
+	contractVersions, responseError := contractVersionServiceTest.FindContractVersions(ctx, contract.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, contractVersions, 2)
+	require.Equal(t, entities.CADASTRO, contractVersions[0].Motivo)
+	require.Equal(t, entities.RENOVACAO, contractVersions[1].Motivo)
+	require.Equal(t, contractVersions[1].ID, contractFound.VersaoID)
+
+	contractUpdateDTO := dtos.ContractUpdateDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		Estado: entities.DESATIVADO,
+	}
+	_, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Empty(t, responseError)
+
+	contractUpdateDTO.Estado = entities.CANCELADO
+	_, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+	require.Empty(t, responseError)
+
+	renewalDTO.DataTermino = endDate.AddDate(2, 0, 0)
+	contractRenewed, responseError = contractServiceTest.RenewContract(ctx, renewalDTO)
+
+	require.Equal(t, utils.InvalidRenewal, responseError.Message)
+	require.Empty(t, contractRenewed)
+}
@@ -0,0 +1,184 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service")
+
+// ContractVersionService representa a interface de ContractVersionService.
+type ContractVersionService interface {
+	CreateContractVersion(ctx context.Context, contractVersionDTO dtos.ContractVersionCreateDTO) (entities.ContratoVersao, utils.ResponseError)
+	FindContractVersions(ctx context.Context, contractID string) ([]entities.ContratoVersao, utils.ResponseError)
+	FindContractVersionAt(ctx context.Context, contractID string, date time.Time) (entities.ContratoVersao, utils.ResponseError)
+	DiffContractVersions(ctx context.Context, contractID string, from int, to int) (dtos.ContractVersionDiffResponse, utils.ResponseError)
+}
+
+type contractVersionService struct {
+	contractVersionRepository repositories.ContractVersionRepository
+	contractRepository        repositories.ContractRepository
+	logger                    *slog.Logger
+}
+
+// CreateContractVersion registra os termos do contrato como a sua próxima versão, ligada à versão anterior, que
+// passa a ser a versão atual do contrato.
+func (service *contractVersionService) CreateContractVersion(ctx context.Context, contractVersionDTO dtos.ContractVersionCreateDTO) (entities.ContratoVersao, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractVersionService.CreateContractVersion")
+	defer span.End()
+
+	contractVersions, err := service.contractVersionRepository.FindContractVersionsByContractID(ctx, contractVersionDTO.ContratoID)
+	if err != nil {
+		return entities.ContratoVersao{}, utils.NewInternalResponseError(err)
+	}
+
+	previousVersion := entities.ContratoVersao{}
+	if len(contractVersions) != 0 {
+		previousVersion = contractVersions[len(contractVersions)-1]
+	}
+
+	terms, err := json.Marshal(contractVersionDTO.Termos)
+	if err != nil {
+		return entities.ContratoVersao{}, utils.NewInternalResponseError(err)
+	}
+
+	contractVersion := entities.ContratoVersao{
+		ContratoID:       contractVersionDTO.ContratoID,
+		Numero:           previousVersion.Numero + 1,
+		VersaoAnteriorID: previousVersion.ID,
+		Motivo:           contractVersionDTO.Motivo,
+		DataEfetiva:      contractVersionDTO.DataEfetiva,
+		Termos:           string(terms),
+	}
+
+	contractVersion.DataCriacao = time.Now()
+	contractVersion.DataAtualizacao = time.Now()
+
+	contractVersion, err = service.contractVersionRepository.CreateContractVersion(ctx, contractVersion)
+	if err != nil {
+		return entities.ContratoVersao{}, utils.NewInternalResponseError(err)
+	}
+
+	err = service.contractRepository.UpdateCurrentVersion(ctx, contractVersion.ContratoID, contractVersion.ID)
+	if err != nil {
+		return entities.ContratoVersao{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "contract version created", slog.String("contrato_id", contractVersion.ContratoID),
+		slog.Int("numero", contractVersion.Numero), slog.String("motivo", string(contractVersion.Motivo)),
+		slog.Time("data_efetiva", contractVersion.DataEfetiva))
+
+	return contractVersion, utils.ResponseError{}
+}
+
+func (service *contractVersionService) FindContractVersions(ctx context.Context, contractID string) ([]entities.ContratoVersao, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractVersionService.FindContractVersions")
+	defer span.End()
+
+	_, err := service.contractRepository.FindContractByID(ctx, contractID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, utils.NewResponseError(utils.ContractNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	contractVersions, err := service.contractVersionRepository.FindContractVersionsByContractID(ctx, contractID)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return contractVersions, utils.ResponseError{}
+}
+
+// FindContractVersionAt pesquisa a versão do contrato em vigor na data informada, que é a ultima versão
+// registrada com a data efetiva até essa data.
+func (service *contractVersionService) FindContractVersionAt(ctx context.Context, contractID string, date time.Time) (entities.ContratoVersao, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractVersionService.FindContractVersionAt")
+	defer span.End()
+
+	contractVersions, responseError := service.FindContractVersions(ctx, contractID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.ContratoVersao{}, responseError
+	}
+
+	contractVersionFound := entities.ContratoVersao{}
+
+	for _, contractVersion := range contractVersions {
+		if !contractVersion.DataEfetiva.After(date) {
+			contractVersionFound = contractVersion
+		}
+	}
+
+	if contractVersionFound.ID == "" {
+		return entities.ContratoVersao{}, utils.NewResponseError(utils.ContractVersionNotFound, http.StatusNotFound)
+	}
+
+	return contractVersionFound, utils.ResponseError{}
+}
+
+// DiffContractVersions compara os termos de duas versões do contrato. Sem a versão final, é usada a ultima
+// versão, e sem a versão inicial, a versão que antecede a final.
+func (service *contractVersionService) DiffContractVersions(ctx context.Context, contractID string, from int, to int) (dtos.ContractVersionDiffResponse, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ContractVersionService.DiffContractVersions")
+	defer span.End()
+
+	contractVersions, responseError := service.FindContractVersions(ctx, contractID)
+	if responseError != (utils.ResponseError{}) {
+		return dtos.ContractVersionDiffResponse{}, responseError
+	}
+
+	if len(contractVersions) == 0 {
+		return dtos.ContractVersionDiffResponse{}, utils.NewResponseError(utils.ContractVersionNotFound, http.StatusNotFound)
+	}
+
+	if to == 0 {
+		to = contractVersions[len(contractVersions)-1].Numero
+	}
+
+	if from == 0 {
+		from = to - 1
+	}
+
+	if from < 0 || to < 0 || from == to {
+		return dtos.ContractVersionDiffResponse{}, utils.NewResponseError(utils.InvalidVersionDiff, http.StatusBadRequest)
+	}
+
+	fromVersion, toVersion := entities.ContratoVersao{}, entities.ContratoVersao{}
+
+	for _, contractVersion := range contractVersions {
+		switch contractVersion.Numero {
+		case from:
+			fromVersion = contractVersion
+		case to:
+			toVersion = contractVersion
+		}
+	}
+
+	if fromVersion.ID == "" || toVersion.ID == "" {
+		return dtos.ContractVersionDiffResponse{}, utils.NewResponseError(utils.ContractVersionNotFound, http.StatusNotFound)
+	}
+
+	return dtos.CreateContractVersionDiffResponse(fromVersion, toVersion), utils.ResponseError{}
+}
+
+// NewContractVersionService cria uma nova instancia de ContractVersionService.
+func NewContractVersionService(contractVersionRepository repositories.ContractVersionRepository, contractRepository repositories.ContractRepository, logger *slog.Logger) ContractVersionService {
+	return &contractVersionService{
+		contractVersionRepository: contractVersionRepository,
+		contractRepository:        contractRepository,
+		logger:                    logger,
+	}
+}
@@ -0,0 +1,208 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+)
+
+// createVersionedContract cria um contrato em vigor com o plano informado para os testes das versões.
+func createVersionedContract(t *testing.T, name string, street string, number int, planID string) entities.Contrato {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: name,
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: street,
+		Bairro:     "BairroTest",
+		Numero:     number,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+		PlanoID: planID,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	return contract
+}
+
+// TestFindContractVersions testa se as alterações dos termos do contrato criam versões ligadas à versão anterior.
+func TestFindContractVersions(t *testing.T) {
+	plan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 9.0", Velocidade: 100, PrecoMensal: 5990, MesesFidelidade: 12})
+	contract := createVersionedContract(t, "Test 126.0", "LogradouroTest 111.0", 111, plan.ID)
+
+	contractVersions, responseError := contractVersionServiceTest.FindContractVersions(ctx, contract.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, contractVersions, 1)
+	require.Equal(t, 1, contractVersions[0].Numero)
+	require.Equal(t, entities.CADASTRO, contractVersions[0].Motivo)
+	require.Empty(t, contractVersions[0].VersaoAnteriorID)
+	require.Equal(t, plan.ID, dtos.CreateContractVersionResponse(contractVersions[0]).Termos.PlanoID)
+
+	effectiveDate := time.Now().AddDate(0, 1, 0)
+	renewalDTO := dtos.ContractRenewalDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		DataTermino: time.Now().AddDate(2, 0, 0),
+		DataEfetiva: &effectiveDate,
+	}
+	_, responseError = contractServiceTest.RenewContract(ctx, renewalDTO)
+
+	require.Empty(t, responseError)
+
+	contractVersions, responseError = contractVersionServiceTest.FindContractVersions(ctx, contract.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, contractVersions, 2)
+	require.Equal(t, entities.RENOVACAO, contractVersions[1].Motivo)
+	require.Equal(t, contractVersions[0].ID, contractVersions[1].VersaoAnteriorID)
+
+	contractVersion, responseError := contractVersionServiceTest.FindContractVersionAt(ctx, contract.ID, time.Now())
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, contractVersion.Numero)
+
+	contractVersion, responseError = contractVersionServiceTest.FindContractVersionAt(ctx, contract.ID, effectiveDate)
+
+	require.Empty(t, responseError)
+	require.Equal(t, 2, contractVersion.Numero)
+
+	contractVersion, responseError = contractVersionServiceTest.FindContractVersionAt(ctx, contract.ID, time.Now().AddDate(-1, 0, 0))
+
+	require.Equal(t, utils.ContractVersionNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, contractVersion)
+
+	contractVersions, responseError = contractVersionServiceTest.FindContractVersions(ctx, "invalid-contract-id")
+
+	require.Equal(t, utils.ContractNotFound, responseError.Message)
+	require.Empty(t, contractVersions)
+}
+
+// TestDiffContractVersions testa se a comparação entre duas versões retorna apenas os termos alterados.
+func TestDiffContractVersions(t *testing.T) {
+	basicPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 9.1", Velocidade: 100, PrecoMensal: 5990})
+	premiumPlan, _ := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 9.2", Velocidade: 500, PrecoMensal: 11990})
+	contract := createVersionedContract(t, "Test 127.0", "LogradouroTest 112.0", 112, basicPlan.ID)
+
+	planChangeDTO := dtos.ContractPlanChangeDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		PlanoID: premiumPlan.ID,
+	}
+	_, responseError := contractServiceTest.ChangePlan(ctx, planChangeDTO)
+
+	require.Empty(t, responseError)
+
+	diffResponse, responseError := contractVersionServiceTest.DiffContractVersions(ctx, contract.ID, 0, 0)
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, diffResponse.De.Numero)
+	require.Equal(t, 2, diffResponse.Para.Numero)
+	require.Equal(t, entities.TROCA_PLANO, diffResponse.Para.Motivo)
+
+	fields := []string{}
+	for _, change := range diffResponse.Alteracoes {
+		fields = append(fields, change.Campo)
+	}
+
+	require.Equal(t, []string{"plano_id", "plano_nome", "preco_mensal", "velocidade"}, fields)
+	require.Equal(t, "PlanoTest 9.1", diffResponse.Alteracoes[1].Anterior)
+	require.Equal(t, "PlanoTest 9.2", diffResponse.Alteracoes[1].Posterior)
+
+	diffResponse, responseError = contractVersionServiceTest.DiffContractVersions(ctx, contract.ID, 2, 2)
+
+	require.Equal(t, utils.InvalidVersionDiff, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, diffResponse)
+
+	diffResponse, responseError = contractVersionServiceTest.DiffContractVersions(ctx, contract.ID, 1, 3)
+
+	require.Equal(t, utils.ContractVersionNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, diffResponse)
+}
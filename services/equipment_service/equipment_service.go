@@ -0,0 +1,265 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service")
+
+// EquipmentService representa a interface de EquipmentService.
+type EquipmentService interface {
+	CreateEquipment(ctx context.Context, equipmentDTO dtos.EquipmentCreateDTO) (entities.Equipamento, utils.ResponseError)
+	FindEquipmentByID(ctx context.Context, equipmentID string) (entities.Equipamento, utils.ResponseError)
+	FindEquipments(ctx context.Context, filter dtos.EquipmentFilter) ([]entities.Equipamento, utils.ResponseError)
+	FindEquipmentsToReturn(ctx context.Context) ([]entities.Equipamento, utils.ResponseError)
+	FindEquipmentMovements(ctx context.Context, equipmentID string) ([]entities.EquipamentoMovimentacao, utils.ResponseError)
+	MoveEquipment(ctx context.Context, movementDTO dtos.EquipmentMovementDTO) (entities.Equipamento, utils.ResponseError)
+	FlagEquipmentsForReturn(ctx context.Context, pointID string, reason string) utils.ResponseError
+}
+
+type equipmentService struct {
+	equipmentRepository         repositories.EquipmentRepository
+	equipmentMovementRepository repositories.EquipmentMovementRepository
+	pointRepository             repositories.PointRepository
+	logger                      *slog.Logger
+}
+
+func (service *equipmentService) CreateEquipment(ctx context.Context, equipmentDTO dtos.EquipmentCreateDTO) (entities.Equipamento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "EquipmentService.CreateEquipment")
+	defer span.End()
+
+	mac, err := net.ParseMAC(strings.TrimSpace(equipmentDTO.Mac))
+	if err != nil {
+		return entities.Equipamento{}, utils.NewResponseError(utils.InvalidMAC, http.StatusBadRequest)
+	}
+
+	serial := strings.TrimSpace(equipmentDTO.Serial)
+
+	_, err = service.equipmentRepository.FindEquipmentBySerial(ctx, serial)
+	if err == nil {
+		return entities.Equipamento{}, utils.NewResponseError(utils.EquipmentAlreadyExists, http.StatusConflict)
+	}
+
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Equipamento{}, utils.NewInternalResponseError(err)
+	}
+
+	equipment := entities.Equipamento{
+		Serial: serial,
+		Modelo: strings.TrimSpace(equipmentDTO.Modelo),
+		Mac:    strings.ToUpper(mac.String()),
+		Estado: entities.ESTOQUE,
+	}
+
+	equipment, err = service.equipmentRepository.CreateEquipment(ctx, equipment)
+	if err != nil {
+		return entities.Equipamento{}, utils.NewInternalResponseError(err)
+	}
+
+	responseError := service.createEquipmentMovement(ctx, entities.Equipamento{}, equipment, entities.EQUIPAMENTO_CADASTRADO)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Equipamento{}, responseError
+	}
+
+	service.logger.InfoContext(ctx, "equipment created", slog.String("equipamento_id", equipment.ID),
+		slog.String("serial", equipment.Serial))
+
+	return equipment, utils.ResponseError{}
+}
+
+func (service *equipmentService) FindEquipmentByID(ctx context.Context, equipmentID string) (entities.Equipamento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "EquipmentService.FindEquipmentByID")
+	defer span.End()
+
+	equipment, err := service.equipmentRepository.FindEquipmentByID(ctx, equipmentID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Equipamento{}, utils.NewResponseError(utils.EquipmentNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Equipamento{}, utils.NewInternalResponseError(err)
+	}
+
+	return equipment, utils.ResponseError{}
+}
+
+func (service *equipmentService) FindEquipments(ctx context.Context, filter dtos.EquipmentFilter) ([]entities.Equipamento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "EquipmentService.FindEquipments")
+	defer span.End()
+
+	equipments, err := service.equipmentRepository.FindEquipments(ctx, filter)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return equipments, utils.ResponseError{}
+}
+
+// FindEquipmentsToReturn busca os equipamentos que ainda estão instalados em pontos removidos ou com o
+// contrato cancelado.
+func (service *equipmentService) FindEquipmentsToReturn(ctx context.Context) ([]entities.Equipamento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "EquipmentService.FindEquipmentsToReturn")
+	defer span.End()
+
+	equipments, err := service.equipmentRepository.FindEquipmentsToReturn(ctx)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return equipments, utils.ResponseError{}
+}
+
+func (service *equipmentService) FindEquipmentMovements(ctx context.Context, equipmentID string) ([]entities.EquipamentoMovimentacao, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "EquipmentService.FindEquipmentMovements")
+	defer span.End()
+
+	_, responseError := service.FindEquipmentByID(ctx, equipmentID)
+	if responseError != (utils.ResponseError{}) {
+		return nil, responseError
+	}
+
+	movements, err := service.equipmentMovementRepository.FindEquipmentMovementsByEquipmentID(ctx, equipmentID)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return movements, utils.ResponseError{}
+}
+
+// MoveEquipment altera o estado e o ponto do equipamento, registrando a movimentação. Apenas os equipamentos
+// instalados ficam vinculados a um ponto, e qualquer movimentação resolve a devolução pendente.
+func (service *equipmentService) MoveEquipment(ctx context.Context, movementDTO dtos.EquipmentMovementDTO) (entities.Equipamento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "EquipmentService.MoveEquipment")
+	defer span.End()
+
+	equipment, responseError := service.FindEquipmentByID(ctx, movementDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Equipamento{}, responseError
+	}
+
+	pointID := ""
+
+	if movementDTO.Estado == entities.INSTALADO {
+		if movementDTO.PontoID == "" {
+			return entities.Equipamento{}, utils.NewResponseError("ponto_id: "+utils.InvalidEquipmentMovement, http.StatusBadRequest)
+		}
+
+		_, err := service.pointRepository.FindPointByID(ctx, movementDTO.PontoID)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return entities.Equipamento{}, utils.NewResponseError("ponto_id: "+utils.PointNotFound, http.StatusNotFound)
+		}
+
+		if err != nil {
+			return entities.Equipamento{}, utils.NewInternalResponseError(err)
+		}
+
+		pointID = movementDTO.PontoID
+	}
+
+	if equipment.Estado == movementDTO.Estado && equipment.PontoID == pointID {
+		return entities.Equipamento{}, utils.NewResponseError(utils.InvalidEquipmentMovement, http.StatusConflict)
+	}
+
+	previous := equipment
+
+	equipment.Estado = movementDTO.Estado
+	equipment.PontoID = pointID
+	equipment.DevolucaoPendente = false
+
+	equipment, err := service.equipmentRepository.UpdateEquipment(ctx, equipment)
+	if err != nil {
+		return entities.Equipamento{}, utils.NewInternalResponseError(err)
+	}
+
+	responseError = service.createEquipmentMovement(ctx, previous, equipment, strings.TrimSpace(movementDTO.Motivo))
+	if responseError != (utils.ResponseError{}) {
+		return entities.Equipamento{}, responseError
+	}
+
+	service.logger.InfoContext(ctx, "equipment moved", slog.String("equipamento_id", equipment.ID),
+		slog.String("estado_anterior", string(previous.Estado)), slog.String("estado", string(equipment.Estado)),
+		slog.String("ponto_id", equipment.PontoID))
+
+	return equipment, utils.ResponseError{}
+}
+
+// FlagEquipmentsForReturn sinaliza a devolução dos equipamentos instalados no ponto, usado na remoção do ponto
+// e no cancelamento do contrato. O equipamento continua vinculado ao ponto até ser recolhido.
+func (service *equipmentService) FlagEquipmentsForReturn(ctx context.Context, pointID string, reason string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "EquipmentService.FlagEquipmentsForReturn")
+	defer span.End()
+
+	pending := false
+
+	equipments, err := service.equipmentRepository.FindEquipments(ctx, dtos.EquipmentFilter{
+		PontoID:           pointID,
+		Estado:            entities.INSTALADO,
+		DevolucaoPendente: &pending,
+	})
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	for _, equipment := range equipments {
+		previous := equipment
+		equipment.DevolucaoPendente = true
+
+		equipment, err = service.equipmentRepository.UpdateEquipment(ctx, equipment)
+		if err != nil {
+			return utils.NewInternalResponseError(err)
+		}
+
+		responseError := service.createEquipmentMovement(ctx, previous, equipment, reason)
+		if responseError != (utils.ResponseError{}) {
+			return responseError
+		}
+	}
+
+	if len(equipments) > 0 {
+		service.logger.InfoContext(ctx, "equipments flagged for return", slog.String("ponto_id", pointID),
+			slog.String("motivo", reason), slog.Int("total", len(equipments)))
+	}
+
+	return utils.ResponseError{}
+}
+
+// createEquipmentMovement registra a movimentação do equipamento entre os estados e pontos.
+func (service *equipmentService) createEquipmentMovement(ctx context.Context, previous entities.Equipamento, equipment entities.Equipamento, reason string) utils.ResponseError {
+	movement := entities.EquipamentoMovimentacao{
+		EquipamentoID:    equipment.ID,
+		EstadoAnterior:   previous.Estado,
+		EstadoPosterior:  equipment.Estado,
+		PontoAnteriorID:  previous.PontoID,
+		PontoPosteriorID: equipment.PontoID,
+		Motivo:           reason,
+	}
+
+	_, err := service.equipmentMovementRepository.CreateEquipmentMovement(ctx, movement)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	return utils.ResponseError{}
+}
+
+// NewEquipmentService cria uma nova instancia de EquipmentService.
+func NewEquipmentService(equipmentRepository repositories.EquipmentRepository, equipmentMovementRepository repositories.EquipmentMovementRepository, pointRepository repositories.PointRepository, logger *slog.Logger) EquipmentService {
+	return &equipmentService{
+		equipmentRepository:         equipmentRepository,
+		equipmentMovementRepository: equipmentMovementRepository,
+		pointRepository:             pointRepository,
+		logger:                      logger,
+	}
+}
@@ -0,0 +1,282 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/services/fixtures"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+
+	// Fixtures
+	pointFactory = fixtures.NewPointFactory(clientServiceTest, addressServiceTest, pointServiceTest)
+)
+
+// installEquipment cadastra um equipamento e o instala no ponto.
+func installEquipment(serial string, mac string, pointID string) entities.Equipamento {
+	equipmentDTO := dtos.EquipmentCreateDTO{
+		Serial: serial,
+		Modelo: "ONT Test",
+		Mac:    mac,
+	}
+	equipment, _ := equipmentServiceTest.CreateEquipment(ctx, equipmentDTO)
+
+	movementDTO := dtos.EquipmentMovementDTO{
+		Base: dtos.Base{
+			ID: equipment.ID,
+		},
+		Estado:  entities.INSTALADO,
+		PontoID: pointID,
+	}
+	equipment, _ = equipmentServiceTest.MoveEquipment(ctx, movementDTO)
+
+	return equipment
+}
+
+// TestCreateEquipment testa se é possivel cadastrar um equipamento no estoque, com o MAC normalizado.
+func TestCreateEquipment(t *testing.T) {
+	equipmentDTO := dtos.EquipmentCreateDTO{
+		Serial: " SN-0001 ",
+		Modelo: "Roteador Test",
+		Mac:    "aa-bb-cc-dd-ee-01",
+	}
+	equipment, responseError := equipmentServiceTest.CreateEquipment(ctx, equipmentDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, "SN-0001", equipment.Serial)
+	require.Equal(t, "AA:BB:CC:DD:EE:01", equipment.Mac)
+	require.Equal(t, entities.ESTOQUE, equipment.Estado)
+	require.Empty(t, equipment.PontoID)
+
+	movements, responseError := equipmentServiceTest.FindEquipmentMovements(ctx, equipment.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, movements, 1)
+	require.Equal(t, entities.ESTOQUE, movements[0].EstadoPosterior)
+	require.Equal(t, entities.EQUIPAMENTO_CADASTRADO, movements[0].Motivo)
+
+	equipment, responseError = equipmentServiceTest.CreateEquipment(ctx, equipmentDTO)
+
+	require.Equal(t, utils.EquipmentAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, equipment)
+
+	equipmentDTO.Serial = "SN-0002"
+	equipmentDTO.Mac = "invalid-mac"
+	equipment, responseError = equipmentServiceTest.CreateEquipment(ctx, equipmentDTO)
+
+	require.Equal(t, utils.InvalidMAC, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, equipment)
+}
+
+// TestMoveEquipment testa se é possivel instalar o equipamento no ponto e retorna-lo ao estoque.
+func TestMoveEquipment(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 133.0", Logradouro: "LogradouroTest 118.0", Numero: 118})
+
+	equipmentDTO := dtos.EquipmentCreateDTO{
+		Serial: "SN-0003",
+		Modelo: "ONT Test",
+		Mac:    "AA:BB:CC:DD:EE:03",
+	}
+	equipment, responseError := equipmentServiceTest.CreateEquipment(ctx, equipmentDTO)
+
+	require.Empty(t, responseError)
+
+	movementDTO := dtos.EquipmentMovementDTO{
+		Base: dtos.Base{
+			ID: equipment.ID,
+		},
+		Estado: entities.INSTALADO,
+	}
+	equipmentMoved, responseError := equipmentServiceTest.MoveEquipment(ctx, movementDTO)
+
+	require.Equal(t, "ponto_id: "+utils.InvalidEquipmentMovement, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, equipmentMoved)
+
+	movementDTO.PontoID = "invalid-point-id"
+	equipmentMoved, responseError = equipmentServiceTest.MoveEquipment(ctx, movementDTO)
+
+	require.Equal(t, "ponto_id: "+utils.PointNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, equipmentMoved)
+
+	movementDTO.PontoID = point.ID
+	equipmentMoved, responseError = equipmentServiceTest.MoveEquipment(ctx, movementDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.INSTALADO, equipmentMoved.Estado)
+	require.Equal(t, point.ID, equipmentMoved.PontoID)
+
+	equipmentMoved, responseError = equipmentServiceTest.MoveEquipment(ctx, movementDTO)
+
+	require.Equal(t, utils.InvalidEquipmentMovement, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, equipmentMoved)
+
+	movementDTO.Estado = entities.DEFEITO
+	equipmentMoved, responseError = equipmentServiceTest.MoveEquipment(ctx, movementDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.DEFEITO, equipmentMoved.Estado)
+	require.Empty(t, equipmentMoved.PontoID)
+
+	movements, responseError := equipmentServiceTest.FindEquipmentMovements(ctx, equipment.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, movements, 3)
+	require.Equal(t, point.ID, movements[2].PontoAnteriorID)
+	require.Empty(t, movements[2].PontoPosteriorID)
+
+	movements, responseError = equipmentServiceTest.FindEquipmentMovements(ctx, "invalid-equipment-id")
+
+	require.Equal(t, utils.EquipmentNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, movements)
+}
+
+// TestFindEquipmentsToReturn testa se o cancelamento do contrato e a remoção do ponto sinalizam a devolução dos
+// equipamentos, e se o relatorio lista os equipamentos em pontos com o contrato cancelado.
+func TestFindEquipmentsToReturn(t *testing.T) {
+	cancelledPoint := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 134.0", Logradouro: "LogradouroTest 119.0", Numero: 119})
+	deletedPoint := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 135.0", Logradouro: "LogradouroTest 120.0", Numero: 120})
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: cancelledPoint.ID,
+		Estado:  entities.VIGOR,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	cancelledEquipment := installEquipment("SN-0004", "AA:BB:CC:DD:EE:04", cancelledPoint.ID)
+	deletedEquipment := installEquipment("SN-0005", "AA:BB:CC:DD:EE:05", deletedPoint.ID)
+
+	for _, state := range []entities.ContractState{entities.DESATIVADO, entities.CANCELADO} {
+		contractUpdateDTO := dtos.ContractUpdateDTO{
+			Base: dtos.Base{
+				ID: contract.ID,
+			},
+			Estado: state,
+		}
+		_, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+		require.Empty(t, responseError)
+	}
+
+	responseError = pointServiceTest.DeletePointByID(ctx, deletedPoint.ID)
+
+	require.Empty(t, responseError)
+
+	// Equipamento instalado depois do cancelamento, sem a sinalização de devolução.
+	lateEquipment := installEquipment("SN-0006", "AA:BB:CC:DD:EE:06", cancelledPoint.ID)
+
+	require.False(t, lateEquipment.DevolucaoPendente)
+
+	pending := true
+	equipments, responseError := equipmentServiceTest.FindEquipments(ctx, dtos.EquipmentFilter{DevolucaoPendente: &pending})
+
+	require.Empty(t, responseError)
+	require.Len(t, equipments, 2)
+	require.Equal(t, cancelledEquipment.ID, equipments[0].ID)
+	require.Equal(t, deletedEquipment.ID, equipments[1].ID)
+
+	movements, responseError := equipmentServiceTest.FindEquipmentMovements(ctx, cancelledEquipment.ID)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.CONTRATO_CANCELADO, movements[len(movements)-1].Motivo)
+
+	movements, responseError = equipmentServiceTest.FindEquipmentMovements(ctx, deletedEquipment.ID)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.PONTO_REMOVIDO, movements[len(movements)-1].Motivo)
+
+	equipments, responseError = equipmentServiceTest.FindEquipmentsToReturn(ctx)
+
+	require.Empty(t, responseError)
+	require.Len(t, equipments, 3)
+
+	for _, equipment := range equipments {
+		require.NotEmpty(t, equipment.Ponto.Cliente.Nome)
+	}
+
+	movementDTO := dtos.EquipmentMovementDTO{
+		Base: dtos.Base{
+			ID: cancelledEquipment.ID,
+		},
+		Estado: entities.ESTOQUE,
+		Motivo: "recolhido",
+	}
+	equipment, responseError := equipmentServiceTest.MoveEquipment(ctx, movementDTO)
+
+	require.Empty(t, responseError)
+	require.False(t, equipment.DevolucaoPendente)
+
+	equipments, responseError = equipmentServiceTest.FindEquipmentsToReturn(ctx)
+
+	require.Empty(t, responseError)
+	require.Len(t, equipments, 2)
+}
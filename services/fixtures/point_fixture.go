@@ -0,0 +1,82 @@
+// Package fixtures reúne os dados compartilhados pelos testes dos serviços.
+package fixtures
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/stretchr/testify/require"
+)
+
+// PointData representa o cliente e o endereço do ponto criado pelo PointFactory. O Tipo padrão do cliente é
+// FISICO e o Bairro padrão é BairroTest.
+type PointData struct {
+	Nome       string
+	Tipo       entities.ClientType
+	Logradouro string
+	Numero     int
+	Bairro     string
+}
+
+// PointFactory cria os pontos, com o cliente e o endereço, usados nos testes dos serviços.
+type PointFactory struct {
+	clientService  clientService.ClientService
+	addressService addressService.AddressService
+	pointService   pointService.PointService
+}
+
+// CreatePoint cria o cliente, o endereço e o ponto informados, falhando o teste quando algum deles é recusado.
+func (factory PointFactory) CreatePoint(t *testing.T, data PointData) entities.Ponto {
+	t.Helper()
+
+	ctx := context.Background()
+
+	if data.Tipo == "" {
+		data.Tipo = entities.FISICO
+	}
+
+	if data.Bairro == "" {
+		data.Bairro = "BairroTest"
+	}
+
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: data.Nome,
+		Tipo: data.Tipo,
+	}
+	client, responseError := factory.clientService.CreateClient(ctx, clientDTO)
+	require.Empty(t, responseError)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: data.Logradouro,
+		Bairro:     data.Bairro,
+		Numero:     data.Numero,
+	}
+	address, responseError := factory.addressService.CreateAddress(ctx, addressDTO)
+	require.Empty(t, responseError)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, responseError := factory.pointService.CreatePoint(ctx, pointDTO)
+	require.Empty(t, responseError)
+
+	return point
+}
+
+// NewPointFactory cria uma nova instancia de PointFactory.
+func NewPointFactory(clientService clientService.ClientService, addressService addressService.AddressService, pointService pointService.PointService) PointFactory {
+	return PointFactory{
+		clientService:  clientService,
+		addressService: addressService,
+		pointService:   pointService,
+	}
+}
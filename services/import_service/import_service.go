@@ -0,0 +1,872 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/gofrs/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/import_service")
+
+// ImportChunkSize quantidade de linhas gravadas em cada transação da importação.
+const ImportChunkSize = 500
+
+// ImportService representa a interface de ImportService.
+type ImportService interface {
+	ImportRecords(ctx context.Context, reader io.Reader, options dtos.ImportOptions) (dtos.ImportResult, utils.ResponseError)
+}
+
+type importService struct {
+	importRepository   repositories.ImportRepository
+	clientRepository   repositories.ClientRepository
+	addressRepository  repositories.AddressRepository
+	pointRepository    repositories.PointRepository
+	contractRepository repositories.ContractRepository
+	contactRepository  repositories.ContactRepository
+	planRepository     repositories.PlanRepository
+	cepRepository      repositories.CEPRepository
+	clientPolicies     policies.ClientPolicies
+	logger             *slog.Logger
+}
+
+// importState guarda os registros já resolvidos na importação, para que as linhas seguintes reutilizem os
+// clientes, endereços, pontos e contatos criados pelas linhas anteriores do arquivo.
+type importState struct {
+	clients        map[string]entities.Cliente
+	addresses      map[string]entities.Endereco
+	points         map[string]entities.Ponto
+	contacts       map[string]entities.Contato
+	clientPoints   map[string]int
+	contactTypes   map[string]map[entities.ContactType]bool
+	contractPoints map[string]bool
+	plans          map[string]entities.Plano
+}
+
+func newImportState() *importState {
+	return &importState{
+		clients:        map[string]entities.Cliente{},
+		addresses:      map[string]entities.Endereco{},
+		points:         map[string]entities.Ponto{},
+		contacts:       map[string]entities.Contato{},
+		clientPoints:   map[string]int{},
+		contactTypes:   map[string]map[entities.ContactType]bool{},
+		contractPoints: map[string]bool{},
+		plans:          map[string]entities.Plano{},
+	}
+}
+
+// ImportRecords importa os clientes, endereços, pontos e contratos do CSV, uma linha por ponto. Cada linha é
+// validada com as regras dos DTOs de cadastro, e os registros já existentes são encontrados pelas chaves
+// naturais: o documento ou o nome do cliente e os campos normalizados do endereço. As linhas validas são
+// gravadas em lotes de ImportChunkSize linhas, cada lote em uma transação.
+func (service *importService) ImportRecords(ctx context.Context, reader io.Reader, options dtos.ImportOptions) (dtos.ImportResult, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ImportService.ImportRecords")
+	defer span.End()
+
+	result := dtos.ImportResult{Previa: options.Previa, Erros: []dtos.ImportRowError{}}
+
+	for field := range options.Mapeamento {
+		if !slices.Contains(dtos.ImportColumns, field) {
+			return dtos.ImportResult{}, utils.NewResponseError(utils.InvalidImportMapping+": "+field, http.StatusBadRequest)
+		}
+	}
+
+	bufferedReader := bufio.NewReader(reader)
+
+	// O delimitador é detectado pelo cabeçalho, aceitando arquivos separados por ponto e virgula ou virgula.
+	header, err := bufferedReader.Peek(bufferedReader.Size())
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, bufio.ErrBufferFull) {
+		return dtos.ImportResult{}, utils.NewResponseError(utils.InvalidImportFile, http.StatusBadRequest)
+	}
+
+	csvReader := csv.NewReader(bufferedReader)
+	csvReader.Comma = ','
+	if firstLine, _, _ := strings.Cut(string(header), "\n"); strings.Contains(firstLine, ";") {
+		csvReader.Comma = ';'
+	}
+	csvReader.FieldsPerRecord = -1
+
+	columns, err := csvReader.Read()
+	if err != nil {
+		return dtos.ImportResult{}, utils.NewResponseError(utils.InvalidImportFile, http.StatusBadRequest)
+	}
+
+	indexes, missing := importColumnIndexes(columns, options.Mapeamento)
+	if len(missing) != 0 {
+		return dtos.ImportResult{}, utils.NewResponseError(utils.InvalidImportFile+": "+strings.Join(missing, ", "),
+			http.StatusBadRequest)
+	}
+
+	state := newImportState()
+	chunk := []dtos.ImportRowPlan{}
+	line := 1
+
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		line++
+		result.Linhas++
+
+		if err != nil {
+			addImportRowError(&result, line, err.Error())
+			continue
+		}
+
+		row, message, responseError := service.planRow(ctx, state, line, importValues(record, indexes))
+		if responseError != (utils.ResponseError{}) {
+			return dtos.ImportResult{}, responseError
+		}
+
+		if message != "" {
+			addImportRowError(&result, line, message)
+			continue
+		}
+
+		chunk = append(chunk, row)
+
+		if len(chunk) == ImportChunkSize {
+			service.saveChunk(ctx, &state, chunk, &result)
+			chunk = []dtos.ImportRowPlan{}
+		}
+	}
+
+	if len(chunk) != 0 {
+		service.saveChunk(ctx, &state, chunk, &result)
+	}
+
+	if err := ctx.Err(); err != nil {
+		return dtos.ImportResult{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "records imported", slog.Bool("previa", result.Previa),
+		slog.Int("linhas", result.Linhas), slog.Int("importadas", result.Importadas),
+		slog.Int("rejeitadas", result.Rejeitadas))
+
+	return result, utils.ResponseError{}
+}
+
+// saveChunk grava o lote de linhas validas, contabilizando os registros criados. Na prévia o lote não é gravado.
+// Quando a transação falha, todas as linhas do lote são rejeitadas e os registros resolvidos são descartados,
+// pois os registros criados pelo lote não existem mais no banco.
+func (service *importService) saveChunk(ctx context.Context, state **importState, chunk []dtos.ImportRowPlan, result *dtos.ImportResult) {
+	if !result.Previa {
+		err := service.importRepository.ImportRows(ctx, chunk)
+		if err != nil {
+			service.logger.ErrorContext(ctx, "failed to import chunk", slog.Int("linha_inicial", chunk[0].Linha),
+				slog.Int("linhas", len(chunk)), slog.String("error", err.Error()))
+
+			for _, row := range chunk {
+				addImportRowError(result, row.Linha, fmt.Sprintf("lote desfeito: %v", err))
+			}
+
+			*state = newImportState()
+
+			return
+		}
+	}
+
+	for _, row := range chunk {
+		result.Importadas++
+
+		if row.AcaoCliente != dtos.IMPORTACAO_EXISTENTE {
+			result.ClientesCriados++
+		}
+
+		if row.AcaoEndereco != dtos.IMPORTACAO_EXISTENTE {
+			result.EnderecosCriados++
+		}
+
+		if row.AcaoPonto != dtos.IMPORTACAO_EXISTENTE {
+			result.PontosCriados++
+		}
+
+		if row.Contato != nil && row.AcaoContato != dtos.IMPORTACAO_EXISTENTE {
+			result.ContatosCriados++
+		}
+
+		if row.Contrato != nil {
+			result.ContratosCriados++
+		}
+	}
+}
+
+// planRow valida a linha e resolve os seus registros, retornando a mensagem de erro quando a linha é rejeitada.
+func (service *importService) planRow(ctx context.Context, state *importState, line int, values map[string]string) (dtos.ImportRowPlan, string, utils.ResponseError) {
+	row := dtos.ImportRowPlan{Linha: line}
+
+	client, action, message, responseError := service.resolveClient(ctx, state, values)
+	if message != "" || responseError != (utils.ResponseError{}) {
+		return dtos.ImportRowPlan{}, message, responseError
+	}
+
+	row.Cliente, row.AcaoCliente = client, action
+
+	address, action, message, responseError := service.resolveAddress(ctx, state, values)
+	if message != "" || responseError != (utils.ResponseError{}) {
+		return dtos.ImportRowPlan{}, message, responseError
+	}
+
+	row.Endereco, row.AcaoEndereco = address, action
+
+	point, action, message, responseError := service.resolvePoint(ctx, state, client, address)
+	if message != "" || responseError != (utils.ResponseError{}) {
+		return dtos.ImportRowPlan{}, message, responseError
+	}
+
+	row.Ponto, row.AcaoPonto = point, action
+
+	if values["plano"] != "" || values["data_assinatura"] != "" {
+		message, responseError = service.planContract(ctx, state, &row, values)
+		if message != "" || responseError != (utils.ResponseError{}) {
+			return dtos.ImportRowPlan{}, message, responseError
+		}
+	}
+
+	// Os registros da linha só passam a ser reutilizados quando a linha inteira é valida.
+	state.clients[importClientKey(client)] = client
+	state.addresses[address.ChaveNormalizada] = address
+
+	if row.AcaoPonto != dtos.IMPORTACAO_EXISTENTE {
+		state.clientPoints[client.ID]++
+	}
+
+	state.points[point.ClienteID+"|"+point.EnderecoID] = point
+
+	if row.Contato != nil {
+		state.contacts[importContactKey(*row.Contato)] = *row.Contato
+
+		if contactTypes, ok := state.contactTypes[client.ID]; ok {
+			contactTypes[row.Contato.Tipo] = true
+		}
+	}
+
+	if row.Contrato != nil {
+		state.contractPoints[point.ID] = true
+	}
+
+	return row, "", utils.ResponseError{}
+}
+
+// resolveClient valida o cliente da linha com as regras de ClientCreateDTO e o encontra pelo documento ou, sem
+// documento, pelo nome.
+func (service *importService) resolveClient(ctx context.Context, state *importState, values map[string]string) (entities.Cliente, dtos.ImportAction, string, utils.ResponseError) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome:      values["cliente_nome"],
+		Tipo:      entities.ClientType(strings.ToLower(values["cliente_tipo"])),
+		Documento: values["cliente_documento"],
+	}
+
+	if err := binding.Validator.ValidateStruct(&clientDTO); err != nil {
+		return entities.Cliente{}, "", err.Error(), utils.ResponseError{}
+	}
+
+	client := entities.Cliente{
+		Nome:      clientDTO.Nome,
+		Tipo:      clientDTO.Tipo,
+		Documento: dtos.NormalizeDocument(clientDTO.Documento),
+	}
+
+	if client.Documento != "" && !dtos.IsValidDocument(client.Tipo, client.Documento) {
+		return entities.Cliente{}, "", "cliente_documento: " + utils.InvalidDocument, utils.ResponseError{}
+	}
+
+	if clientFound, ok := state.clients[importClientKey(client)]; ok {
+		return clientFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+
+	var clientFound entities.Cliente
+	var err error
+
+	if client.Documento != "" {
+		clientFound, err = service.clientRepository.FindClientByDocument(ctx, client.Documento)
+	} else {
+		var message string
+
+		clientFound, message, err = service.findClientByName(ctx, client.Nome)
+		if message != "" {
+			return entities.Cliente{}, "", message, utils.ResponseError{}
+		}
+	}
+
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		client.ID = newImportID()
+		setImportDates(&client.Base)
+
+		return client, dtos.IMPORTACAO_CRIAR, "", utils.ResponseError{}
+
+	case err != nil:
+		return entities.Cliente{}, "", "", utils.NewInternalResponseError(err)
+
+	case clientFound.DataRemocao.Valid:
+		// O cliente removido é restaurado com os dados do arquivo, como no cadastro do cliente.
+		client.ID = clientFound.ID
+		client.DataCriacao = clientFound.DataCriacao
+		client.DataAtualizacao = time.Now()
+
+		return client, dtos.IMPORTACAO_RESTAURAR, "", utils.ResponseError{}
+
+	default:
+		return clientFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+}
+
+// findClientByName encontra o cliente da linha sem documento pelo nome, preferindo o cliente ativo ao removido. A
+// linha é recusada quando mais de um cliente ativo, ou, sem cliente ativo, mais de um removido, tem o nome.
+func (service *importService) findClientByName(ctx context.Context, name string) (entities.Cliente, string, error) {
+	clients, err := service.clientRepository.FindClientsByName(ctx, name)
+	if err != nil {
+		return entities.Cliente{}, "", err
+	}
+
+	active, deleted := []entities.Cliente{}, []entities.Cliente{}
+
+	for _, client := range clients {
+		if client.DataRemocao.Valid {
+			deleted = append(deleted, client)
+		} else {
+			active = append(active, client)
+		}
+	}
+
+	candidates := active
+	if len(candidates) == 0 {
+		candidates = deleted
+	}
+
+	switch len(candidates) {
+	case 0:
+		return entities.Cliente{}, "", repositories.ErrNotFound
+	case 1:
+		return candidates[0], "", nil
+	default:
+		return entities.Cliente{}, "cliente_nome: " + utils.AmbiguousClientName, nil
+	}
+}
+
+// resolveAddress valida o endereço da linha com as regras de AddressCreateDTO, completando os campos vazios
+// pelo diretorio de CEPs, e o encontra pelos campos normalizados.
+func (service *importService) resolveAddress(ctx context.Context, state *importState, values map[string]string) (entities.Endereco, dtos.ImportAction, string, utils.ResponseError) {
+	number, err := strconv.Atoi(values["numero"])
+	if err != nil {
+		return entities.Endereco{}, "", "numero: " + utils.InvalidImportNumber, utils.ResponseError{}
+	}
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:         values["cep"],
+		Cidade:      values["cidade"],
+		Uf:          values["uf"],
+		Logradouro:  values["logradouro"],
+		Bairro:      values["bairro"],
+		Numero:      number,
+		Complemento: values["complemento"],
+	}
+
+	if err := binding.Validator.ValidateStruct(&addressDTO); err != nil {
+		return entities.Endereco{}, "", err.Error(), utils.ResponseError{}
+	}
+
+	cep, ok := dtos.NormalizeCEP(addressDTO.Cep)
+	if !ok {
+		return entities.Endereco{}, "", "cep: " + utils.InvalidCEP, utils.ResponseError{}
+	}
+
+	address := entities.Endereco{
+		Cep:         cep,
+		Cidade:      addressDTO.Cidade,
+		Uf:          strings.ToUpper(addressDTO.Uf),
+		Logradouro:  addressDTO.Logradouro,
+		Bairro:      addressDTO.Bairro,
+		Numero:      addressDTO.Numero,
+		Complemento: addressDTO.Complemento,
+	}
+
+	cepFound, err := service.cepRepository.FindCEPByCode(ctx, address.Cep)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Endereco{}, "", "", utils.NewInternalResponseError(err)
+	}
+
+	for _, field := range []struct {
+		value *string
+		known string
+	}{
+		{&address.Logradouro, cepFound.Logradouro},
+		{&address.Bairro, cepFound.Bairro},
+		{&address.Cidade, cepFound.Cidade},
+		{&address.Uf, cepFound.Uf},
+	} {
+		if *field.value == "" {
+			*field.value = field.known
+		}
+	}
+
+	if _, ok := dtos.NormalizeUF(address.Uf); !ok {
+		return entities.Endereco{}, "", "uf: " + utils.InvalidUF, utils.ResponseError{}
+	}
+
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{"cidade", address.Cidade},
+		{"logradouro", address.Logradouro},
+		{"bairro", address.Bairro},
+	} {
+		if !dtos.IsValidTextLenght(field.value) {
+			return entities.Endereco{}, "", field.name + ": " + utils.InvalidNumberOfCaracter, utils.ResponseError{}
+		}
+	}
+
+	address.ChaveNormalizada = dtos.AddressKey(address)
+
+	if addressFound, ok := state.addresses[address.ChaveNormalizada]; ok {
+		return addressFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+
+	addressFound, err := service.addressRepository.FindAddressByFields(ctx, address)
+
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+		address.ID = newImportID()
+		setImportDates(&address.Base)
+
+		return address, dtos.IMPORTACAO_CRIAR, "", utils.ResponseError{}
+
+	case err != nil:
+		return entities.Endereco{}, "", "", utils.NewInternalResponseError(err)
+
+	case addressFound.DataRemocao.Valid:
+		address.ID = addressFound.ID
+		address.DataCriacao = addressFound.DataCriacao
+		address.DataAtualizacao = time.Now()
+
+		return address, dtos.IMPORTACAO_RESTAURAR, "", utils.ResponseError{}
+
+	default:
+		return addressFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+}
+
+// resolvePoint encontra o ponto do cliente no endereço ou, respeitando o limite de pontos da politica do tipo
+// do cliente, planeja o seu cadastro.
+func (service *importService) resolvePoint(ctx context.Context, state *importState, client entities.Cliente, address entities.Endereco) (entities.Ponto, dtos.ImportAction, string, utils.ResponseError) {
+	if pointFound, ok := state.points[client.ID+"|"+address.ID]; ok {
+		return pointFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+
+	point := entities.Ponto{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	action := dtos.IMPORTACAO_CRIAR
+
+	pointFound, err := service.pointRepository.FindPointByClientIDAndAddressID(ctx, client.ID, address.ID)
+
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+	case err != nil:
+		return entities.Ponto{}, "", "", utils.NewInternalResponseError(err)
+	case pointFound.DataRemocao.Valid:
+		point.ID = pointFound.ID
+		point.DataCriacao = pointFound.DataCriacao
+		action = dtos.IMPORTACAO_RESTAURAR
+	default:
+		return pointFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+
+	points, ok := state.clientPoints[client.ID]
+	if !ok {
+		clientPoints, err := service.pointRepository.FindPointsByClientID(ctx, client.ID)
+		if err != nil {
+			return entities.Ponto{}, "", "", utils.NewInternalResponseError(err)
+		}
+
+		points = len(clientPoints)
+		state.clientPoints[client.ID] = points
+	}
+
+	err = service.clientPolicies.CheckPointLimit(client.Tipo, points)
+	if err != nil {
+		return entities.Ponto{}, "", err.Error(), utils.ResponseError{}
+	}
+
+	if action == dtos.IMPORTACAO_CRIAR {
+		point.ID = newImportID()
+		setImportDates(&point.Base)
+	}
+
+	point.DataAtualizacao = time.Now()
+
+	return point, action, "", utils.ResponseError{}
+}
+
+// resolveContact valida o contato responsavel da linha com as regras de ContactCreateDTO e o encontra pelo
+// cliente, tipo e valor normalizado ou, como no cadastro do contato, planeja o seu cadastro.
+func (service *importService) resolveContact(ctx context.Context, state *importState, client entities.Cliente, values map[string]string) (*entities.Contato, dtos.ImportAction, string, utils.ResponseError) {
+	if values["contato_responsavel_tipo"] == "" && values["contato_responsavel_valor"] == "" {
+		return nil, "", "", utils.ResponseError{}
+	}
+
+	contactType := entities.ContactType(strings.ToLower(values["contato_responsavel_tipo"]))
+	if !dtos.IsValidContactType(contactType) {
+		return nil, "", "contato_responsavel_tipo: " + utils.InvalidContactType, utils.ResponseError{}
+	}
+
+	value, ok := dtos.NormalizeContactValue(contactType, values["contato_responsavel_valor"])
+	if !ok && contactType == entities.EMAIL {
+		return nil, "", "contato_responsavel_valor: " + utils.InvalidEmail, utils.ResponseError{}
+	}
+
+	if !ok {
+		return nil, "", "contato_responsavel_valor: " + utils.InvalidPhone, utils.ResponseError{}
+	}
+
+	contact := entities.Contato{
+		ClienteID: client.ID,
+		Tipo:      contactType,
+		Valor:     value,
+	}
+
+	if contactFound, ok := state.contacts[importContactKey(contact)]; ok {
+		return &contactFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+
+	action := dtos.IMPORTACAO_CRIAR
+
+	contactFound, err := service.contactRepository.FindContactByClientIDAndValue(ctx, client.ID, contact.Tipo, contact.Valor)
+
+	switch {
+	case errors.Is(err, repositories.ErrNotFound):
+	case err != nil:
+		return nil, "", "", utils.NewInternalResponseError(err)
+	case contactFound.DataRemocao.Valid:
+		contact.ID = contactFound.ID
+		contact.DataCriacao = contactFound.DataCriacao
+		action = dtos.IMPORTACAO_RESTAURAR
+	default:
+		return &contactFound, dtos.IMPORTACAO_EXISTENTE, "", utils.ResponseError{}
+	}
+
+	contactTypes, ok := state.contactTypes[client.ID]
+	if !ok {
+		clientContacts, err := service.contactRepository.FindContactsByClientID(ctx, client.ID)
+		if err != nil {
+			return nil, "", "", utils.NewInternalResponseError(err)
+		}
+
+		contactTypes = map[entities.ContactType]bool{}
+
+		for _, clientContact := range clientContacts {
+			contactTypes[clientContact.Tipo] = true
+		}
+
+		state.contactTypes[client.ID] = contactTypes
+	}
+
+	// O primeiro contato de cada tipo é sempre o principal.
+	contact.Principal = !contactTypes[contact.Tipo]
+
+	if action == dtos.IMPORTACAO_CRIAR {
+		contact.ID = newImportID()
+		setImportDates(&contact.Base)
+	}
+
+	contact.DataAtualizacao = time.Now()
+
+	return &contact, action, "", utils.ResponseError{}
+}
+
+// planContract valida o contrato da linha, com o plano encontrado pelo nome e o contato responsavel, e planeja o
+// seu cadastro com os eventos, a primeira versão e, no contrato pendente, a ordem de serviço de instalação, como
+// no cadastro do contrato. O contrato com a ativação futura é importado pendente de instalação, sem a ativação e a
+// fidelidade.
+func (service *importService) planContract(ctx context.Context, state *importState, row *dtos.ImportRowPlan, values map[string]string) (string, utils.ResponseError) {
+	contract := entities.Contrato{
+		PontoID: row.Ponto.ID,
+	}
+
+	for _, field := range []struct {
+		name  string
+		value **time.Time
+	}{
+		{"data_assinatura", &contract.DataAssinatura},
+		{"data_ativacao", &contract.DataAtivacao},
+		{"data_termino", &contract.DataTermino},
+	} {
+		if values[field.name] == "" {
+			continue
+		}
+
+		date, err := time.ParseInLocation(dtos.DateLayout, values[field.name], time.Local)
+		if err != nil {
+			return field.name + ": " + utils.InvalidDate, utils.ResponseError{}
+		}
+
+		*field.value = &date
+	}
+
+	contact, action, message, responseError := service.resolveContact(ctx, state, row.Cliente, values)
+	if message != "" || responseError != (utils.ResponseError{}) {
+		return message, responseError
+	}
+
+	if contact != nil {
+		row.Contato, row.AcaoContato = contact, action
+		contract.ContatoResponsavelID = contact.ID
+	}
+
+	err := service.clientPolicies.CheckResponsibleContact(row.Cliente.Tipo, contract.ContatoResponsavelID)
+	if err != nil {
+		return err.Error(), utils.ResponseError{}
+	}
+
+	plan := entities.Plano{}
+
+	if name := values["plano"]; name != "" {
+		cachedPlan, ok := state.plans[name]
+		if !ok {
+			cachedPlan, err = service.planRepository.FindPlanByName(ctx, name)
+			if errors.Is(err, repositories.ErrNotFound) {
+				return "plano: " + utils.PlanNotFound, utils.ResponseError{}
+			}
+
+			if err != nil {
+				return "", utils.NewInternalResponseError(err)
+			}
+
+			state.plans[name] = cachedPlan
+		}
+
+		plan = cachedPlan
+
+		contract.PlanoID = plan.ID
+	}
+
+	now := time.Now()
+
+	if contract.DataAssinatura == nil {
+		contract.DataAssinatura = &now
+	}
+
+	if contract.DataAtivacao == nil {
+		contract.DataAtivacao = contract.DataAssinatura
+	}
+
+	if contract.DataAtivacao.Before(*contract.DataAssinatura) {
+		return "data_ativacao: " + utils.InvalidContractDates, utils.ResponseError{}
+	}
+
+	if contract.DataTermino != nil && !contract.DataTermino.After(*contract.DataAtivacao) {
+		return "data_termino: " + utils.InvalidContractDates, utils.ResponseError{}
+	}
+
+	// O contrato pendente de instalação é ativado, e inicia a fidelidade, apenas na conclusão da instalação, como
+	// no cadastro do contrato, então a ativação futura informada apenas indica que o contrato está pendente.
+	contract.Estado = entities.VIGOR
+	if contract.DataAtivacao.After(now) {
+		contract.Estado = entities.PENDENTE
+		contract.DataAtivacao = nil
+	}
+
+	start := *contract.DataAssinatura
+
+	if contract.DataAtivacao != nil {
+		start = *contract.DataAtivacao
+		contract.DataFimFidelidade = dtos.FidelityEnd(start, plan)
+	}
+
+	// O ponto pode ter uma sequencia de contratos, com no maximo um contrato não cancelado.
+	if state.contractPoints[row.Ponto.ID] {
+		return utils.ContractAlreadyExists, utils.ResponseError{}
+	}
+
+	if row.AcaoPonto == dtos.IMPORTACAO_EXISTENTE {
+		_, err = service.contractRepository.FindContractByPontoID(ctx, row.Ponto.ID)
+		if err == nil {
+			return utils.ContractAlreadyExists, utils.ResponseError{}
+		}
+
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return "", utils.NewInternalResponseError(err)
+		}
+	}
+
+	contract.ID = newImportID()
+	setImportDates(&contract.Base)
+
+	contractEvent := entities.ContratoEvento{
+		Tipo:            entities.ESTADO,
+		ContratoID:      contract.ID,
+		EstadoAnterior:  contract.Estado,
+		EstadoPosterior: contract.Estado,
+	}
+	contractEvent.ID = newImportID()
+	setImportDates(&contractEvent.Base)
+
+	row.ContratoEventos = []entities.ContratoEvento{contractEvent}
+
+	if contract.PlanoID != "" {
+		planEvent := entities.ContratoEvento{
+			Tipo:             entities.PLANO,
+			ContratoID:       contract.ID,
+			EstadoAnterior:   contract.Estado,
+			EstadoPosterior:  contract.Estado,
+			PlanoPosteriorID: contract.PlanoID,
+			DataEfetiva:      &start,
+		}
+		planEvent.ID = newImportID()
+		setImportDates(&planEvent.Base)
+
+		row.ContratoEventos = append(row.ContratoEventos, planEvent)
+	}
+
+	terms, err := json.Marshal(dtos.CreateContractTerms(contract, plan))
+	if err != nil {
+		return "", utils.NewInternalResponseError(err)
+	}
+
+	contractVersion := entities.ContratoVersao{
+		ContratoID:  contract.ID,
+		Numero:      1,
+		Motivo:      entities.CADASTRO,
+		DataEfetiva: start,
+		Termos:      string(terms),
+	}
+	contractVersion.ID = newImportID()
+	setImportDates(&contractVersion.Base)
+
+	contract.VersaoID = contractVersion.ID
+
+	row.Contrato = &contract
+	row.ContratoVersao = &contractVersion
+
+	if contract.Estado == entities.PENDENTE {
+		serviceOrder := entities.OrdemServico{
+			Tipo:       entities.INSTALACAO,
+			Estado:     entities.ORDEM_ABERTA,
+			PontoID:    contract.PontoID,
+			ContratoID: contract.ID,
+			Descricao:  "Instalação do contrato",
+		}
+		serviceOrder.ID = newImportID()
+		setImportDates(&serviceOrder.Base)
+
+		row.OrdemServico = &serviceOrder
+	}
+
+	return "", utils.ResponseError{}
+}
+
+// importColumnIndexes localiza a coluna de cada campo no cabeçalho do CSV, retornando os campos obrigatorios
+// ausentes.
+func importColumnIndexes(columns []string, mapping map[string]string) (map[string]int, []string) {
+	indexes := map[string]int{}
+
+	for _, field := range dtos.ImportColumns {
+		name := field
+		if mapped, ok := mapping[field]; ok {
+			name = mapped
+		}
+
+		for i, column := range columns {
+			if strings.EqualFold(strings.TrimSpace(strings.TrimPrefix(column, "\uFEFF")), strings.TrimSpace(name)) {
+				indexes[field] = i
+			}
+		}
+	}
+
+	missing := []string{}
+
+	for _, field := range dtos.ImportRequiredColumns {
+		if _, ok := indexes[field]; !ok {
+			missing = append(missing, field)
+		}
+	}
+
+	return indexes, missing
+}
+
+// importValues retorna os valores da linha para cada campo, vazios quando a linha não possui a coluna.
+func importValues(record []string, indexes map[string]int) map[string]string {
+	values := map[string]string{}
+
+	for field, index := range indexes {
+		if index < len(record) {
+			values[field] = strings.TrimSpace(record[index])
+		}
+	}
+
+	return values
+}
+
+// importClientKey retorna a chave natural do cliente: o documento ou, sem documento, o nome.
+func importClientKey(client entities.Cliente) string {
+	if client.Documento != "" {
+		return "documento:" + client.Documento
+	}
+
+	return "nome:" + client.Nome
+}
+
+// importContactKey retorna a chave natural do contato: o cliente, o tipo e o valor normalizado.
+func importContactKey(contact entities.Contato) string {
+	return contact.ClienteID + "|" + string(contact.Tipo) + "|" + contact.Valor
+}
+
+// newImportID gera o id dos registros novos antes da gravação, para que as linhas do mesmo lote se refiram a eles.
+func newImportID() string {
+	id, _ := uuid.NewV4()
+
+	return id.String()
+}
+
+// setImportDates preenche as datas de criação e atualização dos registros novos.
+func setImportDates(base *entities.Base) {
+	base.DataCriacao = time.Now()
+	base.DataAtualizacao = base.DataCriacao
+}
+
+// addImportRowError rejeita a linha, registrando a mensagem no relatorio da importação.
+func addImportRowError(result *dtos.ImportResult, line int, message string) {
+	result.Rejeitadas++
+	result.Erros = append(result.Erros, dtos.ImportRowError{Linha: line, Erro: message})
+}
+
+// NewImportService cria uma nova instancia de ImportService.
+func NewImportService(importRepository repositories.ImportRepository, clientRepository repositories.ClientRepository, addressRepository repositories.AddressRepository, pointRepository repositories.PointRepository, contractRepository repositories.ContractRepository, contactRepository repositories.ContactRepository, planRepository repositories.PlanRepository, cepRepository repositories.CEPRepository, clientPolicies policies.ClientPolicies, logger *slog.Logger) ImportService {
+	return &importService{
+		importRepository:   importRepository,
+		clientRepository:   clientRepository,
+		addressRepository:  addressRepository,
+		pointRepository:    pointRepository,
+		contractRepository: contractRepository,
+		contactRepository:  contactRepository,
+		planRepository:     planRepository,
+		cepRepository:      cepRepository,
+		clientPolicies:     clientPolicies,
+		logger:             logger,
+	}
+}
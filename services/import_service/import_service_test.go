@@ -0,0 +1,394 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	importService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/import_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	serviceOrderService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/service_order_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+	dbTechnician        = repositoriesFake.DBTechnician
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	importRepositoryFake            = repositoriesFake.NewImportRepositoryFake(dbClient, dbAddress, dbPoint, dbContact, dbContract, dbContractEvent, dbContractVersion, dbServiceOrder)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+	technicianRepositoryFake        = repositoriesFake.NewTechnicianRepositoryFake(dbTechnician)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	serviceOrderServiceTest    = serviceOrderService.NewServiceOrderService(serviceOrderRepositoryFake, pointRepositoryFake, technicianRepositoryFake, contractServiceTest, logNop)
+	importServiceTest          = importService.NewImportService(importRepositoryFake, clientRepositoryFake, addressRepositoryFake, pointRepositoryFake, contractRepositoryFake, contactRepositoryFake, planRepositoryFake, cepRepositoryFake, clientPolicies, logNop)
+)
+
+// importFile arquivo usado nos testes da importação, com as colunas de um sistema legado.
+const importFile = `NOME;TIPO;CPF_CNPJ;cep;numero;logradouro;bairro;cidade;uf;plano;data_assinatura
+Test 144.0;fisico;529.982.247-25;01001000;129;LogradouroTest 129.0;BairroTest;São Paulo;SP;PlanoTest 8.0;2026-01-10
+Test 144.0;fisico;529.982.247-25;01001000;130;LogradouroTest 130.0;BairroTest;São Paulo;SP;;
+Test 145.0;invalido;;01001000;131;LogradouroTest 131.0;BairroTest;São Paulo;SP;;
+Test 146.0;fisico;;01001000;132;LogradouroTest 132.0;BairroTest;São Paulo;SP;PlanoTest 9.9;
+Test 147.0;fisico;;01001000;sem numero;LogradouroTest 133.0;BairroTest;São Paulo;SP;;
+`
+
+// importMapping mapeamento das colunas de importFile.
+var importMapping = map[string]string{
+	"cliente_nome":      "NOME",
+	"cliente_tipo":      "TIPO",
+	"cliente_documento": "CPF_CNPJ",
+}
+
+// TestImportRecordsPreview testa se a prévia da importação valida as linhas e resolve os registros sem gravá-los.
+func TestImportRecordsPreview(t *testing.T) {
+	planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 8.0", Velocidade: 300, PrecoMensal: 9990, MesesFidelidade: 12})
+
+	clients, points, contracts := len(*dbClient), len(*dbPoint), len(*dbContract)
+
+	options := dtos.ImportOptions{Previa: true, Mapeamento: importMapping}
+	result, responseError := importServiceTest.ImportRecords(ctx, strings.NewReader(importFile), options)
+
+	require.Empty(t, responseError)
+	require.True(t, result.Previa)
+	require.Equal(t, 5, result.Linhas)
+	require.Equal(t, 2, result.Importadas)
+	require.Equal(t, 3, result.Rejeitadas)
+	require.Equal(t, 1, result.ClientesCriados)
+	require.Equal(t, 2, result.EnderecosCriados)
+	require.Equal(t, 2, result.PontosCriados)
+	require.Equal(t, 1, result.ContratosCriados)
+	require.Len(t, result.Erros, 3)
+	require.Equal(t, 4, result.Erros[0].Linha)
+	require.Contains(t, result.Erros[0].Erro, "Tipo")
+	require.Equal(t, dtos.ImportRowError{Linha: 5, Erro: "plano: " + utils.PlanNotFound}, result.Erros[1])
+	require.Equal(t, dtos.ImportRowError{Linha: 6, Erro: "numero: " + utils.InvalidImportNumber}, result.Erros[2])
+
+	require.Len(t, *dbClient, clients)
+	require.Len(t, *dbPoint, points)
+	require.Len(t, *dbContract, contracts)
+}
+
+// TestImportRecords testa se a importação grava os registros das linhas validas, reutilizando o cliente das
+// linhas seguintes, e se a nova importação do mesmo arquivo reutiliza os registros já importados.
+func TestImportRecords(t *testing.T) {
+	planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 8.0", Velocidade: 300, PrecoMensal: 9990, MesesFidelidade: 12})
+
+	options := dtos.ImportOptions{Mapeamento: importMapping}
+	result, responseError := importServiceTest.ImportRecords(ctx, strings.NewReader(importFile), options)
+
+	require.Empty(t, responseError)
+	require.False(t, result.Previa)
+	require.Equal(t, 2, result.Importadas)
+	require.Equal(t, 3, result.Rejeitadas)
+
+	client, responseError := clientServiceTest.FindClientByName(ctx, "Test 144.0")
+
+	require.Empty(t, responseError)
+	require.Equal(t, "52998224725", client.Documento)
+
+	points, responseError := pointServiceTest.FindPoints(ctx, client.ID, "")
+
+	require.Empty(t, responseError)
+	require.Len(t, points, 2)
+
+	contract, responseError := contractServiceTest.FindContractByPontoID(ctx, points[0].ID)
+	if responseError != (utils.ResponseError{}) {
+		contract, responseError = contractServiceTest.FindContractByPontoID(ctx, points[1].ID)
+	}
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.VIGOR, contract.Estado)
+	require.Equal(t, "2026-01-10", contract.DataAtivacao.Format(dtos.DateLayout))
+	require.Equal(t, "2027-01-10", contract.DataFimFidelidade.Format(dtos.DateLayout))
+
+	versions, responseError := contractVersionServiceTest.FindContractVersions(ctx, contract.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, versions, 1)
+	require.Equal(t, entities.CADASTRO, versions[0].Motivo)
+
+	result, responseError = importServiceTest.ImportRecords(ctx, strings.NewReader(importFile), options)
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, result.Importadas)
+	require.Zero(t, result.ClientesCriados)
+	require.Zero(t, result.PontosCriados)
+	require.Equal(t, dtos.ImportRowError{Linha: 2, Erro: utils.ContractAlreadyExists}, result.Erros[0])
+}
+
+// TestImportRecordsPendingContract testa se a importação cadastra o contrato com a ativação futura pendente de
+// instalação, sem a ativação e a fidelidade, com a ordem de serviço de instalação e o contato responsavel da linha,
+// e se os eventos do contrato passam a valer na assinatura, como na primeira versão.
+func TestImportRecordsPendingContract(t *testing.T) {
+	planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 9.3", Velocidade: 300, PrecoMensal: 9990, MesesFidelidade: 12})
+
+	activation := time.Now().AddDate(0, 1, 0).Format(dtos.DateLayout)
+	file := "cliente_nome;cliente_tipo;cep;numero;logradouro;bairro;cidade;uf;plano;data_ativacao;contato_responsavel_tipo;contato_responsavel_valor\n" +
+		"Test 149.0;fisico;01001000;134;LogradouroTest 134.0;BairroTest;São Paulo;SP;PlanoTest 9.3;" + activation + ";email;Test149@Email.com\n" +
+		"Test 149.0;fisico;01001000;135;LogradouroTest 135.0;BairroTest;São Paulo;SP;PlanoTest 9.3;;email;test149@email.com\n" +
+		"Test 149.0;fisico;01001000;136;LogradouroTest 136.0;BairroTest;São Paulo;SP;PlanoTest 9.3;;fax;test149@email.com\n"
+
+	result, responseError := importServiceTest.ImportRecords(ctx, strings.NewReader(file), dtos.ImportOptions{})
+
+	require.Empty(t, responseError)
+	require.Equal(t, 2, result.Importadas)
+	require.Equal(t, 1, result.ContatosCriados)
+	require.Equal(t, dtos.ImportRowError{Linha: 4, Erro: "contato_responsavel_tipo: " + utils.InvalidContactType}, result.Erros[0])
+
+	client, responseError := clientServiceTest.FindClientByName(ctx, "Test 149.0")
+
+	require.Empty(t, responseError)
+
+	contacts, responseError := contactServiceTest.FindContactsByClientID(ctx, client.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, contacts, 1)
+	require.Equal(t, "test149@email.com", contacts[0].Valor)
+	require.True(t, contacts[0].Principal)
+
+	points, responseError := pointServiceTest.FindPoints(ctx, client.ID, "")
+
+	require.Empty(t, responseError)
+	require.Len(t, points, 2)
+
+	for _, point := range points {
+		contract, responseError := contractServiceTest.FindContractByPontoID(ctx, point.ID)
+
+		require.Empty(t, responseError)
+		require.Equal(t, contacts[0].ID, contract.ContatoResponsavelID)
+
+		serviceOrders, err := serviceOrderRepositoryFake.FindServiceOrders(ctx, dtos.ServiceOrderFilter{ContratoID: contract.ID})
+
+		require.NoError(t, err)
+
+		if point.Endereco.Numero == 135 {
+			require.Equal(t, entities.VIGOR, contract.Estado)
+			require.Empty(t, serviceOrders)
+
+			continue
+		}
+
+		require.Equal(t, entities.PENDENTE, contract.Estado)
+		require.Nil(t, contract.DataAtivacao)
+		require.Nil(t, contract.DataFimFidelidade)
+		require.Len(t, serviceOrders, 1)
+		require.Equal(t, entities.INSTALACAO, serviceOrders[0].Tipo)
+		require.Equal(t, entities.ORDEM_ABERTA, serviceOrders[0].Estado)
+
+		contractEvents, responseError := contractEventServiceTest.FindContractEventsByContractID(ctx, contract.ID)
+
+		require.Empty(t, responseError)
+		require.Len(t, contractEvents, 2)
+
+		for _, contractEvent := range contractEvents {
+			require.Equal(t, entities.PENDENTE, contractEvent.EstadoPosterior)
+
+			if contractEvent.Tipo == entities.PLANO {
+				require.True(t, contract.DataAssinatura.Equal(*contractEvent.DataEfetiva))
+			}
+		}
+	}
+}
+
+// TestImportRecordsPendingContractInstallation testa se a conclusão da instalação do contrato importado pendente
+// ativa o contrato na conclusão, iniciando a fidelidade e criando a versão da ativação.
+func TestImportRecordsPendingContractInstallation(t *testing.T) {
+	plan, err := planRepositoryFake.CreatePlan(ctx, entities.Plano{Nome: "PlanoTest 9.4", Velocidade: 300, PrecoMensal: 9990, MesesFidelidade: 12})
+
+	require.NoError(t, err)
+
+	_, err = technicianRepositoryFake.CreateTechnician(ctx, entities.Tecnico{Nome: "Tecnico Importacao", InicioExpediente: 8, FimExpediente: 18})
+
+	require.NoError(t, err)
+
+	activation := time.Now().AddDate(0, 2, 0).Format(dtos.DateLayout)
+	file := "cliente_nome;cliente_tipo;cep;numero;logradouro;bairro;cidade;uf;plano;data_ativacao\n" +
+		"Test 151.0;fisico;01001000;138;LogradouroTest 138.0;BairroTest;São Paulo;SP;PlanoTest 9.4;" + activation + "\n"
+
+	result, responseError := importServiceTest.ImportRecords(ctx, strings.NewReader(file), dtos.ImportOptions{})
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, result.Importadas)
+
+	client, responseError := clientServiceTest.FindClientByName(ctx, "Test 151.0")
+
+	require.Empty(t, responseError)
+
+	points, responseError := pointServiceTest.FindPoints(ctx, client.ID, "")
+
+	require.Empty(t, responseError)
+	require.Len(t, points, 1)
+
+	contract, responseError := contractServiceTest.FindContractByPontoID(ctx, points[0].ID)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.PENDENTE, contract.Estado)
+
+	serviceOrders, responseError := serviceOrderServiceTest.FindServiceOrders(ctx, dtos.ServiceOrderFilter{ContratoID: contract.ID})
+
+	require.Empty(t, responseError)
+	require.Len(t, serviceOrders, 1)
+
+	now := time.Now()
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+	scheduleDTO := dtos.ServiceOrderScheduleDTO{
+		Base: dtos.Base{
+			ID: serviceOrders[0].ID,
+		},
+		InicioJanela: day.Add(9 * time.Hour),
+		FimJanela:    day.Add(11 * time.Hour),
+		Tecnico:      "Tecnico Importacao",
+	}
+	_, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Empty(t, responseError)
+
+	completeDTO := dtos.ServiceOrderCompleteDTO{
+		Base: dtos.Base{
+			ID: serviceOrders[0].ID,
+		},
+		Relatorio: "Instalação concluida",
+	}
+	_, responseError = serviceOrderServiceTest.CompleteServiceOrder(ctx, completeDTO)
+
+	require.Empty(t, responseError)
+
+	contract, responseError = contractServiceTest.FindContractByID(ctx, contract.ID)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.VIGOR, contract.Estado)
+	require.NotNil(t, contract.DataAtivacao)
+	require.WithinDuration(t, time.Now(), *contract.DataAtivacao, time.Minute)
+	require.Equal(t, dtos.FidelityEnd(*contract.DataAtivacao, plan), contract.DataFimFidelidade)
+
+	versions, responseError := contractVersionServiceTest.FindContractVersions(ctx, contract.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, versions, 2)
+	require.Equal(t, entities.CADASTRO, versions[0].Motivo)
+	require.Equal(t, entities.ATIVACAO, versions[1].Motivo)
+	require.True(t, contract.DataAtivacao.Equal(versions[1].DataEfetiva))
+	require.Equal(t, versions[1].ID, contract.VersaoID)
+}
+
+// TestImportRecordsClientByName testa se a linha sem documento usa o cliente ativo com o nome, mesmo com um cliente
+// removido de mesmo nome, e se é recusada quando o nome identifica mais de um cliente.
+func TestImportRecordsClientByName(t *testing.T) {
+	active, err := clientRepositoryFake.CreateClient(ctx, entities.Cliente{Nome: "Test 152.0", Tipo: entities.FISICO})
+
+	require.NoError(t, err)
+
+	for _, client := range []entities.Cliente{
+		{Nome: "Test 152.0", Tipo: entities.FISICO},
+		{Nome: "Test 154.0", Tipo: entities.FISICO},
+		{Nome: "Test 154.0", Tipo: entities.FISICO},
+	} {
+		deleted, err := clientRepositoryFake.CreateClient(ctx, client)
+
+		require.NoError(t, err)
+		require.NoError(t, clientRepositoryFake.DeleteClient(ctx, deleted))
+	}
+
+	for i := 0; i < 2; i++ {
+		_, err = clientRepositoryFake.CreateClient(ctx, entities.Cliente{Nome: "Test 153.0", Tipo: entities.FISICO})
+
+		require.NoError(t, err)
+	}
+
+	file := "cliente_nome;cliente_tipo;cep;numero;logradouro;bairro;cidade;uf\n" +
+		"Test 152.0;fisico;01001000;139;LogradouroTest 139.0;BairroTest;São Paulo;SP\n" +
+		"Test 153.0;fisico;01001000;140;LogradouroTest 140.0;BairroTest;São Paulo;SP\n" +
+		"Test 154.0;fisico;01001000;141;LogradouroTest 141.0;BairroTest;São Paulo;SP\n"
+
+	result, responseError := importServiceTest.ImportRecords(ctx, strings.NewReader(file), dtos.ImportOptions{})
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, result.Importadas)
+	require.Equal(t, 2, result.Rejeitadas)
+	require.Zero(t, result.ClientesCriados)
+	require.Equal(t, []dtos.ImportRowError{
+		{Linha: 3, Erro: "cliente_nome: " + utils.AmbiguousClientName},
+		{Linha: 4, Erro: "cliente_nome: " + utils.AmbiguousClientName},
+	}, result.Erros)
+
+	points, responseError := pointServiceTest.FindPoints(ctx, active.ID, "")
+
+	require.Empty(t, responseError)
+	require.Len(t, points, 1)
+	require.Equal(t, 139, points[0].Endereco.Numero)
+}
+
+// TestImportRecordsInvalidFile testa se a importação recusa o arquivo sem as colunas obrigatorias e o mapeamento
+// com campos desconhecidos.
+func TestImportRecordsInvalidFile(t *testing.T) {
+	file := "cliente_nome;cliente_tipo\nTest 148.0;fisico\n"
+
+	result, responseError := importServiceTest.ImportRecords(ctx, strings.NewReader(file), dtos.ImportOptions{})
+
+	require.Equal(t, utils.InvalidImportFile+": cep, numero", responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, result)
+
+	options := dtos.ImportOptions{Mapeamento: map[string]string{"cliente_email": "EMAIL"}}
+	result, responseError = importServiceTest.ImportRecords(ctx, strings.NewReader(importFile), options)
+
+	require.Equal(t, utils.InvalidImportMapping+": cliente_email", responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, result)
+}
@@ -0,0 +1,402 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/gofrs/uuid"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/payment_service")
+
+// maxImportErrors limita a quantidade de erros retornados na importação do arquivo de retorno.
+const maxImportErrors = 100
+
+// PaymentService representa a interface de paymentService.
+type PaymentService interface {
+	CreatePayment(ctx context.Context, paymentDTO dtos.PaymentCreateDTO) (entities.Pagamento, utils.ResponseError)
+	FindPaymentsByInvoiceID(ctx context.Context, invoiceID string) ([]entities.Pagamento, utils.ResponseError)
+	ImportPayments(ctx context.Context, reader io.Reader) (dtos.PaymentImportResult, utils.ResponseError)
+	SuspendDelinquentContracts(ctx context.Context, now time.Time) (dtos.DelinquencyResult, utils.ResponseError)
+}
+
+type paymentService struct {
+	paymentRepository    repositories.PaymentRepository
+	invoiceRepository    repositories.InvoiceRepository
+	contractService      contractService.ContractService
+	contractEventService contractEventService.ContractEventService
+	clientPolicies       policies.ClientPolicies
+	billingPolicy        policies.BillingPolicy
+	logger               *slog.Logger
+}
+
+func (service *paymentService) CreatePayment(ctx context.Context, paymentDTO dtos.PaymentCreateDTO) (entities.Pagamento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PaymentService.CreatePayment")
+	defer span.End()
+
+	payment := entities.Pagamento{
+		FaturaID:      paymentDTO.FaturaID,
+		Valor:         paymentDTO.Valor,
+		DataPagamento: time.Now(),
+		Origem:        entities.MANUAL,
+		Identificador: strings.TrimSpace(paymentDTO.Identificador),
+	}
+
+	if paymentDTO.DataPagamento != nil {
+		payment.DataPagamento = *paymentDTO.DataPagamento
+	}
+
+	return service.registerPayment(ctx, payment)
+}
+
+func (service *paymentService) FindPaymentsByInvoiceID(ctx context.Context, invoiceID string) ([]entities.Pagamento, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PaymentService.FindPaymentsByInvoiceID")
+	defer span.End()
+
+	payments, err := service.paymentRepository.FindPaymentsByInvoiceID(ctx, invoiceID)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return payments, utils.ResponseError{}
+}
+
+// ImportPayments registra os pagamentos do arquivo de retorno do banco, em largura fixa. Apenas as linhas de
+// detalhe, iniciadas por 1, são lidas:
+//
+//	1       tipo do registro (1)
+//	2-37    id da fatura
+//	38-45   data do pagamento (DDMMAAAA)
+//	46-58   valor pago em centavos, com zeros a esquerda
+//	59-78   identificador do pagamento no banco (opcional)
+//
+// Os pagamentos com um identificador já importado são ignorados, o que permite importar o mesmo arquivo novamente.
+func (service *paymentService) ImportPayments(ctx context.Context, reader io.Reader) (dtos.PaymentImportResult, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PaymentService.ImportPayments")
+	defer span.End()
+
+	result := dtos.PaymentImportResult{Erros: []string{}}
+
+	scanner := bufio.NewScanner(reader)
+	line := 0
+
+	for scanner.Scan() {
+		line++
+
+		record := strings.TrimRight(scanner.Text(), "\r")
+		if !strings.HasPrefix(record, "1") {
+			continue
+		}
+
+		payment, message := parseReturnRecord(record)
+		if message != "" {
+			addImportError(&result, fmt.Sprintf("linha %v: %v", line, message))
+			continue
+		}
+
+		_, responseError := service.registerPayment(ctx, payment)
+		if responseError.StatusCode == http.StatusInternalServerError || responseError.StatusCode == http.StatusGatewayTimeout {
+			return dtos.PaymentImportResult{}, responseError
+		}
+
+		if responseError != (utils.ResponseError{}) {
+			addImportError(&result, fmt.Sprintf("linha %v: %v", line, responseError.Message))
+			continue
+		}
+
+		result.Importados++
+	}
+
+	if err := scanner.Err(); err != nil {
+		return dtos.PaymentImportResult{}, utils.NewResponseError(utils.InvalidReturnFile, http.StatusBadRequest)
+	}
+
+	service.logger.InfoContext(ctx, "payments imported", slog.Int("importados", result.Importados),
+		slog.Int("ignorados", result.Ignorados))
+
+	return result, utils.ResponseError{}
+}
+
+// SuspendDelinquentContracts marca as faturas vencidas e suspende os contratos em vigor dos clientes com faturas
+// sem pagamento após a carencia. Os clientes isentos pela politica do seu tipo mantêm os contratos em vigor.
+func (service *paymentService) SuspendDelinquentContracts(ctx context.Context, now time.Time) (dtos.DelinquencyResult, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PaymentService.SuspendDelinquentContracts")
+	defer span.End()
+
+	result := dtos.DelinquencyResult{ContratosSuspensos: []string{}, ContratosIsentos: []string{}}
+
+	invoices, err := service.invoiceRepository.FindUnpaidInvoicesDueBefore(ctx, now)
+	if err != nil {
+		return dtos.DelinquencyResult{}, utils.NewInternalResponseError(err)
+	}
+
+	delinquentClients := []string{}
+
+	for _, invoice := range invoices {
+		state := dtos.InvoiceState(invoice, now)
+
+		if state == entities.VENCIDA {
+			result.FaturasVencidas++
+		}
+
+		if state != invoice.Estado {
+			invoice.Estado = state
+
+			_, err := service.invoiceRepository.UpdateInvoice(ctx, invoice)
+			if err != nil {
+				return dtos.DelinquencyResult{}, utils.NewInternalResponseError(err)
+			}
+		}
+
+		if dtos.IsDelinquent(invoice, now, service.billingPolicy.DiasCarencia) && !slices.Contains(delinquentClients, invoice.ClienteID) {
+			delinquentClients = append(delinquentClients, invoice.ClienteID)
+		}
+	}
+
+	for _, clientID := range delinquentClients {
+		contracts, responseError := service.contractService.FindContracts(ctx, clientID, "", false)
+		if responseError != (utils.ResponseError{}) {
+			return dtos.DelinquencyResult{}, responseError
+		}
+
+		for _, contract := range contracts {
+			if contract.Estado != entities.VIGOR {
+				continue
+			}
+
+			if service.clientPolicies.CheckAutomaticSuspension(contract.Ponto.Cliente.Tipo) != nil {
+				result.ContratosIsentos = append(result.ContratosIsentos, contract.ID)
+				continue
+			}
+
+			contractDTO := dtos.ContractUpdateDTO{
+				Base: dtos.Base{
+					ID: contract.ID,
+				},
+				Estado:     entities.DESATIVADO,
+				Motivo:     entities.INADIMPLENCIA,
+				Automatica: true,
+			}
+
+			_, responseError := service.contractService.UpdateContract(ctx, contractDTO)
+			if responseError != (utils.ResponseError{}) {
+				return dtos.DelinquencyResult{}, responseError
+			}
+
+			service.logger.InfoContext(ctx, "contract suspended for delinquency", slog.String("contrato_id", contract.ID),
+				slog.String("cliente_id", clientID))
+
+			result.ContratosSuspensos = append(result.ContratosSuspensos, contract.ID)
+		}
+	}
+
+	service.logger.InfoContext(ctx, "delinquency check finished", slog.Int("faturas_vencidas", result.FaturasVencidas),
+		slog.Int("contratos_suspensos", len(result.ContratosSuspensos)), slog.Int("contratos_isentos", len(result.ContratosIsentos)))
+
+	return result, utils.ResponseError{}
+}
+
+// registerPayment registra o pagamento e atualiza o valor pago e o estado da fatura. Quando a fatura é quitada e o
+// cliente não possui outras faturas em atraso, os contratos suspensos por inadimplencia são reativados.
+func (service *paymentService) registerPayment(ctx context.Context, payment entities.Pagamento) (entities.Pagamento, utils.ResponseError) {
+	if _, err := uuid.FromString(payment.FaturaID); err != nil {
+		return entities.Pagamento{}, utils.NewResponseError(utils.InvoiceNotFound, http.StatusNotFound)
+	}
+
+	invoice, err := service.invoiceRepository.FindInvoiceByID(ctx, payment.FaturaID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Pagamento{}, utils.NewResponseError(utils.InvoiceNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Pagamento{}, utils.NewInternalResponseError(err)
+	}
+
+	if payment.Identificador != "" {
+		_, err := service.paymentRepository.FindPaymentByIdentifier(ctx, payment.Identificador)
+		if err == nil {
+			return entities.Pagamento{}, utils.NewResponseError(utils.PaymentAlreadyExists, http.StatusConflict)
+		}
+
+		if !errors.Is(err, repositories.ErrNotFound) {
+			return entities.Pagamento{}, utils.NewInternalResponseError(err)
+		}
+	}
+
+	if invoice.ValorPago >= invoice.Total {
+		return entities.Pagamento{}, utils.NewResponseError(utils.InvoiceAlreadyPaid, http.StatusConflict)
+	}
+
+	payment, invoice, err = service.paymentRepository.CreatePayment(ctx, payment)
+	if errors.Is(err, repositories.ErrAlreadyExists) {
+		return entities.Pagamento{}, utils.NewResponseError(utils.PaymentAlreadyExists, http.StatusConflict)
+	}
+
+	if errors.Is(err, repositories.ErrInvoicePaid) {
+		return entities.Pagamento{}, utils.NewResponseError(utils.InvoiceAlreadyPaid, http.StatusConflict)
+	}
+
+	if err != nil {
+		return entities.Pagamento{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "payment registered", slog.String("pagamento_id", payment.ID),
+		slog.String("fatura_id", invoice.ID), slog.Int64("valor", payment.Valor), slog.String("estado", string(invoice.Estado)))
+
+	if invoice.Estado == entities.PAGA {
+		responseError := service.reactivateContracts(ctx, invoice.ClienteID)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Pagamento{}, responseError
+		}
+	}
+
+	return payment, utils.ResponseError{}
+}
+
+// reactivateContracts coloca em vigor os contratos do cliente suspensos por inadimplencia, quando ele não possui
+// mais faturas em atraso.
+func (service *paymentService) reactivateContracts(ctx context.Context, clientID string) utils.ResponseError {
+	now := time.Now()
+
+	invoices, err := service.invoiceRepository.FindInvoices(ctx, clientID, "")
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	for _, invoice := range invoices {
+		if dtos.IsDelinquent(invoice, now, service.billingPolicy.DiasCarencia) {
+			return utils.ResponseError{}
+		}
+	}
+
+	contracts, responseError := service.contractService.FindContracts(ctx, clientID, "", false)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
+	}
+
+	for _, contract := range contracts {
+		if contract.Estado != entities.DESATIVADO {
+			continue
+		}
+
+		contractEvents, responseError := service.contractEventService.FindContractEventsByContractID(ctx, contract.ID)
+		if responseError != (utils.ResponseError{}) {
+			return responseError
+		}
+
+		sort.SliceStable(contractEvents, func(i, j int) bool {
+			return contractEvents[i].DataCriacao.Before(contractEvents[j].DataCriacao)
+		})
+
+		suspension := entities.ContratoEvento{}
+		for _, contractEvent := range contractEvents {
+			if contractEvent.Tipo == entities.ESTADO && contractEvent.EstadoPosterior != contractEvent.EstadoAnterior {
+				suspension = contractEvent
+			}
+		}
+
+		if suspension.Motivo != entities.INADIMPLENCIA {
+			continue
+		}
+
+		contractDTO := dtos.ContractUpdateDTO{
+			Base: dtos.Base{
+				ID: contract.ID,
+			},
+			Estado:     entities.VIGOR,
+			Motivo:     entities.PAGAMENTO,
+			Automatica: true,
+		}
+
+		_, responseError = service.contractService.UpdateContract(ctx, contractDTO)
+		if responseError != (utils.ResponseError{}) {
+			return responseError
+		}
+
+		service.logger.InfoContext(ctx, "contract reactivated after payment", slog.String("contrato_id", contract.ID),
+			slog.String("cliente_id", clientID))
+	}
+
+	return utils.ResponseError{}
+}
+
+// parseReturnRecord le o pagamento de uma linha de detalhe do arquivo de retorno.
+func parseReturnRecord(record string) (entities.Pagamento, string) {
+	if len(record) < 58 {
+		return entities.Pagamento{}, "registro com menos de 58 posições"
+	}
+
+	// O id invalido é rejeitado na linha, já que a consulta da fatura falharia no banco e interromperia o arquivo.
+	invoiceID := strings.TrimSpace(record[1:37])
+	if _, err := uuid.FromString(invoiceID); err != nil {
+		return entities.Pagamento{}, "id da fatura invalido: " + invoiceID
+	}
+
+	paymentDate, err := time.ParseInLocation("02012006", record[37:45], time.Local)
+	if err != nil {
+		return entities.Pagamento{}, "data do pagamento invalida: " + record[37:45]
+	}
+
+	value, err := strconv.ParseInt(record[45:58], 10, 64)
+	if err != nil || value <= 0 {
+		return entities.Pagamento{}, "valor invalido: " + record[45:58]
+	}
+
+	identifier := ""
+	if len(record) > 58 {
+		identifier = strings.TrimSpace(record[58:min(len(record), 78)])
+	}
+
+	payment := entities.Pagamento{
+		FaturaID:      invoiceID,
+		Valor:         value,
+		DataPagamento: paymentDate,
+		Origem:        entities.RETORNO,
+		Identificador: identifier,
+	}
+
+	return payment, ""
+}
+
+// addImportError conta a linha ignorada, guardando a mensagem enquanto o limite de erros não é atingido.
+func addImportError(result *dtos.PaymentImportResult, message string) {
+	result.Ignorados++
+
+	if len(result.Erros) < maxImportErrors {
+		result.Erros = append(result.Erros, message)
+	}
+}
+
+// NewPaymentService cria uma nova instancia de PaymentService.
+func NewPaymentService(paymentRepository repositories.PaymentRepository, invoiceRepository repositories.InvoiceRepository,
+	contractService contractService.ContractService, contractEventService contractEventService.ContractEventService,
+	clientPolicies policies.ClientPolicies, billingPolicy policies.BillingPolicy, logger *slog.Logger) PaymentService {
+	return &paymentService{
+		paymentRepository:    paymentRepository,
+		invoiceRepository:    invoiceRepository,
+		contractService:      contractService,
+		contractEventService: contractEventService,
+		clientPolicies:       clientPolicies,
+		billingPolicy:        billingPolicy,
+		logger:               logger,
+	}
+}
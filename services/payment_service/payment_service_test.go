@@ -0,0 +1,274 @@
+package services_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	paymentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/payment_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+	dbInvoice           = repositoriesFake.DBInvoice
+	dbPayment           = repositoriesFake.DBPayment
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+	invoiceRepositoryFake           = repositoriesFake.NewInvoiceRepositoryFake(dbInvoice)
+	paymentRepositoryFake           = repositoriesFake.NewPaymentRepositoryFake(dbPayment, dbInvoice)
+
+	// Policies
+	clientPolicies = policies.Default()
+	billingPolicy  = policies.DefaultBilling()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	paymentServiceTest         = paymentService.NewPaymentService(paymentRepositoryFake, invoiceRepositoryFake, contractServiceTest, contractEventServiceTest, clientPolicies, billingPolicy, logNop)
+)
+
+// createInvoicedContract cadastra um contrato em vigor para um novo cliente, com uma fatura no vencimento informado.
+func createInvoicedContract(t *testing.T, name string, clientType entities.ClientType, street string, dueDate time.Time) (entities.Contrato, entities.Fatura) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: name,
+		Tipo: clientType,
+	}
+	client, responseError := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	require.Empty(t, responseError)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: street,
+		Bairro:     "BairroTest " + street,
+		Numero:     103,
+	}
+	address, responseError := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	require.Empty(t, responseError)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	require.Empty(t, responseError)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	invoice, _ := invoiceRepositoryFake.CreateInvoice(ctx, entities.Fatura{
+		ClienteID:   client.ID,
+		Competencia: dueDate.AddDate(0, -1, 0).Format(dtos.CompetenciaLayout),
+		Total:       9990,
+		Estado:      entities.ABERTA,
+		Vencimento:  dueDate,
+		Detalhes:    "[]",
+	})
+
+	return contract, invoice
+}
+
+// lastStateEvent retorna o ultimo evento de alteração de estado do contrato.
+func lastStateEvent(t *testing.T, contractID string) entities.ContratoEvento {
+	contractEvents, responseError := contractEventServiceTest.FindContractEventsByContractID(ctx, contractID)
+
+	require.Empty(t, responseError)
+
+	return contractEvents[len(contractEvents)-1]
+}
+
+// TestCreatePayment testa se os pagamentos atualizam o valor pago e o estado da fatura.
+func TestCreatePayment(t *testing.T) {
+	_, invoice := createInvoicedContract(t, "Test 118.0", entities.FISICO, "LogradouroTest 103.0", time.Now().AddDate(0, 0, 5))
+
+	paymentDTO := dtos.PaymentCreateDTO{
+		FaturaID: invoice.ID,
+		Valor:    4000,
+	}
+	payment, responseError := paymentServiceTest.CreatePayment(ctx, paymentDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.MANUAL, payment.Origem)
+
+	invoice, _ = invoiceRepositoryFake.FindInvoiceByID(ctx, invoice.ID)
+
+	require.Equal(t, int64(4000), invoice.ValorPago)
+	require.Equal(t, entities.PARCIAL, invoice.Estado)
+
+	paymentDTO.Valor = 5990
+	_, responseError = paymentServiceTest.CreatePayment(ctx, paymentDTO)
+
+	require.Empty(t, responseError)
+
+	invoice, _ = invoiceRepositoryFake.FindInvoiceByID(ctx, invoice.ID)
+
+	require.Equal(t, entities.PAGA, invoice.Estado)
+
+	payment, responseError = paymentServiceTest.CreatePayment(ctx, paymentDTO)
+
+	require.Equal(t, utils.InvoiceAlreadyPaid, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, payment)
+
+	payments, responseError := paymentServiceTest.FindPaymentsByInvoiceID(ctx, invoice.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, payments, 2)
+
+	paymentDTO.FaturaID = "invalid_id"
+	payment, responseError = paymentServiceTest.CreatePayment(ctx, paymentDTO)
+
+	require.Equal(t, utils.InvoiceNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, payment)
+}
+
+// TestSuspendDelinquentContracts testa se a rotina de inadimplencia suspende os contratos após a carencia,
+// respeitando os clientes isentos, e se o pagamento reativa o contrato.
+func TestSuspendDelinquentContracts(t *testing.T) {
+	now := time.Now()
+
+	delinquentContract, delinquentInvoice := createInvoicedContract(t, "Test 119.0", entities.FISICO, "LogradouroTest 104.0", now.AddDate(0, 0, -30))
+	exemptContract, _ := createInvoicedContract(t, "Test 120.0", entities.ESPECIAL, "LogradouroTest 105.0", now.AddDate(0, 0, -30))
+	graceContract, graceInvoice := createInvoicedContract(t, "Test 121.0", entities.FISICO, "LogradouroTest 106.0", now.AddDate(0, 0, -5))
+
+	result, responseError := paymentServiceTest.SuspendDelinquentContracts(ctx, now)
+
+	require.Empty(t, responseError)
+	require.Contains(t, result.ContratosSuspensos, delinquentContract.ID)
+	require.Contains(t, result.ContratosIsentos, exemptContract.ID)
+	require.NotContains(t, result.ContratosSuspensos, graceContract.ID)
+
+	graceInvoice, _ = invoiceRepositoryFake.FindInvoiceByID(ctx, graceInvoice.ID)
+
+	require.Equal(t, entities.VENCIDA, graceInvoice.Estado)
+
+	contract, _ := contractServiceTest.FindContractByID(ctx, delinquentContract.ID)
+
+	require.Equal(t, entities.DESATIVADO, contract.Estado)
+	require.Equal(t, entities.INADIMPLENCIA, lastStateEvent(t, contract.ID).Motivo)
+
+	contract, _ = contractServiceTest.FindContractByID(ctx, exemptContract.ID)
+
+	require.Equal(t, entities.VIGOR, contract.Estado)
+
+	paymentDTO := dtos.PaymentCreateDTO{
+		FaturaID: delinquentInvoice.ID,
+		Valor:    delinquentInvoice.Total,
+	}
+	_, responseError = paymentServiceTest.CreatePayment(ctx, paymentDTO)
+
+	require.Empty(t, responseError)
+
+	contract, _ = contractServiceTest.FindContractByID(ctx, delinquentContract.ID)
+
+	require.Equal(t, entities.VIGOR, contract.Estado)
+	require.Equal(t, entities.PAGAMENTO, lastStateEvent(t, contract.ID).Motivo)
+
+	contractDTO := dtos.ContractUpdateDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		Estado: entities.DESATIVADO,
+		Motivo: entities.INADIMPLENCIA,
+	}
+	_, responseError = contractServiceTest.UpdateContract(ctx, contractDTO)
+
+	require.Equal(t, utils.ReservedContractMotive, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+}
+
+// TestImportPayments testa se o arquivo de retorno registra os pagamentos, ignorando os repetidos e os invalidos.
+func TestImportPayments(t *testing.T) {
+	_, invoice := createInvoicedContract(t, "Test 122.0", entities.FISICO, "LogradouroTest 107.0", time.Now().AddDate(0, 0, 5))
+
+	detail := fmt.Sprintf("1%-36s%s%013d%-20s", invoice.ID, time.Now().Format("02012006"), 9990, "RET0001")
+	file := strings.Join([]string{
+		"0RETORNO",
+		detail,
+		detail,
+		"1" + invoice.ID + "31132024",
+		fmt.Sprintf("1%-36s%s%013d%-20s", "Test 122.0", time.Now().Format("02012006"), 9990, "RET0002"),
+		"9",
+	}, "\n")
+
+	result, responseError := paymentServiceTest.ImportPayments(ctx, strings.NewReader(file))
+
+	require.Empty(t, responseError)
+	require.Equal(t, 1, result.Importados)
+	require.Equal(t, 3, result.Ignorados)
+	require.Equal(t, "linha 3: "+utils.PaymentAlreadyExists, result.Erros[0])
+	require.Equal(t, "linha 5: id da fatura invalido: Test 122.0", result.Erros[2])
+
+	invoice, _ = invoiceRepositoryFake.FindInvoiceByID(ctx, invoice.ID)
+
+	require.Equal(t, entities.PAGA, invoice.Estado)
+
+	payments, _ := paymentServiceTest.FindPaymentsByInvoiceID(ctx, invoice.ID)
+
+	require.Len(t, payments, 1)
+	require.Equal(t, entities.RETORNO, payments[0].Origem)
+	require.Equal(t, "RET0001", payments[0].Identificador)
+}
@@ -0,0 +1,217 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/mashingan/smapping"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/plan_service")
+
+// PlanService representa a interface de planService.
+type PlanService interface {
+	CreatePlan(ctx context.Context, planDTO dtos.PlanCreateDTO) (entities.Plano, utils.ResponseError)
+	UpdatePlan(ctx context.Context, planDTO dtos.PlanUpdateDTO) (entities.Plano, utils.ResponseError)
+	FindPlanByID(ctx context.Context, planID string) (entities.Plano, utils.ResponseError)
+	DeletePlanByID(ctx context.Context, planID string) utils.ResponseError
+	FindPlans(ctx context.Context, name string) ([]entities.Plano, utils.ResponseError)
+}
+
+type planService struct {
+	planRepository     repositories.PlanRepository
+	contractRepository repositories.ContractRepository
+	logger             *slog.Logger
+}
+
+func (service *planService) CreatePlan(ctx context.Context, planDTO dtos.PlanCreateDTO) (entities.Plano, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PlanService.CreatePlan")
+	defer span.End()
+
+	plan := entities.Plano{}
+
+	err := smapping.FillStruct(&plan, smapping.MapFields(&planDTO))
+	if err != nil {
+		return entities.Plano{},
+			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
+	}
+
+	plan.Nome = strings.TrimSpace(plan.Nome)
+
+	planAlreadyExists, err := service.planRepository.FindPlanByName(ctx, plan.Nome)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Plano{}, utils.NewInternalResponseError(err)
+	}
+
+	switch {
+	case planAlreadyExists.DataRemocao.Valid:
+		plan.ID = planAlreadyExists.ID
+		plan.DataCriacao = planAlreadyExists.DataCriacao
+
+		plan, err := service.planRepository.UpdatePlan(ctx, plan)
+		if err != nil {
+			return entities.Plano{}, utils.NewInternalResponseError(err)
+		}
+
+		service.logger.InfoContext(ctx, "plan restored", slog.String("plano_id", plan.ID))
+
+		return plan, utils.ResponseError{}
+
+	case err == nil:
+		return entities.Plano{}, utils.NewResponseError(utils.PlanAlreadyExists, http.StatusConflict)
+
+	default:
+		plan, err := service.planRepository.CreatePlan(ctx, plan)
+		if err != nil {
+			return entities.Plano{}, utils.NewInternalResponseError(err)
+		}
+
+		service.logger.InfoContext(ctx, "plan created", slog.String("plano_id", plan.ID))
+
+		return plan, utils.ResponseError{}
+	}
+}
+
+func (service *planService) UpdatePlan(ctx context.Context, planDTO dtos.PlanUpdateDTO) (entities.Plano, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PlanService.UpdatePlan")
+	defer span.End()
+
+	plan, responseError := service.FindPlanByID(ctx, planDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Plano{}, responseError
+	}
+
+	if name := strings.TrimSpace(planDTO.Nome); name != "" && name != plan.Nome {
+		if !dtos.IsValidTextLenght(name) {
+			return entities.Plano{}, utils.NewResponseError("nome: "+utils.InvalidNumberOfCaracter, http.StatusBadRequest)
+		}
+
+		_, err := service.planRepository.FindPlanByName(ctx, name)
+		if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+			return entities.Plano{}, utils.NewInternalResponseError(err)
+		}
+
+		if err == nil {
+			return entities.Plano{}, utils.NewResponseError(utils.PlanAlreadyExists, http.StatusConflict)
+		}
+
+		plan.Nome = name
+	}
+
+	if planDTO.Velocidade != nil {
+		if *planDTO.Velocidade < 1 {
+			return entities.Plano{}, utils.NewResponseError("velocidade: "+utils.InvalidSpeed, http.StatusBadRequest)
+		}
+
+		plan.Velocidade = *planDTO.Velocidade
+	}
+
+	if planDTO.PrecoMensal != nil {
+		if *planDTO.PrecoMensal < 0 {
+			return entities.Plano{}, utils.NewResponseError("preco_mensal: "+utils.InvalidNegativeValue, http.StatusBadRequest)
+		}
+
+		plan.PrecoMensal = *planDTO.PrecoMensal
+	}
+
+	if planDTO.MesesFidelidade != nil {
+		if *planDTO.MesesFidelidade < 0 {
+			return entities.Plano{}, utils.NewResponseError("meses_fidelidade: "+utils.InvalidNegativeValue, http.StatusBadRequest)
+		}
+
+		plan.MesesFidelidade = *planDTO.MesesFidelidade
+	}
+
+	if planDTO.MultaFidelidade != nil {
+		if *planDTO.MultaFidelidade < 0 {
+			return entities.Plano{}, utils.NewResponseError("multa_fidelidade: "+utils.InvalidNegativeValue, http.StatusBadRequest)
+		}
+
+		plan.MultaFidelidade = *planDTO.MultaFidelidade
+	}
+
+	plan, err := service.planRepository.UpdatePlan(ctx, plan)
+	if err != nil {
+		return entities.Plano{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "plan updated", slog.String("plano_id", plan.ID))
+
+	return plan, utils.ResponseError{}
+}
+
+func (service *planService) FindPlanByID(ctx context.Context, planID string) (entities.Plano, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PlanService.FindPlanByID")
+	defer span.End()
+
+	plan, err := service.planRepository.FindPlanByID(ctx, planID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Plano{}, utils.NewResponseError(utils.PlanNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Plano{}, utils.NewInternalResponseError(err)
+	}
+
+	return plan, utils.ResponseError{}
+}
+
+// DeletePlanByID remove o plano do catalogo, desde que nenhum contrato ativo o utilize.
+func (service *planService) DeletePlanByID(ctx context.Context, planID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "PlanService.DeletePlanByID")
+	defer span.End()
+
+	plan, responseError := service.FindPlanByID(ctx, planID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
+	}
+
+	contracts, err := service.contractRepository.CountActiveContractsByPlanID(ctx, planID)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	if contracts != 0 {
+		return utils.NewResponseError(utils.PlanInUse, http.StatusConflict)
+	}
+
+	err = service.planRepository.DeletePlan(ctx, plan)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "plan deleted", slog.String("plano_id", planID))
+
+	return utils.ResponseError{}
+}
+
+func (service *planService) FindPlans(ctx context.Context, name string) ([]entities.Plano, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PlanService.FindPlans")
+	defer span.End()
+
+	plans, err := service.planRepository.FindPlans(ctx, name)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return plans, utils.ResponseError{}
+}
+
+// NewPlanService cria uma nova instancia de PlanService.
+func NewPlanService(planRepository repositories.PlanRepository, contractRepository repositories.ContractRepository, logger *slog.Logger) PlanService {
+	return &planService{
+		planRepository:     planRepository,
+		contractRepository: contractRepository,
+		logger:             logger,
+	}
+}
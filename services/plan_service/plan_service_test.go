@@ -0,0 +1,214 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	planService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/plan_service"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	planServiceTest            = planService.NewPlanService(planRepositoryFake, contractRepositoryFake, logNop)
+)
+
+// TestCreatePlan testa se é possivel criar um novo plano.
+func TestCreatePlan(t *testing.T) {
+	planDTO := dtos.PlanCreateDTO{
+		Nome:            "PlanoTest 1.0",
+		Velocidade:      300,
+		PrecoMensal:     9990,
+		MesesFidelidade: 12,
+	}
+
+	plan, responseError := planServiceTest.CreatePlan(ctx, planDTO)
+
+	require.Empty(t, responseError)
+	require.NotEqual(t, "", plan.ID)
+	require.Equal(t, int64(9990), plan.PrecoMensal)
+	require.Equal(t, 12, plan.MesesFidelidade)
+}
+
+// TestCreatePlanWithNameExistent testa se não é possivel criar dois planos com o mesmo nome.
+func TestCreatePlanWithNameExistent(t *testing.T) {
+	planDTO := dtos.PlanCreateDTO{
+		Nome:       "PlanoTest 2.0",
+		Velocidade: 100,
+	}
+
+	planServiceTest.CreatePlan(ctx, planDTO)
+	plan, responseError := planServiceTest.CreatePlan(ctx, planDTO)
+
+	require.Equal(t, utils.PlanAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, plan)
+}
+
+// TestUpdatePlan testa se é possivel atualizar apenas os campos informados do plano.
+func TestUpdatePlan(t *testing.T) {
+	planDTO := dtos.PlanCreateDTO{
+		Nome:            "PlanoTest 3.0",
+		Velocidade:      200,
+		PrecoMensal:     7990,
+		MesesFidelidade: 12,
+	}
+	plan, _ := planServiceTest.CreatePlan(ctx, planDTO)
+
+	price := int64(8990)
+	planUpdateDTO := dtos.PlanUpdateDTO{
+		Base: dtos.Base{
+			ID: plan.ID,
+		},
+		PrecoMensal: &price,
+	}
+	planUpdated, responseError := planServiceTest.UpdatePlan(ctx, planUpdateDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, price, planUpdated.PrecoMensal)
+	require.Equal(t, plan.Nome, planUpdated.Nome)
+	require.Equal(t, plan.Velocidade, planUpdated.Velocidade)
+	require.Equal(t, plan.MesesFidelidade, planUpdated.MesesFidelidade)
+
+	speed := 0
+	planUpdateDTO.Velocidade = &speed
+	planUpdated, responseError = planServiceTest.UpdatePlan(ctx, planUpdateDTO)
+
+	require.Equal(t, "velocidade: "+utils.InvalidSpeed, responseError.Message)
+	require.Empty(t, planUpdated)
+}
+
+// TestDeletePlanInUse testa se não é possivel remover um plano usado por um contrato ativo.
+func TestDeletePlanInUse(t *testing.T) {
+	planDTO := dtos.PlanCreateDTO{
+		Nome:       "PlanoTest 4.0",
+		Velocidade: 500,
+	}
+	plan, _ := planServiceTest.CreatePlan(ctx, planDTO)
+
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 114.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 99.0",
+		Bairro:     "BairroTest 99.0",
+		Numero:     99,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+		PlanoID: plan.ID,
+	}
+	contract, _ := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	responseError := planServiceTest.DeletePlanByID(ctx, plan.ID)
+
+	require.Equal(t, utils.PlanInUse, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+
+	for _, state := range []entities.ContractState{entities.DESATIVADO, entities.CANCELADO} {
+		contractUpdateDTO := dtos.ContractUpdateDTO{
+			Base: dtos.Base{
+				ID: contract.ID,
+			},
+			Estado: state,
+		}
+		contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+	}
+
+	responseError = planServiceTest.DeletePlanByID(ctx, plan.ID)
+
+	require.Empty(t, responseError)
+
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
+
+	require.Equal(t, plan.ID, contractFound.Plano.ID)
+}
+
+// TestFindPlans testa se é possivel listar os planos do mais barato ao mais caro.
+func TestFindPlans(t *testing.T) {
+	planServiceTest.CreatePlan(ctx, dtos.PlanCreateDTO{Nome: "PlanoTest 5.1", Velocidade: 1000, PrecoMensal: 19990})
+	planServiceTest.CreatePlan(ctx, dtos.PlanCreateDTO{Nome: "PlanoTest 5.0", Velocidade: 50, PrecoMensal: 4990})
+
+	plans, responseError := planServiceTest.FindPlans(ctx, "plano")
+
+	require.Empty(t, responseError)
+	require.NotEmpty(t, plans)
+
+	for i := 1; i < len(plans); i++ {
+		require.LessOrEqual(t, plans[i-1].PrecoMensal, plans[i].PrecoMensal)
+	}
+}
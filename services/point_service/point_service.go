@@ -1,26 +1,42 @@
 package services
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"log/slog"
+	"math"
 	"net/http"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
 	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/mashingan/smapping"
+	"go.opentelemetry.io/otel"
 )
 
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service")
+
 // PointService representa a interface de pointService.
 type PointService interface {
-	CreatePoint(pointDTO dtos.PointCreateDTO) (entities.Ponto, utils.ResponseError)
-	FindPointByID(pointID string) entities.Ponto
-	FindPointByClientIDAndAddressID(clientID string, addressID string) entities.Ponto
-	DeletePointByID(pointID string) utils.ResponseError
-	DeletePointsByClientID(clientID string) utils.ResponseError
-	DeletePointsByAddressID(addressID string) utils.ResponseError
-	FindPoints(clientID string, addressID string) []entities.Ponto
+	CreatePoint(ctx context.Context, pointDTO dtos.PointCreateDTO) (entities.Ponto, utils.ResponseError)
+	FindPointByID(ctx context.Context, pointID string) (entities.Ponto, utils.ResponseError)
+	FindPointByClientIDAndAddressID(ctx context.Context, clientID string, addressID string) (entities.Ponto, utils.ResponseError)
+	DeletePointByID(ctx context.Context, pointID string) utils.ResponseError
+	DeletePointsByClientID(ctx context.Context, clientID string) utils.ResponseError
+	DeletePointsByAddressID(ctx context.Context, addressID string) utils.ResponseError
+	FindPoints(ctx context.Context, clientID string, addressID string) ([]entities.Ponto, utils.ResponseError)
+	FindPointContracts(ctx context.Context, pointID string) ([]entities.Contrato, utils.ResponseError)
+	FindPointsInArea(ctx context.Context, filter dtos.PointGeoFilter) ([]dtos.PointGeoResponse, utils.ResponseError)
+	TransferPoint(ctx context.Context, pointDTO dtos.PointTransferDTO) (entities.Ponto, utils.ResponseError)
 }
 
 type pointService struct {
@@ -28,9 +44,15 @@ type pointService struct {
 	clientRepository  repositories.ClientRepository
 	addressReporitory repositories.AddressRepository
 	contractService   services.ContractService
+	equipmentService  equipmentService.EquipmentService
+	clientPolicies    policies.ClientPolicies
+	logger            *slog.Logger
 }
 
-func (service *pointService) CreatePoint(pointDTO dtos.PointCreateDTO) (entities.Ponto, utils.ResponseError) {
+func (service *pointService) CreatePoint(ctx context.Context, pointDTO dtos.PointCreateDTO) (entities.Ponto, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PointService.CreatePoint")
+	defer span.End()
+
 	point := entities.Ponto{}
 
 	err := smapping.FillStruct(&point, smapping.MapFields(&pointDTO))
@@ -39,129 +61,414 @@ func (service *pointService) CreatePoint(pointDTO dtos.PointCreateDTO) (entities
 			utils.NewResponseError(fmt.Sprintf("failed to map: %v", err), http.StatusInternalServerError)
 	}
 
-	clientExists := service.clientRepository.FindClientByID(pointDTO.ClienteID)
-	if clientExists == (entities.Cliente{}) {
+	client, err := service.clientRepository.FindClientByID(ctx, pointDTO.ClienteID)
+	if errors.Is(err, repositories.ErrNotFound) {
 		return entities.Ponto{}, utils.NewResponseError(utils.ClientNotFound, http.StatusNotFound)
 	}
 
-	addressExists := service.addressReporitory.FindAddressByID(pointDTO.EnderecoID)
-	if addressExists == (entities.Endereco{}) {
+	if err != nil {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
+
+	_, err = service.addressReporitory.FindAddressByID(ctx, pointDTO.EnderecoID)
+	if errors.Is(err, repositories.ErrNotFound) {
 		return entities.Ponto{}, utils.NewResponseError(utils.AddressNotFound, http.StatusNotFound)
 	}
 
-	pointAlreadyExists := service.pointRepository.FindPointByClientIDAndAddressID(
+	if err != nil {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
+
+	pointAlreadyExists, err := service.pointRepository.FindPointByClientIDAndAddressID(ctx,
 		point.ClienteID, point.EnderecoID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
 
 	switch {
 	case pointAlreadyExists.DataRemocao.Valid:
+		responseError := service.checkPointLimit(ctx, client)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Ponto{}, responseError
+		}
+
 		point.ID = pointAlreadyExists.ID
 
-		point, err := service.pointRepository.UpdatePoint(point)
+		point, err := service.pointRepository.UpdatePoint(ctx, point)
 		if err != nil {
-			return entities.Ponto{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return entities.Ponto{}, utils.NewInternalResponseError(err)
 		}
 
+		service.logger.InfoContext(ctx, "point restored", slog.String("ponto_id", point.ID))
+
 		return point, utils.ResponseError{}
 
-	case (pointAlreadyExists != entities.Ponto{}):
+	case err == nil:
 		return entities.Ponto{}, utils.NewResponseError(utils.PointAlreadyExists, http.StatusConflict)
 
 	default:
-		point, err := service.pointRepository.CreatePoint(point)
+		responseError := service.checkPointLimit(ctx, client)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Ponto{}, responseError
+		}
+
+		point, err := service.pointRepository.CreatePoint(ctx, point)
 		if err != nil {
-			return entities.Ponto{}, utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return entities.Ponto{}, utils.NewInternalResponseError(err)
 		}
 
+		service.logger.InfoContext(ctx, "point created", slog.String("ponto_id", point.ID))
+
 		return point, utils.ResponseError{}
 	}
 }
 
-func (service *pointService) FindPointByID(pointID string) entities.Ponto {
-	return service.pointRepository.FindPointByID(pointID)
+func (service *pointService) FindPointByID(ctx context.Context, pointID string) (entities.Ponto, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PointService.FindPointByID")
+	defer span.End()
+
+	point, err := service.pointRepository.FindPointByID(ctx, pointID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Ponto{}, utils.NewResponseError(utils.PointNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
+
+	return point, utils.ResponseError{}
 }
 
-func (service *pointService) FindPointByClientIDAndAddressID(clientID string, addressID string) entities.Ponto {
-	return service.pointRepository.FindPointByClientIDAndAddressID(clientID, addressID)
+func (service *pointService) FindPointByClientIDAndAddressID(ctx context.Context, clientID string, addressID string) (entities.Ponto, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PointService.FindPointByClientIDAndAddressID")
+	defer span.End()
+
+	point, err := service.pointRepository.FindPointByClientIDAndAddressID(ctx, clientID, addressID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Ponto{}, utils.NewResponseError(utils.PointNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
+
+	return point, utils.ResponseError{}
 }
 
-func (service *pointService) DeletePointByID(pointID string) utils.ResponseError {
-	pointFound := service.pointRepository.FindPointByID(pointID)
+func (service *pointService) DeletePointByID(ctx context.Context, pointID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "PointService.DeletePointByID")
+	defer span.End()
 
-	if pointFound == (entities.Ponto{}) {
-		return utils.NewResponseError(utils.PointNotFound, http.StatusNotFound)
+	pointFound, responseError := service.FindPointByID(ctx, pointID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
 	}
 
-	err := service.pointRepository.DeletePoint(pointFound)
+	err := service.pointRepository.DeletePoint(ctx, pointFound)
 	if err != nil {
-		return utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+		return utils.NewInternalResponseError(err)
+	}
+
+	responseError = service.contractService.DeleteContractByPontoID(ctx, pointID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
 	}
 
-	responseError := service.contractService.DeleteContractByPontoID(pointID)
+	responseError = service.equipmentService.FlagEquipmentsForReturn(ctx, pointID, entities.PONTO_REMOVIDO)
 	if responseError != (utils.ResponseError{}) {
 		return responseError
 	}
 
+	service.logger.InfoContext(ctx, "point deleted", slog.String("ponto_id", pointID))
+
 	return utils.ResponseError{}
 }
 
-func (service *pointService) DeletePointsByClientID(clientID string) utils.ResponseError {
-	points := service.pointRepository.FindPointsByClientID(clientID)
+func (service *pointService) DeletePointsByClientID(ctx context.Context, clientID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "PointService.DeletePointsByClientID")
+	defer span.End()
+
+	points, err := service.pointRepository.FindPointsByClientID(ctx, clientID)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
 
 	if len(points) == 0 {
 		return utils.ResponseError{}
 	}
 
-	var err error
-
 	for _, point := range points {
-		err = service.pointRepository.DeletePoint(point)
+		err = service.pointRepository.DeletePoint(ctx, point)
 		if err != nil {
-			return utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return utils.NewInternalResponseError(err)
+		}
+
+		responseError := service.contractService.DeleteContractByPontoID(ctx, point.ID)
+		if responseError != (utils.ResponseError{}) {
+			return responseError
 		}
 
-		responseError := service.contractService.DeleteContractByPontoID(point.ID)
+		responseError = service.equipmentService.FlagEquipmentsForReturn(ctx, point.ID, entities.PONTO_REMOVIDO)
 		if responseError != (utils.ResponseError{}) {
 			return responseError
 		}
 	}
 
+	service.logger.InfoContext(ctx, "points deleted", slog.String("cliente_id", clientID), slog.Int("total", len(points)))
+
 	return utils.ResponseError{}
 }
 
-func (service *pointService) DeletePointsByAddressID(addressID string) utils.ResponseError {
-	points := service.pointRepository.FindPointsByAddressID(addressID)
+func (service *pointService) DeletePointsByAddressID(ctx context.Context, addressID string) utils.ResponseError {
+	ctx, span := tracer.Start(ctx, "PointService.DeletePointsByAddressID")
+	defer span.End()
+
+	points, err := service.pointRepository.FindPointsByAddressID(ctx, addressID)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
 
 	if len(points) == 0 {
 		return utils.ResponseError{}
 	}
 
-	var err error
-
 	for _, point := range points {
-		err = service.pointRepository.DeletePoint(point)
+		err = service.pointRepository.DeletePoint(ctx, point)
 		if err != nil {
-			return utils.NewResponseError(err.Error(), http.StatusInternalServerError)
+			return utils.NewInternalResponseError(err)
 		}
 
-		responseError := service.contractService.DeleteContractByPontoID(point.ID)
+		responseError := service.contractService.DeleteContractByPontoID(ctx, point.ID)
+		if responseError != (utils.ResponseError{}) {
+			return responseError
+		}
+
+		responseError = service.equipmentService.FlagEquipmentsForReturn(ctx, point.ID, entities.PONTO_REMOVIDO)
 		if responseError != (utils.ResponseError{}) {
 			return responseError
 		}
 	}
 
+	service.logger.InfoContext(ctx, "points deleted", slog.String("endereco_id", addressID), slog.Int("total", len(points)))
+
 	return utils.ResponseError{}
 }
 
-func (service *pointService) FindPoints(clientID string, addressID string) []entities.Ponto {
-	return service.pointRepository.FindPoints(clientID, addressID)
+func (service *pointService) FindPoints(ctx context.Context, clientID string, addressID string) ([]entities.Ponto, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PointService.FindPoints")
+	defer span.End()
+
+	points, err := service.pointRepository.FindPoints(ctx, clientID, addressID)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	pointIDs := []string{}
+	for _, point := range points {
+		pointIDs = append(pointIDs, point.ID)
+	}
+
+	contracts, responseError := service.contractService.FindCurrentContractsByPontoIDs(ctx, pointIDs)
+	if responseError != (utils.ResponseError{}) {
+		return nil, responseError
+	}
+
+	for i, point := range points {
+		if contract, ok := contracts[point.ID]; ok {
+			points[i].ContratoAtual = &contract
+		}
+	}
+
+	return points, utils.ResponseError{}
+}
+
+// FindPointContracts pesquisa os contratos do ponto ao longo do tempo, do mais antigo ao atual.
+func (service *pointService) FindPointContracts(ctx context.Context, pointID string) ([]entities.Contrato, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PointService.FindPointContracts")
+	defer span.End()
+
+	_, responseError := service.FindPointByID(ctx, pointID)
+	if responseError != (utils.ResponseError{}) {
+		return nil, responseError
+	}
+
+	return service.contractService.FindContractsByPontoID(ctx, pointID)
+}
+
+func (service *pointService) FindPointsInArea(ctx context.Context, filter dtos.PointGeoFilter) ([]dtos.PointGeoResponse, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PointService.FindPointsInArea")
+	defer span.End()
+
+	if filter.Perto == nil && len(filter.Poligono) == 0 {
+		return nil, utils.NewResponseError(utils.InvalidLocation, http.StatusBadRequest)
+	}
+
+	if filter.Perto != nil && len(filter.Poligono) == 0 && filter.Raio == 0 {
+		filter.Raio = dtos.DefaultSearchRadius
+	}
+
+	if filter.Raio < 0 || filter.Raio > dtos.MaxSearchRadius {
+		return nil, utils.NewResponseError(utils.InvalidRadius, http.StatusBadRequest)
+	}
+
+	if len(filter.Poligono) != 0 && len(filter.Poligono) < 3 {
+		return nil, utils.NewResponseError(utils.InvalidPolygon, http.StatusBadRequest)
+	}
+
+	points, err := service.pointRepository.FindPointsInArea(ctx, filter)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	pointIDs := []string{}
+	for _, point := range points {
+		pointIDs = append(pointIDs, point.ID)
+	}
+
+	contracts, responseError := service.contractService.FindCurrentContractsByPontoIDs(ctx, pointIDs)
+	if responseError != (utils.ResponseError{}) {
+		return nil, responseError
+	}
+
+	pointsResponse := []dtos.PointGeoResponse{}
+
+	for _, point := range points {
+		coordinate := dtos.Coordinate{Latitude: *point.Endereco.Latitude, Longitude: *point.Endereco.Longitude}
+		if !filter.Matches(coordinate) {
+			continue
+		}
+
+		pointResponse := dtos.PointGeoResponse{PointResponse: dtos.CreatePointResponse(point)}
+
+		if filter.Perto != nil {
+			distance := math.Round(dtos.Distance(*filter.Perto, coordinate))
+			pointResponse.Distancia = &distance
+		}
+
+		pointResponse.ContratoEstado = string(contracts[point.ID].Estado)
+		pointsResponse = append(pointsResponse, pointResponse)
+	}
+
+	if filter.Perto != nil {
+		sort.SliceStable(pointsResponse, func(i, j int) bool {
+			return *pointsResponse[i].Distancia < *pointsResponse[j].Distancia
+		})
+	}
+
+	return pointsResponse, utils.ResponseError{}
+}
+
+func (service *pointService) TransferPoint(ctx context.Context, pointDTO dtos.PointTransferDTO) (entities.Ponto, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "PointService.TransferPoint")
+	defer span.End()
+
+	point, responseError := service.FindPointByID(ctx, pointDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Ponto{}, responseError
+	}
+
+	if point.ClienteID == pointDTO.ClienteID {
+		return entities.Ponto{}, utils.NewResponseError(utils.PointAlreadyOwned, http.StatusBadRequest)
+	}
+
+	client, err := service.clientRepository.FindClientByID(ctx, pointDTO.ClienteID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Ponto{}, utils.NewResponseError(utils.ClientNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
+
+	pointAlreadyExists, err := service.pointRepository.FindPointByClientIDAndAddressID(ctx, pointDTO.ClienteID, point.EnderecoID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
+
+	if err == nil && !pointAlreadyExists.DataRemocao.Valid {
+		return entities.Ponto{}, utils.NewResponseError(utils.PointAlreadyExists, http.StatusConflict)
+	}
+
+	responseError = service.checkPointLimit(ctx, client)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Ponto{}, responseError
+	}
+
+	previousClientID := point.ClienteID
+	point.ClienteID = pointDTO.ClienteID
+
+	// O ponto sem contrato é transferido sem registrar o evento no historico.
+	var contractEvent *entities.ContratoEvento
+
+	contract, responseError := service.contractService.FindContractByPontoID(ctx, point.ID)
+	if responseError != (utils.ResponseError{}) && responseError.StatusCode != http.StatusNotFound {
+		return entities.Ponto{}, responseError
+	}
+
+	contactID := strings.TrimSpace(pointDTO.ContatoResponsavelID)
+
+	if responseError == (utils.ResponseError{}) {
+		// O contato responsavel do cliente anterior não acompanha o contrato, que passa a seguir a politica do
+		// tipo do novo cliente.
+		responseError = service.contractService.ValidateResponsibleContact(ctx, client.ID, contactID)
+		if responseError != (utils.ResponseError{}) {
+			return entities.Ponto{}, responseError
+		}
+
+		err = service.clientPolicies.CheckResponsibleContact(client.Tipo, contactID)
+		if err != nil {
+			return entities.Ponto{}, utils.NewResponseError(err.Error(), http.StatusUnprocessableEntity)
+		}
+
+		contractEvent = &entities.ContratoEvento{
+			Base: entities.Base{
+				DataCriacao:     time.Now(),
+				DataAtualizacao: time.Now(),
+			},
+			Tipo:               entities.TRANSFERENCIA,
+			ContratoID:         contract.ID,
+			EstadoAnterior:     contract.Estado,
+			EstadoPosterior:    contract.Estado,
+			ClienteAnteriorID:  previousClientID,
+			ClientePosteriorID: point.ClienteID,
+		}
+	}
+
+	point, err = service.pointRepository.TransferPoint(ctx, point, contractEvent, contactID)
+	if err != nil {
+		return entities.Ponto{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "point transferred", slog.String("ponto_id", point.ID),
+		slog.String("cliente_anterior_id", previousClientID), slog.String("cliente_id", point.ClienteID))
+
+	return point, utils.ResponseError{}
+}
+
+// checkPointLimit verifica se a politica do tipo do cliente permite que ele receba mais um ponto.
+func (service *pointService) checkPointLimit(ctx context.Context, client entities.Cliente) utils.ResponseError {
+	points, err := service.pointRepository.FindPointsByClientID(ctx, client.ID)
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	err = service.clientPolicies.CheckPointLimit(client.Tipo, len(points))
+	if err != nil {
+		return utils.NewResponseError(err.Error(), http.StatusUnprocessableEntity)
+	}
+
+	return utils.ResponseError{}
 }
 
 // NewPointService cria uma nova instancia de PointService.
-func NewPointService(pointRepository repositories.PointRepository, clientRepository repositories.ClientRepository, addressReporitory repositories.AddressRepository, contractService services.ContractService) PointService {
+func NewPointService(pointRepository repositories.PointRepository, clientRepository repositories.ClientRepository, addressReporitory repositories.AddressRepository, contractService services.ContractService, equipmentService equipmentService.EquipmentService, clientPolicies policies.ClientPolicies, logger *slog.Logger) PointService {
 	return &pointService{
 		pointRepository:   pointRepository,
 		contractService:   contractService,
+		equipmentService:  equipmentService,
+		clientPolicies:    clientPolicies,
 		clientRepository:  clientRepository,
 		addressReporitory: addressReporitory,
+		logger:            logger,
 	}
 }
@@ -1,43 +1,78 @@
 package services_test
 
 import (
+	"context"
+	"fmt"
 	"net/http"
 	"testing"
 	"time"
 
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
 	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
 	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
 	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
 	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
 	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
 	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
 	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
 	"github.com/stretchr/testify/require"
 )
 
 var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
 	// Fake Databases
-	dbClient        = repositoriesFake.DBClient
-	dbAddress       = repositoriesFake.DBAddress
-	dbPoint         = repositoriesFake.DBPoint
-	dbContract      = repositoriesFake.DBContract
-	dbContractEvent = repositoriesFake.DBContractEvent
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
 
 	// Fake Repositories
-	clientRepositoryFake        = repositoriesFake.NewClientRepositoryFake(dbClient)
-	addressRepositoryFake       = repositoriesFake.NewAddressRepositoryFake(dbAddress)
-	pointRepositoryFake         = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress)
-	contractRepositoryFake      = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint)
-	contractEventRepositoryFake = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.ClientPolicies{
+		entities.FISICO:   {MaxPontos: 3},
+		entities.JURIDICO: {ExigeContatoResponsavel: true},
+	}
 
 	// Services Tests
-	contractEventServiceTest = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake)
-	contractServiceTest      = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, contractEventServiceTest)
-	pointServiceTest         = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest)
-	clientServiceTest        = clientService.NewClientService(clientRepositoryFake, pointServiceTest)
-	addressServiceTest       = addressService.NewAddressService(addressRepositoryFake, pointServiceTest)
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
 )
 
 // TestCreatePoint testa se é possivel criar um novo ponto.
@@ -46,20 +81,23 @@ func TestCreatePoint(t *testing.T) {
 		Nome: "Test 27.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 30.0",
 		Bairro:     "BairroTest 30.0",
 		Numero:     30,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, responseError := pointServiceTest.CreatePoint(pointDTO)
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	require.Empty(t, responseError)
 
@@ -74,21 +112,24 @@ func TestCreatePointWithPointExistent(t *testing.T) {
 		Nome: "Test 28.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 31.0",
 		Bairro:     "BairroTest 31.0",
 		Numero:     31,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
-	point, responseError := pointServiceTest.CreatePoint(pointDTO)
+	pointServiceTest.CreatePoint(ctx, pointDTO)
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.PointAlreadyExists, responseError.Message)
@@ -100,17 +141,20 @@ func TestCreatePointWithPointExistent(t *testing.T) {
 // TestCreatePointwithInvalidClientID testa se não é possivel criar um novo ponto a partir de um ID do cliente invalido.
 func TestCreatePointwithInvalidClientID(t *testing.T) {
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 32.0",
 		Bairro:     "BairroTest 32.0",
 		Numero:     32,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  "",
 		EnderecoID: address.ID,
 	}
-	point, responseError := pointServiceTest.CreatePoint(pointDTO)
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ClientNotFound, responseError.Message)
@@ -125,21 +169,24 @@ func TestCreatePointwithClientDeleted(t *testing.T) {
 		Nome: "Test 29.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
-	clientServiceTest.DeleteClientByID(client.ID)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+	clientServiceTest.DeleteClientByID(ctx, client.ID)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 33.0",
 		Bairro:     "BairroTest 33.0",
 		Numero:     33,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, responseError := pointServiceTest.CreatePoint(pointDTO)
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.ClientNotFound, responseError.Message)
@@ -154,13 +201,13 @@ func TestCreatePointwithInvalidAddressID(t *testing.T) {
 		Nome: "Test 30.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: "",
 	}
-	point, responseError := pointServiceTest.CreatePoint(pointDTO)
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.AddressNotFound, responseError.Message)
@@ -175,21 +222,24 @@ func TestCreatePointwithAddressDeleted(t *testing.T) {
 		Nome: "Test 31.0",
 		Tipo: entities.JURIDICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 34.0",
 		Bairro:     "BairroTest 34.0",
 		Numero:     34,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
-	addressServiceTest.DeleteAddressByID(address.ID)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+	addressServiceTest.DeleteAddressByID(ctx, address.ID)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, responseError := pointServiceTest.CreatePoint(pointDTO)
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.AddressNotFound, responseError.Message)
@@ -204,22 +254,25 @@ func TestCreatePointwithDeletedAtValid(t *testing.T) {
 		Nome: "Test 32.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 35.0",
 		Bairro:     "BairroTest 35.0",
 		Numero:     35,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	pointServiceTest.DeletePointByID(point.ID)
-	point, responseError := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointServiceTest.DeletePointByID(ctx, point.ID)
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	require.Empty(t, responseError)
 
@@ -234,23 +287,27 @@ func TestFindPointByID(t *testing.T) {
 		Nome: "Test 33.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 36.0",
 		Bairro:     "BairroTest 36.0",
 		Numero:     36,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	pointFound := pointServiceTest.FindPointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointFound, responseError := pointServiceTest.FindPointByID(ctx, point.ID)
 
 	require.NotEmpty(t, pointFound)
+	require.Empty(t, responseError)
 	require.Equal(t, point, pointFound)
 }
 
@@ -260,23 +317,27 @@ func TestFindPointByIDWithInvalidID(t *testing.T) {
 		Nome: "Test 34.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 37.0",
 		Bairro:     "BairroTest 37.0",
 		Numero:     37,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
-	pointFound := pointServiceTest.FindPointByID("")
+	pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointFound, responseError := pointServiceTest.FindPointByID(ctx, "")
 
 	require.Empty(t, pointFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindPointByIDWithDeletedAtValid testa se não é possivel buscar um ponto removido a partir do ID.
@@ -285,24 +346,28 @@ func TestFindPointByIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 35.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 38.0",
 		Bairro:     "BairroTest 38.0",
 		Numero:     38,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	pointServiceTest.DeletePointByID(point.ID)
-	pointFound := pointServiceTest.FindPointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointServiceTest.DeletePointByID(ctx, point.ID)
+	pointFound, responseError := pointServiceTest.FindPointByID(ctx, point.ID)
 
 	require.Empty(t, pointFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindPointByClientIDAndAddressID testa se é possivel buscar um ponto a partir do ID do cliente e do endereço.
@@ -311,24 +376,28 @@ func TestFindPointByClientIDAndAddressID(t *testing.T) {
 		Nome: "Test 36.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 39.0",
 		Bairro:     "BairroTest 39.0",
 		Numero:     39,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
 
-	pointFound := pointServiceTest.FindPointByClientIDAndAddressID(client.ID, address.ID)
+	pointFound, responseError := pointServiceTest.FindPointByClientIDAndAddressID(ctx, client.ID, address.ID)
 
 	require.NotEmpty(t, pointFound)
+	require.Empty(t, responseError)
 	require.Equal(t, point, pointFound)
 }
 
@@ -338,24 +407,28 @@ func TestFindPointByClientIDAndAddressIDWithInvalidClientID(t *testing.T) {
 		Nome: "Test 37.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 40.0",
 		Bairro:     "BairroTest 40.0",
 		Numero:     40,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
+	pointServiceTest.CreatePoint(ctx, pointDTO)
 
-	pointFound := pointServiceTest.FindPointByClientIDAndAddressID("", address.ID)
+	pointFound, responseError := pointServiceTest.FindPointByClientIDAndAddressID(ctx, "", address.ID)
 
 	require.Empty(t, pointFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindPointByClientIDAndAddressIDWithInvalidAddressID testa se não é possivel buscar um ponto a partir do ID valido do cliente e do ID invalido do endereço.
@@ -364,24 +437,28 @@ func TestFindPointByClientIDAndAddressIDWithInvalidAddressID(t *testing.T) {
 		Nome: "Test 38.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 41.0",
 		Bairro:     "BairroTest 41.0",
 		Numero:     41,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
+	pointServiceTest.CreatePoint(ctx, pointDTO)
 
-	pointFound := pointServiceTest.FindPointByClientIDAndAddressID(client.ID, "")
+	pointFound, responseError := pointServiceTest.FindPointByClientIDAndAddressID(ctx, client.ID, "")
 
 	require.Empty(t, pointFound)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
 }
 
 // TestFindPointByClientIDAndAddressIDWithDeletedAtValid testa se é possivel buscar um ponto removido a partir do ID do cliente e do endereço.
@@ -390,26 +467,30 @@ func TestFindPointByClientIDAndAddressIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 39.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 42.0",
 		Bairro:     "BairroTest 42.0",
 		Numero:     42,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	pointServiceTest.DeletePointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointServiceTest.DeletePointByID(ctx, point.ID)
 
-	pointFound := pointServiceTest.FindPointByClientIDAndAddressID(client.ID, address.ID)
+	pointFound, responseError := pointServiceTest.FindPointByClientIDAndAddressID(ctx, client.ID, address.ID)
 	point.DataRemocao.Scan(pointFound.DataRemocao.Time)
 
 	require.NotEmpty(t, pointFound)
+	require.Empty(t, responseError)
 	require.Equal(t, point, pointFound)
 }
 
@@ -419,22 +500,25 @@ func TestDeletePointByID(t *testing.T) {
 		Nome: "Test 40.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 43.0",
 		Bairro:     "BairroTest 43.0",
 		Numero:     43,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	responseError := pointServiceTest.DeletePointByID(point.ID)
-	pointFound := pointServiceTest.FindPointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	responseError := pointServiceTest.DeletePointByID(ctx, point.ID)
+	pointFound, _ := pointServiceTest.FindPointByID(ctx, point.ID)
 
 	require.Empty(t, responseError)
 	require.Empty(t, pointFound)
@@ -446,21 +530,24 @@ func TestDeletePointByIDWithInvalidID(t *testing.T) {
 		Nome: "Test 41.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 44.0",
 		Bairro:     "BairroTest 44.0",
 		Numero:     44,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
-	responseError := pointServiceTest.DeletePointByID("")
+	pointServiceTest.CreatePoint(ctx, pointDTO)
+	responseError := pointServiceTest.DeletePointByID(ctx, "")
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.PointNotFound, responseError.Message)
@@ -473,22 +560,25 @@ func TestDeletePointByIDWithDeletedAtValid(t *testing.T) {
 		Nome: "Test 42.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 45.0",
 		Bairro:     "BairroTest 45.0",
 		Numero:     45,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	pointServiceTest.DeletePointByID(point.ID)
-	responseError := pointServiceTest.DeletePointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointServiceTest.DeletePointByID(ctx, point.ID)
+	responseError := pointServiceTest.DeletePointByID(ctx, point.ID)
 
 	require.NotEmpty(t, responseError)
 	require.Equal(t, utils.PointNotFound, responseError.Message)
@@ -501,22 +591,25 @@ func TestDeletePointsByClientID(t *testing.T) {
 		Nome: "Test 43.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 46.0",
 		Bairro:     "BairroTest 46.0",
 		Numero:     46,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	responseError := pointServiceTest.DeletePointsByClientID(client.ID)
-	pointFound := pointServiceTest.FindPointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	responseError := pointServiceTest.DeletePointsByClientID(ctx, client.ID)
+	pointFound, _ := pointServiceTest.FindPointByID(ctx, point.ID)
 
 	require.Empty(t, responseError)
 	require.Empty(t, pointFound)
@@ -528,24 +621,28 @@ func TestDeletePointsByClientIDWithInvalidClientID(t *testing.T) {
 		Nome: "Test 44.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 47.0",
 		Bairro:     "BairroTest 47.0",
 		Numero:     47,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	pointServiceTest.DeletePointsByClientID("")
-	pointFound := pointServiceTest.FindPointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointServiceTest.DeletePointsByClientID(ctx, "")
+	pointFound, responseError := pointServiceTest.FindPointByID(ctx, point.ID)
 
 	require.NotEmpty(t, pointFound)
+	require.Empty(t, responseError)
 	require.False(t, pointFound.DataRemocao.Valid)
 }
 
@@ -555,22 +652,25 @@ func TestDeletePointsByAddressID(t *testing.T) {
 		Nome: "Test 45.0",
 		Tipo: entities.FISICO,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 48.0",
 		Bairro:     "BairroTest 48.0",
 		Numero:     48,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	responseError := pointServiceTest.DeletePointsByAddressID(address.ID)
-	pointFound := pointServiceTest.FindPointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	responseError := pointServiceTest.DeletePointsByAddressID(ctx, address.ID)
+	pointFound, _ := pointServiceTest.FindPointByID(ctx, point.ID)
 
 	require.Empty(t, responseError)
 	require.Empty(t, pointFound)
@@ -582,24 +682,28 @@ func TestDeletePointsByAddressIDWithInvalidAddressID(t *testing.T) {
 		Nome: "Test 46.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 49.0",
 		Bairro:     "BairroTest 49.0",
 		Numero:     49,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	point, _ := pointServiceTest.CreatePoint(pointDTO)
-	pointServiceTest.DeletePointsByAddressID("")
-	pointFound := pointServiceTest.FindPointByID(point.ID)
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+	pointServiceTest.DeletePointsByAddressID(ctx, "")
+	pointFound, responseError := pointServiceTest.FindPointByID(ctx, point.ID)
 
 	require.NotEmpty(t, pointFound)
+	require.Empty(t, responseError)
 	require.False(t, pointFound.DataRemocao.Valid)
 }
 
@@ -609,23 +713,27 @@ func TestFindPoints(t *testing.T) {
 		Nome: "Test 47.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 50.0",
 		Bairro:     "BairroTest 50.0",
 		Numero:     50,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
-	points := pointServiceTest.FindPoints("", "")
+	pointServiceTest.CreatePoint(ctx, pointDTO)
+	points, responseError := pointServiceTest.FindPoints(ctx, "", "")
 
 	require.NotEmpty(t, points)
+	require.Empty(t, responseError)
 	require.Greater(t, len(points), 0)
 }
 
@@ -635,23 +743,27 @@ func TestFindPointsByClienteIDAndAddressID(t *testing.T) {
 		Nome: "Test 48.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 51.0",
 		Bairro:     "BairroTest 51.0",
 		Numero:     51,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
-	points := pointServiceTest.FindPoints(client.ID, address.ID)
+	pointServiceTest.CreatePoint(ctx, pointDTO)
+	points, responseError := pointServiceTest.FindPoints(ctx, client.ID, address.ID)
 
 	require.NotEmpty(t, points)
+	require.Empty(t, responseError)
 	require.Greater(t, len(points), 0)
 }
 
@@ -661,23 +773,27 @@ func TestFindPointsByClienteID(t *testing.T) {
 		Nome: "Test 49.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 52.0",
 		Bairro:     "BairroTest 52.0",
 		Numero:     52,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
-	points := pointServiceTest.FindPoints(client.ID, "")
+	pointServiceTest.CreatePoint(ctx, pointDTO)
+	points, responseError := pointServiceTest.FindPoints(ctx, client.ID, "")
 
 	require.NotEmpty(t, points)
+	require.Empty(t, responseError)
 	require.Greater(t, len(points), 0)
 }
 
@@ -687,23 +803,27 @@ func TestFindPointsByAddressID(t *testing.T) {
 		Nome: "Test 50.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 53.0",
 		Bairro:     "BairroTest 53.0",
 		Numero:     53,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
-	points := pointServiceTest.FindPoints("", address.ID)
+	pointServiceTest.CreatePoint(ctx, pointDTO)
+	points, responseError := pointServiceTest.FindPoints(ctx, "", address.ID)
 
 	require.NotEmpty(t, points)
+	require.Empty(t, responseError)
 	require.Greater(t, len(points), 0)
 }
 
@@ -713,27 +833,408 @@ func TestFindPointsWithDeteletAtValid(t *testing.T) {
 		Nome: "Test 51.0",
 		Tipo: entities.ESPECIAL,
 	}
-	client, _ := clientServiceTest.CreateClient(clientDTO)
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
 
 	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
 		Logradouro: "LogradouroTest 54.0",
 		Bairro:     "BairroTest 54.0",
 		Numero:     54,
 	}
-	address, _ := addressServiceTest.CreateAddress(addressDTO)
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
 
 	pointDTO := dtos.PointCreateDTO{
 		ClienteID:  client.ID,
 		EnderecoID: address.ID,
 	}
-	pointServiceTest.CreatePoint(pointDTO)
+	pointServiceTest.CreatePoint(ctx, pointDTO)
 
 	for i := range *dbPoint {
 		(*dbPoint)[i].DataRemocao.Scan(time.Now())
 	}
 
-	points := pointServiceTest.FindPoints("", "")
+	points, responseError := pointServiceTest.FindPoints(ctx, "", "")
 
 	require.Empty(t, points)
+	require.Empty(t, responseError)
 	require.Equal(t, len(points), 0)
 }
+
+// TestFindPointsWithDeadlineExceeded testa se a listagem de pontos retorna 504 quando o prazo da requisição expirou.
+func TestFindPointsWithDeadlineExceeded(t *testing.T) {
+	expiredCtx, cancel := context.WithDeadline(ctx, time.Now())
+	defer cancel()
+
+	points, responseError := pointServiceTest.FindPoints(expiredCtx, "", "")
+
+	require.Empty(t, points)
+	require.Equal(t, http.StatusGatewayTimeout, responseError.StatusCode)
+	require.Equal(t, utils.RequestTimeout, responseError.Message)
+}
+
+// createGeoPoint cria um ponto em um endereço com as coordenadas informadas para os testes da pesquisa geografica.
+func createGeoPoint(t *testing.T, name string, number int, latitude float64, longitude float64) entities.Ponto {
+	client, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{
+		Nome: name,
+		Tipo: entities.FISICO,
+	})
+
+	address, responseError := addressServiceTest.CreateAddress(ctx, dtos.AddressCreateDTO{
+		Cep:        "57000001",
+		Cidade:     "Maceió",
+		Uf:         "AL",
+		Logradouro: "LogradouroTest " + name,
+		Bairro:     "BairroTest " + name,
+		Numero:     number,
+		Latitude:   &latitude,
+		Longitude:  &longitude,
+	})
+	require.Empty(t, responseError)
+
+	point, responseError := pointServiceTest.CreatePoint(ctx, dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	})
+	require.Empty(t, responseError)
+
+	return point
+}
+
+// TestFindPointsInRadius testa se é possivel listar os pontos dentro do raio, ordenados pela distancia.
+func TestFindPointsInRadius(t *testing.T) {
+	far := createGeoPoint(t, "Test 97.0", 97, -9.6620, -35.7350)
+	near := createGeoPoint(t, "Test 97.1", 97, -9.6660, -35.7350)
+	_ = createGeoPoint(t, "Test 97.2", 97, -9.7000, -35.7350)
+
+	_, responseError := contractServiceTest.CreateContract(ctx, dtos.ContractCreateDTO{
+		PontoID: near.ID,
+		Estado:  entities.VIGOR,
+	})
+	require.Empty(t, responseError)
+
+	filter := dtos.PointGeoFilter{
+		Perto: &dtos.Coordinate{Latitude: -9.6665, Longitude: -35.7350},
+		Raio:  1000,
+	}
+	points, responseError := pointServiceTest.FindPointsInArea(ctx, filter)
+
+	require.Empty(t, responseError)
+	require.Len(t, points, 2)
+	require.Equal(t, near.ID, points[0].ID)
+	require.Equal(t, string(entities.VIGOR), points[0].ContratoEstado)
+	require.InDelta(t, 56, *points[0].Distancia, 1)
+	require.Equal(t, far.ID, points[1].ID)
+	require.Empty(t, points[1].ContratoEstado)
+	require.InDelta(t, 500, *points[1].Distancia, 1)
+}
+
+// TestFindPointsInPolygon testa se é possivel listar os pontos dentro do poligono.
+func TestFindPointsInPolygon(t *testing.T) {
+	inside := createGeoPoint(t, "Test 98.0", 98, -9.5000, -35.5000)
+	_ = createGeoPoint(t, "Test 98.1", 98, -9.5000, -35.4000)
+
+	polygon, ok := dtos.ParsePolygon("-9.45,-35.55;-9.45,-35.45;-9.55,-35.45;-9.55,-35.55;-9.45,-35.55")
+	require.True(t, ok)
+
+	points, responseError := pointServiceTest.FindPointsInArea(ctx, dtos.PointGeoFilter{Poligono: polygon})
+
+	require.Empty(t, responseError)
+	require.Len(t, points, 1)
+	require.Equal(t, inside.ID, points[0].ID)
+	require.Nil(t, points[0].Distancia)
+}
+
+// TestFindPointsInAreaWithInvalidFilter testa se não é possivel pesquisar os pontos sem localização ou com um raio invalido.
+func TestFindPointsInAreaWithInvalidFilter(t *testing.T) {
+	points, responseError := pointServiceTest.FindPointsInArea(ctx, dtos.PointGeoFilter{})
+
+	require.Equal(t, utils.InvalidLocation, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, points)
+
+	filter := dtos.PointGeoFilter{
+		Perto: &dtos.Coordinate{Latitude: -9.6665, Longitude: -35.7350},
+		Raio:  dtos.MaxSearchRadius + 1,
+	}
+	points, responseError = pointServiceTest.FindPointsInArea(ctx, filter)
+
+	require.Equal(t, utils.InvalidRadius, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, points)
+}
+
+// TestTransferPoint testa se é possivel transferir o ponto para outro cliente, registrando o evento no historico do contrato.
+func TestTransferPoint(t *testing.T) {
+	point := createGeoPoint(t, "Test 104.0", 104, -9.6000, -35.7000)
+	newClient, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 104.1", Tipo: entities.FISICO})
+
+	contract, _ := contractServiceTest.CreateContract(ctx, dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+	})
+
+	pointDTO := dtos.PointTransferDTO{
+		Base: dtos.Base{
+			ID: point.ID,
+		},
+		ClienteID: newClient.ID,
+	}
+	pointTransferred, responseError := pointServiceTest.TransferPoint(ctx, pointDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, point.ID, pointTransferred.ID)
+	require.Equal(t, newClient.ID, pointTransferred.ClienteID)
+	require.Equal(t, point.EnderecoID, pointTransferred.EnderecoID)
+
+	contractEvents, _ := contractEventServiceTest.FindContractEventsByContractID(ctx, contract.ID)
+	event := contractEvents[len(contractEvents)-1]
+
+	require.Equal(t, entities.TRANSFERENCIA, event.Tipo)
+	require.Equal(t, point.ClienteID, event.ClienteAnteriorID)
+	require.Equal(t, newClient.ID, event.ClientePosteriorID)
+	require.Equal(t, entities.VIGOR, event.EstadoAnterior)
+	require.Equal(t, entities.VIGOR, event.EstadoPosterior)
+
+	contractFound, _ := contractServiceTest.FindContractByID(ctx, contract.ID)
+	require.Equal(t, point.ID, contractFound.PontoID)
+}
+
+// TestTransferPointWithResponsibleContact testa se o contrato do ponto transferido troca o contato responsavel do
+// cliente anterior pelo contato do novo cliente, exigido pela politica do tipo do novo cliente.
+func TestTransferPointWithResponsibleContact(t *testing.T) {
+	point := createGeoPoint(t, "Test 107.0", 107, -9.6300, -35.7000)
+
+	previousContact, responseError := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: point.ClienteID, Tipo: entities.EMAIL, Valor: "test107@example.com"})
+	require.Empty(t, responseError)
+
+	contract, responseError := contractServiceTest.CreateContract(ctx, dtos.ContractCreateDTO{
+		PontoID:              point.ID,
+		Estado:               entities.VIGOR,
+		ContatoResponsavelID: previousContact.ID,
+	})
+	require.Empty(t, responseError)
+
+	newClient, responseError := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 107.1", Tipo: entities.JURIDICO})
+	require.Empty(t, responseError)
+
+	pointDTO := dtos.PointTransferDTO{
+		Base: dtos.Base{
+			ID: point.ID,
+		},
+		ClienteID: newClient.ID,
+	}
+	_, responseError = pointServiceTest.TransferPoint(ctx, pointDTO)
+
+	require.Contains(t, responseError.Message, "contato_responsavel_id")
+	require.Equal(t, http.StatusUnprocessableEntity, responseError.StatusCode)
+
+	pointDTO.ContatoResponsavelID = previousContact.ID
+	_, responseError = pointServiceTest.TransferPoint(ctx, pointDTO)
+
+	require.Equal(t, "contato_responsavel_id: "+utils.ContactNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+
+	newContact, responseError := contactServiceTest.CreateContact(ctx, dtos.ContactCreateDTO{
+		ClienteID: newClient.ID, Tipo: entities.EMAIL, Valor: "test107.1@example.com"})
+	require.Empty(t, responseError)
+
+	pointDTO.ContatoResponsavelID = newContact.ID
+	pointTransferred, responseError := pointServiceTest.TransferPoint(ctx, pointDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, newClient.ID, pointTransferred.ClienteID)
+
+	contractFound, responseError := contractServiceTest.FindContractByID(ctx, contract.ID)
+	require.Empty(t, responseError)
+	require.Equal(t, newContact.ID, contractFound.ContatoResponsavelID)
+}
+
+// TestTransferPointWithExistingPoint testa se não é possivel transferir o ponto para um cliente que já possui um ponto no endereço.
+func TestTransferPointWithExistingPoint(t *testing.T) {
+	point := createGeoPoint(t, "Test 105.0", 105, -9.6100, -35.7000)
+	newClient, _ := clientServiceTest.CreateClient(ctx, dtos.ClientCreateDTO{Nome: "Test 105.1", Tipo: entities.FISICO})
+
+	_, _ = pointServiceTest.CreatePoint(ctx, dtos.PointCreateDTO{
+		ClienteID:  newClient.ID,
+		EnderecoID: point.EnderecoID,
+	})
+
+	pointDTO := dtos.PointTransferDTO{
+		Base: dtos.Base{
+			ID: point.ID,
+		},
+		ClienteID: newClient.ID,
+	}
+	pointTransferred, responseError := pointServiceTest.TransferPoint(ctx, pointDTO)
+
+	require.Equal(t, utils.PointAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, pointTransferred)
+}
+
+// TestTransferPointWithInvalidClient testa se não é possivel transferir o ponto para o mesmo cliente ou para um cliente inexistente.
+func TestTransferPointWithInvalidClient(t *testing.T) {
+	point := createGeoPoint(t, "Test 106.0", 106, -9.6200, -35.7000)
+
+	pointDTO := dtos.PointTransferDTO{
+		Base: dtos.Base{
+			ID: point.ID,
+		},
+		ClienteID: point.ClienteID,
+	}
+	pointTransferred, responseError := pointServiceTest.TransferPoint(ctx, pointDTO)
+
+	require.Equal(t, utils.PointAlreadyOwned, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, pointTransferred)
+
+	pointDTO.ClienteID = "Test 106.1"
+	pointTransferred, responseError = pointServiceTest.TransferPoint(ctx, pointDTO)
+
+	require.Equal(t, utils.ClientNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, pointTransferred)
+}
+
+// TestCreatePointOverFisicoLimit testa se a politica limita a quantidade de pontos dos clientes fisicos.
+func TestCreatePointOverFisicoLimit(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 113.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	maxPoints := clientPolicies[entities.FISICO].MaxPontos
+	addresses := []entities.Endereco{}
+
+	for i := 0; i <= maxPoints; i++ {
+		addressDTO := dtos.AddressCreateDTO{
+			Cep:        "01001000",
+			Cidade:     "São Paulo",
+			Uf:         "SP",
+			Logradouro: "LogradouroTest 98.0",
+			Bairro:     "BairroTest 98.0",
+			Numero:     980 + i,
+		}
+		address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+		addresses = append(addresses, address)
+	}
+
+	for _, address := range addresses[:maxPoints] {
+		pointDTO := dtos.PointCreateDTO{
+			ClienteID:  client.ID,
+			EnderecoID: address.ID,
+		}
+		_, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+		require.Empty(t, responseError)
+	}
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: addresses[maxPoints].ID,
+	}
+	point, responseError := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	require.Equal(t, http.StatusUnprocessableEntity, responseError.StatusCode)
+	require.Equal(t, fmt.Sprintf("Policy violation: fisico clients may have at most %d points", maxPoints), responseError.Message)
+	require.Empty(t, point)
+
+	otherClientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 113.1",
+		Tipo: entities.ESPECIAL,
+	}
+	otherClient, _ := clientServiceTest.CreateClient(ctx, otherClientDTO)
+
+	pointDTO.ClienteID = otherClient.ID
+	otherPoint, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	pointTransferDTO := dtos.PointTransferDTO{
+		Base: dtos.Base{
+			ID: otherPoint.ID,
+		},
+		ClienteID: client.ID,
+	}
+	pointTransferred, responseError := pointServiceTest.TransferPoint(ctx, pointTransferDTO)
+
+	require.Equal(t, http.StatusUnprocessableEntity, responseError.StatusCode)
+	require.Empty(t, pointTransferred)
+}
+
+// TestFindPointContracts testa se o ponto mantém a sequencia dos seus contratos, com o contrato atual na listagem.
+func TestFindPointContracts(t *testing.T) {
+	clientDTO := dtos.ClientCreateDTO{
+		Nome: "Test 129.0",
+		Tipo: entities.FISICO,
+	}
+	client, _ := clientServiceTest.CreateClient(ctx, clientDTO)
+
+	addressDTO := dtos.AddressCreateDTO{
+		Cep:        "01001000",
+		Cidade:     "São Paulo",
+		Uf:         "SP",
+		Logradouro: "LogradouroTest 114.0",
+		Bairro:     "BairroTest 114.0",
+		Numero:     114,
+	}
+	address, _ := addressServiceTest.CreateAddress(ctx, addressDTO)
+
+	pointDTO := dtos.PointCreateDTO{
+		ClienteID:  client.ID,
+		EnderecoID: address.ID,
+	}
+	point, _ := pointServiceTest.CreatePoint(ctx, pointDTO)
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+	}
+	firstContract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Equal(t, utils.ContractAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, contract)
+
+	for _, state := range []entities.ContractState{entities.DESATIVADO, entities.CANCELADO} {
+		contractUpdateDTO := dtos.ContractUpdateDTO{
+			Base: dtos.Base{
+				ID: firstContract.ID,
+			},
+			Estado: state,
+		}
+		_, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+		require.Empty(t, responseError)
+	}
+
+	secondContract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+	require.NotEqual(t, firstContract.ID, secondContract.ID)
+
+	contracts, responseError := pointServiceTest.FindPointContracts(ctx, point.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, contracts, 2)
+	require.Equal(t, firstContract.ID, contracts[0].ID)
+	require.Equal(t, entities.CANCELADO, contracts[0].Estado)
+	require.Equal(t, secondContract.ID, contracts[1].ID)
+
+	points, responseError := pointServiceTest.FindPoints(ctx, client.ID, address.ID)
+
+	require.Empty(t, responseError)
+	require.Len(t, points, 1)
+	require.Equal(t, secondContract.ID, dtos.CreatePointResponse(points[0]).ContratoAtual.ID)
+
+	contracts, responseError = pointServiceTest.FindPointContracts(ctx, "invalid-point-id")
+
+	require.Equal(t, utils.PointNotFound, responseError.Message)
+	require.Empty(t, contracts)
+}
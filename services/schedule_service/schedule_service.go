@@ -0,0 +1,292 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/schedule_service")
+
+// SuggestionLimit quantidade de horarios sugeridos quando o limite não é informado.
+const SuggestionLimit = 5
+
+// Expediente padrão dos tecnicos, em horas.
+const (
+	defaultWorkStart = 8
+	defaultWorkEnd   = 18
+)
+
+// ScheduleService representa a interface de ScheduleService.
+type ScheduleService interface {
+	CreateTechnician(ctx context.Context, technicianDTO dtos.TechnicianCreateDTO) (entities.Tecnico, utils.ResponseError)
+	FindTechnicians(ctx context.Context, neighborhood string) ([]entities.Tecnico, utils.ResponseError)
+	FindAgenda(ctx context.Context, technicianName string, date time.Time) ([]dtos.AgendaResponse, utils.ResponseError)
+	SuggestSlots(ctx context.Context, pointID string, from time.Time, limit int) ([]dtos.SlotSuggestionResponse, utils.ResponseError)
+}
+
+type scheduleService struct {
+	technicianRepository   repositories.TechnicianRepository
+	serviceOrderRepository repositories.ServiceOrderRepository
+	pointRepository        repositories.PointRepository
+	addressRepository      repositories.AddressRepository
+	schedulePolicy         policies.SchedulePolicy
+	logger                 *slog.Logger
+}
+
+func (service *scheduleService) CreateTechnician(ctx context.Context, technicianDTO dtos.TechnicianCreateDTO) (entities.Tecnico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ScheduleService.CreateTechnician")
+	defer span.End()
+
+	name := strings.TrimSpace(technicianDTO.Nome)
+
+	_, err := service.technicianRepository.FindTechnicianByName(ctx, name)
+	if err == nil {
+		return entities.Tecnico{}, utils.NewResponseError(utils.TechnicianAlreadyExists, http.StatusConflict)
+	}
+
+	if !errors.Is(err, repositories.ErrNotFound) {
+		return entities.Tecnico{}, utils.NewInternalResponseError(err)
+	}
+
+	technician := entities.Tecnico{
+		Nome:             name,
+		InicioExpediente: defaultWorkStart,
+		FimExpediente:    defaultWorkEnd,
+	}
+
+	if technicianDTO.InicioExpediente != nil {
+		technician.InicioExpediente = *technicianDTO.InicioExpediente
+	}
+
+	if technicianDTO.FimExpediente != nil {
+		technician.FimExpediente = *technicianDTO.FimExpediente
+	}
+
+	if technician.FimExpediente <= technician.InicioExpediente {
+		return entities.Tecnico{}, utils.NewResponseError(utils.InvalidWorkingHours, http.StatusBadRequest)
+	}
+
+	// Os bairros escritos de formas diferentes são cadastrados uma unica vez.
+	neighborhoodKeys := map[string]bool{}
+
+	for _, neighborhood := range technicianDTO.Bairros {
+		neighborhoodKey := dtos.NormalizeAddressText(neighborhood)
+		if neighborhoodKey == "" || neighborhoodKeys[neighborhoodKey] {
+			continue
+		}
+
+		neighborhoodKeys[neighborhoodKey] = true
+		technician.Bairros = append(technician.Bairros, entities.TecnicoBairro{
+			Bairro:            strings.TrimSpace(neighborhood),
+			BairroNormalizado: neighborhoodKey,
+		})
+	}
+
+	technician, err = service.technicianRepository.CreateTechnician(ctx, technician)
+	if err != nil {
+		return entities.Tecnico{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "technician created", slog.String("tecnico_id", technician.ID),
+		slog.String("nome", technician.Nome), slog.Int("bairros", len(technician.Bairros)))
+
+	return technician, utils.ResponseError{}
+}
+
+func (service *scheduleService) FindTechnicians(ctx context.Context, neighborhood string) ([]entities.Tecnico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ScheduleService.FindTechnicians")
+	defer span.End()
+
+	technicians, err := service.technicianRepository.FindTechnicians(ctx, dtos.NormalizeAddressText(neighborhood))
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return technicians, utils.ResponseError{}
+}
+
+// FindAgenda monta a agenda do dia do tecnico informado, ou de todos os tecnicos, com a carga de cada um.
+func (service *scheduleService) FindAgenda(ctx context.Context, technicianName string, date time.Time) ([]dtos.AgendaResponse, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ScheduleService.FindAgenda")
+	defer span.End()
+
+	technicians := []entities.Tecnico{}
+
+	if technicianName != "" {
+		technician, err := service.technicianRepository.FindTechnicianByName(ctx, technicianName)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return nil, utils.NewResponseError(utils.TechnicianNotFound, http.StatusNotFound)
+		}
+
+		if err != nil {
+			return nil, utils.NewInternalResponseError(err)
+		}
+
+		technicians = append(technicians, technician)
+	} else {
+		var err error
+
+		technicians, err = service.technicianRepository.FindTechnicians(ctx, "")
+		if err != nil {
+			return nil, utils.NewInternalResponseError(err)
+		}
+	}
+
+	agendas := []dtos.AgendaResponse{}
+
+	for _, technician := range technicians {
+		agenda, responseError := service.technicianAgenda(ctx, technician, date)
+		if responseError != (utils.ResponseError{}) {
+			return nil, responseError
+		}
+
+		agendas = append(agendas, agenda)
+	}
+
+	return agendas, utils.ResponseError{}
+}
+
+// SuggestSlots sugere os primeiros horarios livres, a partir da data informada, dos tecnicos que atendem o bairro
+// do endereço do ponto.
+func (service *scheduleService) SuggestSlots(ctx context.Context, pointID string, from time.Time, limit int) ([]dtos.SlotSuggestionResponse, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ScheduleService.SuggestSlots")
+	defer span.End()
+
+	if limit <= 0 {
+		limit = SuggestionLimit
+	}
+
+	point, err := service.pointRepository.FindPointByID(ctx, pointID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, utils.NewResponseError(utils.PointNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	address, err := service.addressRepository.FindAddressByID(ctx, point.EnderecoID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return nil, utils.NewResponseError(utils.AddressNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	technicians, err := service.technicianRepository.FindTechnicians(ctx, dtos.NormalizeAddressText(address.Bairro))
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	if len(technicians) == 0 {
+		return nil, utils.NewResponseError(utils.TechnicianNotFound, http.StatusNotFound)
+	}
+
+	now := time.Now()
+	suggestions := []dtos.SlotSuggestionResponse{}
+
+	for day := 0; day < service.schedulePolicy.DiasSugestao && len(suggestions) < limit; day++ {
+		daySuggestions := []dtos.SlotSuggestionResponse{}
+
+		for _, technician := range technicians {
+			agenda, responseError := service.technicianAgenda(ctx, technician, from.AddDate(0, 0, day))
+			if responseError != (utils.ResponseError{}) {
+				return nil, responseError
+			}
+
+			for _, slot := range agenda.Horarios {
+				if slot.Livre && slot.Inicio.After(now) {
+					daySuggestions = append(daySuggestions, dtos.SlotSuggestionResponse{
+						Tecnico: technician.Nome,
+						Inicio:  slot.Inicio,
+						Fim:     slot.Fim,
+					})
+				}
+			}
+		}
+
+		sort.SliceStable(daySuggestions, func(i, j int) bool {
+			return daySuggestions[i].Inicio.Before(daySuggestions[j].Inicio)
+		})
+
+		suggestions = append(suggestions, daySuggestions[:min(len(daySuggestions), limit-len(suggestions))]...)
+	}
+
+	if len(suggestions) == 0 {
+		return nil, utils.NewResponseError(utils.SlotNotFound, http.StatusNotFound)
+	}
+
+	return suggestions, utils.ResponseError{}
+}
+
+// technicianAgenda divide o expediente do dia do tecnico em horarios com a duração da politica, marcando os
+// horarios ocupados pelas ordens de serviço não canceladas.
+func (service *scheduleService) technicianAgenda(ctx context.Context, technician entities.Tecnico, date time.Time) (dtos.AgendaResponse, utils.ResponseError) {
+	day := time.Date(date.Year(), date.Month(), date.Day(), 0, 0, 0, 0, time.Local)
+	workStart := day.Add(time.Duration(technician.InicioExpediente) * time.Hour)
+	workEnd := day.Add(time.Duration(technician.FimExpediente) * time.Hour)
+
+	filter := dtos.ServiceOrderFilter{
+		Tecnico: technician.Nome,
+		De:      &workStart,
+		Ate:     &workEnd,
+	}
+
+	serviceOrders, err := service.serviceOrderRepository.FindServiceOrders(ctx, filter)
+	if err != nil {
+		return dtos.AgendaResponse{}, utils.NewInternalResponseError(err)
+	}
+
+	slots := []dtos.ScheduleSlot{}
+	slotDuration := service.schedulePolicy.DuracaoSlot
+
+	for slotStart := workStart; !slotStart.Add(slotDuration).After(workEnd); slotStart = slotStart.Add(slotDuration) {
+		slot := dtos.ScheduleSlot{
+			Inicio: slotStart,
+			Fim:    slotStart.Add(slotDuration),
+			Livre:  true,
+		}
+
+		for _, serviceOrder := range serviceOrders {
+			if serviceOrder.Estado == entities.ORDEM_CANCELADA {
+				continue
+			}
+
+			if serviceOrder.InicioJanela.Before(slot.Fim) && serviceOrder.FimJanela.After(slot.Inicio) {
+				slot.Livre = false
+				slot.OrdemServicoID = serviceOrder.ID
+				break
+			}
+		}
+
+		slots = append(slots, slot)
+	}
+
+	return dtos.CreateAgendaResponse(technician, day, slots), utils.ResponseError{}
+}
+
+// NewScheduleService cria uma nova instancia de ScheduleService.
+func NewScheduleService(technicianRepository repositories.TechnicianRepository, serviceOrderRepository repositories.ServiceOrderRepository, pointRepository repositories.PointRepository, addressRepository repositories.AddressRepository, schedulePolicy policies.SchedulePolicy, logger *slog.Logger) ScheduleService {
+	return &scheduleService{
+		technicianRepository:   technicianRepository,
+		serviceOrderRepository: serviceOrderRepository,
+		pointRepository:        pointRepository,
+		addressRepository:      addressRepository,
+		schedulePolicy:         schedulePolicy,
+		logger:                 logger,
+	}
+}
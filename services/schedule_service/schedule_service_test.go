@@ -0,0 +1,234 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/services/fixtures"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	scheduleService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/schedule_service"
+	serviceOrderService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/service_order_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+	dbTechnician        = repositoriesFake.DBTechnician
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+	technicianRepositoryFake        = repositoriesFake.NewTechnicianRepositoryFake(dbTechnician)
+
+	// Policies
+	clientPolicies = policies.Default()
+	schedulePolicy = policies.DefaultSchedule()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	serviceOrderServiceTest    = serviceOrderService.NewServiceOrderService(serviceOrderRepositoryFake, pointRepositoryFake, technicianRepositoryFake, contractServiceTest, logNop)
+	scheduleServiceTest        = scheduleService.NewScheduleService(technicianRepositoryFake, serviceOrderRepositoryFake, pointRepositoryFake, addressRepositoryFake, schedulePolicy, logNop)
+
+	// Fixtures
+	pointFactory = fixtures.NewPointFactory(clientServiceTest, addressServiceTest, pointServiceTest)
+)
+
+// scheduleDay retorna o dia, a partir de hoje, usado nos testes da agenda.
+func scheduleDay(days int) time.Time {
+	now := time.Now().AddDate(0, 0, days)
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// TestCreateTechnician testa se é possivel cadastrar um tecnico, com os bairros repetidos cadastrados uma unica vez.
+func TestCreateTechnician(t *testing.T) {
+	workStart := 9
+	technicianDTO := dtos.TechnicianCreateDTO{
+		Nome:             " Tecnico Agenda 1 ",
+		Bairros:          []string{"Jd. Paulista", "Jardim Paulista", "Moema"},
+		InicioExpediente: &workStart,
+	}
+	technician, responseError := scheduleServiceTest.CreateTechnician(ctx, technicianDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, "Tecnico Agenda 1", technician.Nome)
+	require.Equal(t, 9, technician.InicioExpediente)
+	require.Equal(t, 18, technician.FimExpediente)
+	require.Len(t, technician.Bairros, 2)
+
+	technicians, responseError := scheduleServiceTest.FindTechnicians(ctx, "jardim paulista")
+
+	require.Empty(t, responseError)
+	require.Len(t, technicians, 1)
+
+	technician, responseError = scheduleServiceTest.CreateTechnician(ctx, technicianDTO)
+
+	require.Equal(t, utils.TechnicianAlreadyExists, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, technician)
+
+	workEnd := 9
+	technicianDTO.Nome = "Tecnico Agenda 2"
+	technicianDTO.FimExpediente = &workEnd
+	technician, responseError = scheduleServiceTest.CreateTechnician(ctx, technicianDTO)
+
+	require.Equal(t, utils.InvalidWorkingHours, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, technician)
+}
+
+// TestFindAgenda testa se a agenda do dia marca os horarios ocupados pelas ordens de serviço e calcula a carga,
+// e se o agendamento na mesma janela do tecnico é recusado.
+func TestFindAgenda(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 136.0", Logradouro: "LogradouroTest 121.0", Numero: 121, Bairro: "Pinheiros"})
+
+	workStart, workEnd := 8, 12
+	technicianDTO := dtos.TechnicianCreateDTO{
+		Nome:             "Tecnico Agenda 3",
+		Bairros:          []string{"Pinheiros"},
+		InicioExpediente: &workStart,
+		FimExpediente:    &workEnd,
+	}
+	_, responseError := scheduleServiceTest.CreateTechnician(ctx, technicianDTO)
+
+	require.Empty(t, responseError)
+
+	day := scheduleDay(2)
+	start := day.Add(9 * time.Hour)
+	end := day.Add(10 * time.Hour)
+	serviceOrderDTO := dtos.ServiceOrderCreateDTO{
+		Tipo:         entities.REPARO,
+		PontoID:      point.ID,
+		InicioJanela: &start,
+		FimJanela:    &end,
+		Tecnico:      "Tecnico Agenda 3",
+	}
+	serviceOrder, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Empty(t, responseError)
+
+	conflictStart := day.Add(9*time.Hour + 30*time.Minute)
+	serviceOrderDTO.InicioJanela = &conflictStart
+	conflict, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Equal(t, utils.ScheduleConflict, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, conflict)
+
+	agendas, responseError := scheduleServiceTest.FindAgenda(ctx, "Tecnico Agenda 3", day)
+
+	require.Empty(t, responseError)
+	require.Len(t, agendas, 1)
+	require.Equal(t, 2, agendas[0].Capacidade)
+	require.Equal(t, 1, agendas[0].Ocupados)
+	require.Equal(t, 0.5, agendas[0].Carga)
+	require.False(t, agendas[0].Horarios[0].Livre)
+	require.Equal(t, serviceOrder.ID, agendas[0].Horarios[0].OrdemServicoID)
+	require.True(t, agendas[0].Horarios[1].Livre)
+
+	agendas, responseError = scheduleServiceTest.FindAgenda(ctx, "Tecnico Inexistente", day)
+
+	require.Equal(t, utils.TechnicianNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, agendas)
+}
+
+// TestSuggestSlots testa se os horarios sugeridos são os primeiros horarios livres dos tecnicos do bairro do ponto.
+func TestSuggestSlots(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 137.0", Logradouro: "LogradouroTest 122.0", Numero: 122, Bairro: "Vila Mariana"})
+
+	workStart, workEnd := 8, 12
+	for _, technicianDTO := range []dtos.TechnicianCreateDTO{
+		{Nome: "Tecnico Agenda 4", Bairros: []string{"Vl. Mariana"}, InicioExpediente: &workStart, FimExpediente: &workEnd},
+		{Nome: "Tecnico Agenda 5", Bairros: []string{"Vila Mariana"}, InicioExpediente: &workStart, FimExpediente: &workEnd},
+		{Nome: "Tecnico Agenda 6", Bairros: []string{"Santana"}, InicioExpediente: &workStart, FimExpediente: &workEnd},
+	} {
+		_, responseError := scheduleServiceTest.CreateTechnician(ctx, technicianDTO)
+
+		require.Empty(t, responseError)
+	}
+
+	day := scheduleDay(3)
+	start := day.Add(8 * time.Hour)
+	end := day.Add(10 * time.Hour)
+	serviceOrderDTO := dtos.ServiceOrderCreateDTO{
+		Tipo:         entities.INSTALACAO,
+		PontoID:      point.ID,
+		InicioJanela: &start,
+		FimJanela:    &end,
+		Tecnico:      "Tecnico Agenda 4",
+	}
+	_, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Empty(t, responseError)
+
+	suggestions, responseError := scheduleServiceTest.SuggestSlots(ctx, point.ID, day, 3)
+
+	require.Empty(t, responseError)
+	require.Len(t, suggestions, 3)
+	require.Equal(t, "Tecnico Agenda 5", suggestions[0].Tecnico)
+	require.Equal(t, start, suggestions[0].Inicio)
+	require.Equal(t, day.Add(10*time.Hour), suggestions[1].Inicio)
+	require.Equal(t, day.Add(10*time.Hour), suggestions[2].Inicio)
+
+	for _, suggestion := range suggestions {
+		require.NotEqual(t, "Tecnico Agenda 6", suggestion.Tecnico)
+	}
+
+	otherPoint := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 138.0", Logradouro: "LogradouroTest 123.0", Numero: 123, Bairro: "Bairro Sem Tecnico"})
+
+	suggestions, responseError = scheduleServiceTest.SuggestSlots(ctx, otherPoint.ID, day, 3)
+
+	require.Equal(t, utils.TechnicianNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, suggestions)
+}
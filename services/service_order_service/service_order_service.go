@@ -0,0 +1,311 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	services "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/service_order_service")
+
+// ServiceOrderService representa a interface de ServiceOrderService.
+type ServiceOrderService interface {
+	CreateServiceOrder(ctx context.Context, serviceOrderDTO dtos.ServiceOrderCreateDTO) (entities.OrdemServico, utils.ResponseError)
+	FindServiceOrderByID(ctx context.Context, serviceOrderID string) (entities.OrdemServico, utils.ResponseError)
+	FindServiceOrders(ctx context.Context, filter dtos.ServiceOrderFilter) ([]entities.OrdemServico, utils.ResponseError)
+	ScheduleServiceOrder(ctx context.Context, scheduleDTO dtos.ServiceOrderScheduleDTO) (entities.OrdemServico, utils.ResponseError)
+	UpdateServiceOrderStatus(ctx context.Context, statusDTO dtos.ServiceOrderStatusDTO) (entities.OrdemServico, utils.ResponseError)
+	CompleteServiceOrder(ctx context.Context, completeDTO dtos.ServiceOrderCompleteDTO) (entities.OrdemServico, utils.ResponseError)
+}
+
+type serviceOrderService struct {
+	serviceOrderRepository repositories.ServiceOrderRepository
+	pointRepository        repositories.PointRepository
+	technicianRepository   repositories.TechnicianRepository
+	contractService        services.ContractService
+	logger                 *slog.Logger
+}
+
+func (service *serviceOrderService) CreateServiceOrder(ctx context.Context, serviceOrderDTO dtos.ServiceOrderCreateDTO) (entities.OrdemServico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderService.CreateServiceOrder")
+	defer span.End()
+
+	_, err := service.pointRepository.FindPointByID(ctx, serviceOrderDTO.PontoID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.OrdemServico{}, utils.NewResponseError("ponto_id: "+utils.PointNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.OrdemServico{}, utils.NewInternalResponseError(err)
+	}
+
+	if serviceOrderDTO.ContratoID != "" {
+		contract, responseError := service.contractService.FindContractByID(ctx, serviceOrderDTO.ContratoID)
+		if responseError.StatusCode == http.StatusNotFound || (responseError == (utils.ResponseError{}) && contract.PontoID != serviceOrderDTO.PontoID) {
+			return entities.OrdemServico{}, utils.NewResponseError("contrato_id: "+utils.ContractNotFound, http.StatusNotFound)
+		}
+
+		if responseError != (utils.ResponseError{}) {
+			return entities.OrdemServico{}, responseError
+		}
+	}
+
+	if (serviceOrderDTO.InicioJanela == nil) != (serviceOrderDTO.FimJanela == nil) ||
+		(serviceOrderDTO.InicioJanela != nil && !serviceOrderDTO.FimJanela.After(*serviceOrderDTO.InicioJanela)) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.InvalidServiceOrderWindow, http.StatusBadRequest)
+	}
+
+	serviceOrder := entities.OrdemServico{
+		Tipo:         serviceOrderDTO.Tipo,
+		Estado:       entities.ORDEM_ABERTA,
+		PontoID:      serviceOrderDTO.PontoID,
+		ContratoID:   serviceOrderDTO.ContratoID,
+		Descricao:    strings.TrimSpace(serviceOrderDTO.Descricao),
+		InicioJanela: serviceOrderDTO.InicioJanela,
+		FimJanela:    serviceOrderDTO.FimJanela,
+		Tecnico:      strings.TrimSpace(serviceOrderDTO.Tecnico),
+	}
+
+	if serviceOrder.Tecnico != "" {
+		technician, responseError := service.findTechnician(ctx, serviceOrder.Tecnico, serviceOrder.InicioJanela, serviceOrder.FimJanela)
+		if responseError != (utils.ResponseError{}) {
+			return entities.OrdemServico{}, responseError
+		}
+
+		serviceOrder.Tecnico = technician.Nome
+	}
+
+	if serviceOrder.InicioJanela != nil && serviceOrder.Tecnico != "" {
+		serviceOrder.Estado = entities.ORDEM_AGENDADA
+		serviceOrder, err = service.serviceOrderRepository.BookServiceOrder(ctx, serviceOrder)
+	} else {
+		serviceOrder, err = service.serviceOrderRepository.CreateServiceOrder(ctx, serviceOrder)
+	}
+
+	if errors.Is(err, repositories.ErrScheduleConflict) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.ScheduleConflict, http.StatusConflict)
+	}
+
+	if err != nil {
+		return entities.OrdemServico{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "service order opened", slog.String("ordem_servico_id", serviceOrder.ID),
+		slog.String("tipo", string(serviceOrder.Tipo)), slog.String("ponto_id", serviceOrder.PontoID))
+
+	return serviceOrder, utils.ResponseError{}
+}
+
+func (service *serviceOrderService) FindServiceOrderByID(ctx context.Context, serviceOrderID string) (entities.OrdemServico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderService.FindServiceOrderByID")
+	defer span.End()
+
+	serviceOrder, err := service.serviceOrderRepository.FindServiceOrderByID(ctx, serviceOrderID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.ServiceOrderNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.OrdemServico{}, utils.NewInternalResponseError(err)
+	}
+
+	return serviceOrder, utils.ResponseError{}
+}
+
+func (service *serviceOrderService) FindServiceOrders(ctx context.Context, filter dtos.ServiceOrderFilter) ([]entities.OrdemServico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderService.FindServiceOrders")
+	defer span.End()
+
+	serviceOrders, err := service.serviceOrderRepository.FindServiceOrders(ctx, filter)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return serviceOrders, utils.ResponseError{}
+}
+
+// ScheduleServiceOrder agenda a ordem de serviço na janela informada, atribuindo o tecnico responsavel. A janela
+// deve estar dentro do expediente do tecnico e não pode se sobrepor a outra ordem do mesmo tecnico.
+func (service *serviceOrderService) ScheduleServiceOrder(ctx context.Context, scheduleDTO dtos.ServiceOrderScheduleDTO) (entities.OrdemServico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderService.ScheduleServiceOrder")
+	defer span.End()
+
+	serviceOrder, responseError := service.FindServiceOrderByID(ctx, scheduleDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.OrdemServico{}, responseError
+	}
+
+	if !dtos.IsServiceOrderChangeAuthorized(serviceOrder.Estado, entities.ORDEM_AGENDADA) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.InvalidServiceOrderState, http.StatusConflict)
+	}
+
+	if !scheduleDTO.FimJanela.After(scheduleDTO.InicioJanela) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.InvalidServiceOrderWindow, http.StatusBadRequest)
+	}
+
+	technician, responseError := service.findTechnician(ctx, scheduleDTO.Tecnico, &scheduleDTO.InicioJanela, &scheduleDTO.FimJanela)
+	if responseError != (utils.ResponseError{}) {
+		return entities.OrdemServico{}, responseError
+	}
+
+	serviceOrder.Estado = entities.ORDEM_AGENDADA
+	serviceOrder.InicioJanela = &scheduleDTO.InicioJanela
+	serviceOrder.FimJanela = &scheduleDTO.FimJanela
+	serviceOrder.Tecnico = technician.Nome
+
+	serviceOrder, err := service.serviceOrderRepository.BookServiceOrder(ctx, serviceOrder)
+	if errors.Is(err, repositories.ErrScheduleConflict) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.ScheduleConflict, http.StatusConflict)
+	}
+
+	if err != nil {
+		return entities.OrdemServico{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "service order scheduled", slog.String("ordem_servico_id", serviceOrder.ID),
+		slog.String("tecnico", serviceOrder.Tecnico), slog.Time("inicio_janela", scheduleDTO.InicioJanela))
+
+	return serviceOrder, utils.ResponseError{}
+}
+
+// UpdateServiceOrderStatus inicia a execução da ordem agendada ou cancela a ordem ainda não concluida.
+func (service *serviceOrderService) UpdateServiceOrderStatus(ctx context.Context, statusDTO dtos.ServiceOrderStatusDTO) (entities.OrdemServico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderService.UpdateServiceOrderStatus")
+	defer span.End()
+
+	serviceOrder, responseError := service.FindServiceOrderByID(ctx, statusDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.OrdemServico{}, responseError
+	}
+
+	if !dtos.IsServiceOrderChangeAuthorized(serviceOrder.Estado, statusDTO.Estado) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.InvalidServiceOrderState, http.StatusConflict)
+	}
+
+	previousStatus := serviceOrder.Estado
+	serviceOrder.Estado = statusDTO.Estado
+
+	serviceOrder, err := service.serviceOrderRepository.UpdateServiceOrder(ctx, serviceOrder)
+	if err != nil {
+		return entities.OrdemServico{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "service order updated", slog.String("ordem_servico_id", serviceOrder.ID),
+		slog.String("estado_anterior", string(previousStatus)), slog.String("estado", string(serviceOrder.Estado)))
+
+	return serviceOrder, utils.ResponseError{}
+}
+
+// CompleteServiceOrder conclui a ordem de serviço com o relatorio do tecnico. A conclusão da instalação coloca
+// em vigor o contrato pendente de instalação.
+func (service *serviceOrderService) CompleteServiceOrder(ctx context.Context, completeDTO dtos.ServiceOrderCompleteDTO) (entities.OrdemServico, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "ServiceOrderService.CompleteServiceOrder")
+	defer span.End()
+
+	serviceOrder, responseError := service.FindServiceOrderByID(ctx, completeDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.OrdemServico{}, responseError
+	}
+
+	if !dtos.IsServiceOrderChangeAuthorized(serviceOrder.Estado, entities.ORDEM_CONCLUIDA) {
+		return entities.OrdemServico{}, utils.NewResponseError(utils.InvalidServiceOrderState, http.StatusConflict)
+	}
+
+	if serviceOrder.Tipo == entities.INSTALACAO && serviceOrder.ContratoID != "" {
+		responseError = service.activateContract(ctx, serviceOrder.ContratoID)
+		if responseError != (utils.ResponseError{}) {
+			return entities.OrdemServico{}, responseError
+		}
+	}
+
+	now := time.Now()
+
+	serviceOrder.Estado = entities.ORDEM_CONCLUIDA
+	serviceOrder.Relatorio = strings.TrimSpace(completeDTO.Relatorio)
+	serviceOrder.DataConclusao = &now
+
+	serviceOrder, err := service.serviceOrderRepository.UpdateServiceOrder(ctx, serviceOrder)
+	if err != nil {
+		return entities.OrdemServico{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "service order completed", slog.String("ordem_servico_id", serviceOrder.ID),
+		slog.String("tipo", string(serviceOrder.Tipo)), slog.String("tecnico", serviceOrder.Tecnico))
+
+	return serviceOrder, utils.ResponseError{}
+}
+
+// activateContract coloca em vigor o contrato pendente de instalação, mantendo os contratos já ativados.
+func (service *serviceOrderService) activateContract(ctx context.Context, contractID string) utils.ResponseError {
+	contract, responseError := service.contractService.FindContractByID(ctx, contractID)
+	if responseError != (utils.ResponseError{}) {
+		return responseError
+	}
+
+	if contract.Estado != entities.PENDENTE {
+		return utils.ResponseError{}
+	}
+
+	contractDTO := dtos.ContractUpdateDTO{
+		Base: dtos.Base{
+			ID: contract.ID,
+		},
+		Estado:     entities.VIGOR,
+		Motivo:     string(entities.INSTALACAO),
+		Automatica: true,
+	}
+
+	_, responseError = service.contractService.UpdateContract(ctx, contractDTO)
+
+	return responseError
+}
+
+// findTechnician busca o tecnico cadastrado com o nome informado e verifica se a janela, quando informada, está
+// dentro do expediente do tecnico.
+func (service *serviceOrderService) findTechnician(ctx context.Context, name string, start, end *time.Time) (entities.Tecnico, utils.ResponseError) {
+	technician, err := service.technicianRepository.FindTechnicianByName(ctx, strings.TrimSpace(name))
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Tecnico{}, utils.NewResponseError("tecnico: "+utils.TechnicianNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Tecnico{}, utils.NewInternalResponseError(err)
+	}
+
+	if start == nil || end == nil {
+		return technician, utils.ResponseError{}
+	}
+
+	windowStart := start.In(time.Local)
+	day := time.Date(windowStart.Year(), windowStart.Month(), windowStart.Day(), 0, 0, 0, 0, time.Local)
+	workStart := day.Add(time.Duration(technician.InicioExpediente) * time.Hour)
+	workEnd := day.Add(time.Duration(technician.FimExpediente) * time.Hour)
+
+	if start.Before(workStart) || end.After(workEnd) {
+		return entities.Tecnico{}, utils.NewResponseError(utils.OutsideWorkingHours, http.StatusBadRequest)
+	}
+
+	return technician, utils.ResponseError{}
+}
+
+// NewServiceOrderService cria uma nova instancia de ServiceOrderService.
+func NewServiceOrderService(serviceOrderRepository repositories.ServiceOrderRepository, pointRepository repositories.PointRepository, technicianRepository repositories.TechnicianRepository, contractService services.ContractService, logger *slog.Logger) ServiceOrderService {
+	return &serviceOrderService{
+		serviceOrderRepository: serviceOrderRepository,
+		pointRepository:        pointRepository,
+		technicianRepository:   technicianRepository,
+		contractService:        contractService,
+		logger:                 logger,
+	}
+}
@@ -0,0 +1,415 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/services/fixtures"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	serviceOrderService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/service_order_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+	dbTechnician        = repositoriesFake.DBTechnician
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+	technicianRepositoryFake        = repositoriesFake.NewTechnicianRepositoryFake(dbTechnician)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	serviceOrderServiceTest    = serviceOrderService.NewServiceOrderService(serviceOrderRepositoryFake, pointRepositoryFake, technicianRepositoryFake, contractServiceTest, logNop)
+
+	// Fixtures
+	pointFactory = fixtures.NewPointFactory(clientServiceTest, addressServiceTest, pointServiceTest)
+)
+
+// createServiceOrderTechnician cadastra um tecnico com expediente das 8 as 18 horas para os testes das ordens de
+// serviço.
+func createServiceOrderTechnician(t *testing.T, name string) {
+	technician := entities.Tecnico{
+		Nome:             name,
+		InicioExpediente: 8,
+		FimExpediente:    18,
+	}
+	_, err := technicianRepositoryFake.CreateTechnician(ctx, technician)
+
+	require.NoError(t, err)
+}
+
+// serviceOrderDay retorna o inicio do dia, a partir de hoje, usado nas janelas das ordens de serviço.
+func serviceOrderDay(days int) time.Time {
+	now := time.Now().AddDate(0, 0, days)
+
+	return time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.Local)
+}
+
+// TestCreateServiceOrder testa se é possivel abrir uma ordem de serviço, já agendada com a janela e o tecnico.
+func TestCreateServiceOrder(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 130.0", Logradouro: "LogradouroTest 115.0", Numero: 115})
+	createServiceOrderTechnician(t, "Tecnico 1")
+
+	start := serviceOrderDay(1).Add(9 * time.Hour)
+	end := start.Add(4 * time.Hour)
+	serviceOrderDTO := dtos.ServiceOrderCreateDTO{
+		Tipo:         entities.REPARO,
+		PontoID:      point.ID,
+		Descricao:    " Sem sinal ",
+		InicioJanela: &start,
+		FimJanela:    &end,
+		Tecnico:      " tecnico 1 ",
+	}
+	serviceOrder, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.ORDEM_AGENDADA, serviceOrder.Estado)
+	require.Equal(t, "Sem sinal", serviceOrder.Descricao)
+	require.Equal(t, "Tecnico 1", serviceOrder.Tecnico)
+
+	serviceOrderDTO.Tecnico = "Tecnico Inexistente"
+	serviceOrder, responseError = serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Equal(t, "tecnico: "+utils.TechnicianNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, serviceOrder)
+
+	serviceOrderDTO.Tecnico = "Tecnico 1"
+
+	serviceOrderDTO.FimJanela = &start
+	serviceOrder, responseError = serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Equal(t, utils.InvalidServiceOrderWindow, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, serviceOrder)
+
+	serviceOrderDTO.PontoID = "invalid-point-id"
+	serviceOrder, responseError = serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Equal(t, "ponto_id: "+utils.PointNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, serviceOrder)
+}
+
+// TestCompleteInstallationServiceOrder testa se a conclusão da instalação coloca em vigor o contrato pendente.
+func TestCompleteInstallationServiceOrder(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 131.0", Logradouro: "LogradouroTest 116.0", Numero: 116})
+	createServiceOrderTechnician(t, "Tecnico 2")
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.PENDENTE,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+	require.Nil(t, contract.DataAtivacao)
+
+	serviceOrders, responseError := serviceOrderServiceTest.FindServiceOrders(ctx, dtos.ServiceOrderFilter{ContratoID: contract.ID})
+
+	require.Empty(t, responseError)
+	require.Len(t, serviceOrders, 1)
+	require.Equal(t, entities.INSTALACAO, serviceOrders[0].Tipo)
+	require.Equal(t, entities.ORDEM_ABERTA, serviceOrders[0].Estado)
+
+	completeDTO := dtos.ServiceOrderCompleteDTO{
+		Base: dtos.Base{
+			ID: serviceOrders[0].ID,
+		},
+		Relatorio: "Instalação concluida",
+	}
+	serviceOrder, responseError := serviceOrderServiceTest.CompleteServiceOrder(ctx, completeDTO)
+
+	require.Equal(t, utils.InvalidServiceOrderState, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, serviceOrder)
+
+	scheduleDTO := dtos.ServiceOrderScheduleDTO{
+		Base: dtos.Base{
+			ID: serviceOrders[0].ID,
+		},
+		InicioJanela: serviceOrderDay(0).Add(9 * time.Hour),
+		FimJanela:    serviceOrderDay(0).Add(11 * time.Hour),
+		Tecnico:      "Tecnico 2",
+	}
+	serviceOrder, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.ORDEM_AGENDADA, serviceOrder.Estado)
+
+	serviceOrder, responseError = serviceOrderServiceTest.CompleteServiceOrder(ctx, completeDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.ORDEM_CONCLUIDA, serviceOrder.Estado)
+	require.Equal(t, "Instalação concluida", serviceOrder.Relatorio)
+	require.NotNil(t, serviceOrder.DataConclusao)
+
+	contractFound, responseError := contractServiceTest.FindContractByID(ctx, contract.ID)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.VIGOR, contractFound.Estado)
+	require.NotNil(t, contractFound.DataAtivacao)
+
+	statusDTO := dtos.ServiceOrderStatusDTO{
+		Base: dtos.Base{
+			ID: serviceOrder.ID,
+		},
+		Estado: entities.ORDEM_CANCELADA,
+	}
+	serviceOrder, responseError = serviceOrderServiceTest.UpdateServiceOrderStatus(ctx, statusDTO)
+
+	require.Equal(t, utils.InvalidServiceOrderState, responseError.Message)
+	require.Empty(t, serviceOrder)
+}
+
+// TestCancelContractOpensRemovalServiceOrder testa se o cancelamento do contrato abre a ordem de retirada de equipamento.
+func TestCancelContractOpensRemovalServiceOrder(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 132.0", Logradouro: "LogradouroTest 117.0", Numero: 117})
+
+	contractDTO := dtos.ContractCreateDTO{
+		PontoID: point.ID,
+		Estado:  entities.VIGOR,
+	}
+	contract, responseError := contractServiceTest.CreateContract(ctx, contractDTO)
+
+	require.Empty(t, responseError)
+
+	for _, state := range []entities.ContractState{entities.DESATIVADO, entities.CANCELADO} {
+		contractUpdateDTO := dtos.ContractUpdateDTO{
+			Base: dtos.Base{
+				ID: contract.ID,
+			},
+			Estado: state,
+		}
+		_, responseError = contractServiceTest.UpdateContract(ctx, contractUpdateDTO)
+
+		require.Empty(t, responseError)
+	}
+
+	serviceOrders, responseError := serviceOrderServiceTest.FindServiceOrders(ctx, dtos.ServiceOrderFilter{ContratoID: contract.ID})
+
+	require.Empty(t, responseError)
+	require.Len(t, serviceOrders, 1)
+	require.Equal(t, entities.RETIRADA, serviceOrders[0].Tipo)
+	require.Equal(t, point.ID, serviceOrders[0].PontoID)
+}
+
+// TestScheduleServiceOrderConflict testa se o agendamento na janela ocupada por outra ordem do tecnico é recusado,
+// permitindo reagendar a propria ordem na mesma janela.
+func TestScheduleServiceOrderConflict(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 139.0", Logradouro: "LogradouroTest 124.0", Numero: 124})
+	createServiceOrderTechnician(t, "Tecnico 3")
+
+	serviceOrderDTO := dtos.ServiceOrderCreateDTO{
+		Tipo:    entities.REPARO,
+		PontoID: point.ID,
+	}
+	first, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Empty(t, responseError)
+
+	second, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Empty(t, responseError)
+
+	start := serviceOrderDay(5).Add(9 * time.Hour)
+	scheduleDTO := dtos.ServiceOrderScheduleDTO{
+		Base: dtos.Base{
+			ID: first.ID,
+		},
+		InicioJanela: start,
+		FimJanela:    start.Add(2 * time.Hour),
+		Tecnico:      "Tecnico 3",
+	}
+	_, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Empty(t, responseError)
+
+	_, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Empty(t, responseError)
+
+	scheduleDTO.ID = second.ID
+	scheduleDTO.InicioJanela = start.Add(time.Hour)
+	scheduleDTO.FimJanela = start.Add(3 * time.Hour)
+	serviceOrder, responseError := serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Equal(t, utils.ScheduleConflict, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, serviceOrder)
+
+	scheduleDTO.InicioJanela = start.Add(2 * time.Hour)
+	serviceOrder, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.ORDEM_AGENDADA, serviceOrder.Estado)
+}
+
+// TestScheduleServiceOrderConcurrently testa se os agendamentos simultaneos na mesma janela do tecnico reservam a
+// janela para apenas uma ordem. O teste cobre apenas a trava do repositorio fake em memoria; a serialização no
+// banco pelo pg_advisory_xact_lock do repositorio postgres não é exercitada aqui.
+func TestScheduleServiceOrderConcurrently(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 140.0", Logradouro: "LogradouroTest 125.0", Numero: 125})
+	createServiceOrderTechnician(t, "Tecnico 4")
+
+	serviceOrderDTO := dtos.ServiceOrderCreateDTO{
+		Tipo:    entities.REPARO,
+		PontoID: point.ID,
+	}
+
+	serviceOrders := []entities.OrdemServico{}
+	for i := 0; i < 10; i++ {
+		serviceOrder, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+		require.Empty(t, responseError)
+
+		serviceOrders = append(serviceOrders, serviceOrder)
+	}
+
+	start := serviceOrderDay(6).Add(9 * time.Hour)
+	responseErrors := make([]utils.ResponseError, len(serviceOrders))
+
+	var waitGroup sync.WaitGroup
+	for i, serviceOrder := range serviceOrders {
+		waitGroup.Add(1)
+
+		go func(i int, serviceOrderID string) {
+			defer waitGroup.Done()
+
+			scheduleDTO := dtos.ServiceOrderScheduleDTO{
+				Base: dtos.Base{
+					ID: serviceOrderID,
+				},
+				InicioJanela: start,
+				FimJanela:    start.Add(2 * time.Hour),
+				Tecnico:      "Tecnico 4",
+			}
+			_, responseErrors[i] = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+		}(i, serviceOrder.ID)
+	}
+
+	waitGroup.Wait()
+
+	scheduled := 0
+	for _, responseError := range responseErrors {
+		if responseError == (utils.ResponseError{}) {
+			scheduled++
+			continue
+		}
+
+		require.Equal(t, utils.ScheduleConflict, responseError.Message)
+		require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	}
+
+	require.Equal(t, 1, scheduled)
+
+	serviceOrdersFound, responseError := serviceOrderServiceTest.FindServiceOrders(ctx, dtos.ServiceOrderFilter{Tecnico: "Tecnico 4"})
+
+	require.Empty(t, responseError)
+	require.Len(t, serviceOrdersFound, 1)
+}
+
+// TestScheduleServiceOrderOutsideWorkingHours testa se o agendamento fora do expediente do tecnico é recusado.
+func TestScheduleServiceOrderOutsideWorkingHours(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 150.0", Logradouro: "LogradouroTest 137.0", Numero: 137})
+	createServiceOrderTechnician(t, "Tecnico 5")
+
+	serviceOrderDTO := dtos.ServiceOrderCreateDTO{
+		Tipo:    entities.REPARO,
+		PontoID: point.ID,
+	}
+	serviceOrder, responseError := serviceOrderServiceTest.CreateServiceOrder(ctx, serviceOrderDTO)
+
+	require.Empty(t, responseError)
+
+	day := serviceOrderDay(7)
+	scheduleDTO := dtos.ServiceOrderScheduleDTO{
+		Base: dtos.Base{
+			ID: serviceOrder.ID,
+		},
+		InicioJanela: day.Add(17 * time.Hour),
+		FimJanela:    day.Add(19 * time.Hour),
+		Tecnico:      "Tecnico 5",
+	}
+	serviceOrderScheduled, responseError := serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Equal(t, utils.OutsideWorkingHours, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, serviceOrderScheduled)
+
+	scheduleDTO.InicioJanela = day.Add(7 * time.Hour)
+	scheduleDTO.FimJanela = day.Add(9 * time.Hour)
+	serviceOrderScheduled, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Equal(t, utils.OutsideWorkingHours, responseError.Message)
+	require.Empty(t, serviceOrderScheduled)
+
+	scheduleDTO.InicioJanela = day.Add(16 * time.Hour)
+	scheduleDTO.FimJanela = day.Add(18 * time.Hour)
+	serviceOrderScheduled, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.ORDEM_AGENDADA, serviceOrderScheduled.Estado)
+
+	scheduleDTO.Tecnico = "Tecnico Inexistente"
+	serviceOrderScheduled, responseError = serviceOrderServiceTest.ScheduleServiceOrder(ctx, scheduleDTO)
+
+	require.Equal(t, "tecnico: "+utils.TechnicianNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, serviceOrderScheduled)
+}
@@ -0,0 +1,240 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositories "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/postgres"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"go.opentelemetry.io/otel"
+)
+
+// tracer usado para criar os spans da camada de servicos.
+var tracer = otel.Tracer("github.com/ThiagoRDS-042/Recrutamento-API-GO/services/ticket_service")
+
+// TicketService representa a interface de TicketService.
+type TicketService interface {
+	CreateTicket(ctx context.Context, ticketDTO dtos.TicketCreateDTO) (entities.Chamado, utils.ResponseError)
+	FindTicketByID(ctx context.Context, ticketID string) (entities.Chamado, utils.ResponseError)
+	FindTickets(ctx context.Context, filter dtos.TicketFilter) ([]entities.Chamado, utils.ResponseError)
+	UpdateTicketStatus(ctx context.Context, statusDTO dtos.TicketStatusDTO) (entities.Chamado, utils.ResponseError)
+	AddTicketComment(ctx context.Context, commentDTO dtos.TicketCommentDTO) (entities.Chamado, utils.ResponseError)
+}
+
+type ticketService struct {
+	ticketRepository   repositories.TicketRepository
+	clientRepository   repositories.ClientRepository
+	pointRepository    repositories.PointRepository
+	contractRepository repositories.ContractRepository
+	clientPolicies     policies.ClientPolicies
+	logger             *slog.Logger
+}
+
+// CreateTicket abre o chamado do cliente, com o prazo do SLA definido pela politica do tipo do cliente.
+func (service *ticketService) CreateTicket(ctx context.Context, ticketDTO dtos.TicketCreateDTO) (entities.Chamado, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "TicketService.CreateTicket")
+	defer span.End()
+
+	client, err := service.clientRepository.FindClientByID(ctx, ticketDTO.ClienteID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Chamado{}, utils.NewResponseError("cliente_id: "+utils.ClientNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Chamado{}, utils.NewInternalResponseError(err)
+	}
+
+	responseError := service.validateReferences(ctx, client.ID, ticketDTO.PontoID, ticketDTO.ContratoID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Chamado{}, responseError
+	}
+
+	now := time.Now()
+
+	ticket := entities.Chamado{
+		ClienteID:  client.ID,
+		PontoID:    ticketDTO.PontoID,
+		ContratoID: ticketDTO.ContratoID,
+		Categoria:  ticketDTO.Categoria,
+		Prioridade: ticketDTO.Prioridade,
+		Estado:     entities.CHAMADO_ABERTO,
+		Assunto:    strings.TrimSpace(ticketDTO.Assunto),
+		Descricao:  strings.TrimSpace(ticketDTO.Descricao),
+		PrazoSLA:   service.clientPolicies.SLADeadline(client.Tipo, ticketDTO.Prioridade, now),
+	}
+
+	ticket, err = service.ticketRepository.CreateTicket(ctx, ticket)
+	if err != nil {
+		return entities.Chamado{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "ticket created", slog.String("chamado_id", ticket.ID),
+		slog.String("cliente_id", ticket.ClienteID), slog.String("prioridade", string(ticket.Prioridade)),
+		slog.Time("prazo_sla", ticket.PrazoSLA))
+
+	return ticket, utils.ResponseError{}
+}
+
+func (service *ticketService) FindTicketByID(ctx context.Context, ticketID string) (entities.Chamado, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "TicketService.FindTicketByID")
+	defer span.End()
+
+	ticket, err := service.ticketRepository.FindTicketByID(ctx, ticketID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return entities.Chamado{}, utils.NewResponseError(utils.TicketNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return entities.Chamado{}, utils.NewInternalResponseError(err)
+	}
+
+	return ticket, utils.ResponseError{}
+}
+
+func (service *ticketService) FindTickets(ctx context.Context, filter dtos.TicketFilter) ([]entities.Chamado, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "TicketService.FindTickets")
+	defer span.End()
+
+	tickets, err := service.ticketRepository.FindTickets(ctx, filter)
+	if err != nil {
+		return nil, utils.NewInternalResponseError(err)
+	}
+
+	return tickets, utils.ResponseError{}
+}
+
+// UpdateTicketStatus altera o estado do chamado. O chamado fechado não pode mais ser alterado, e o chamado
+// resolvido pode ser reaberto, limpando a data de resolução.
+func (service *ticketService) UpdateTicketStatus(ctx context.Context, statusDTO dtos.TicketStatusDTO) (entities.Chamado, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "TicketService.UpdateTicketStatus")
+	defer span.End()
+
+	ticket, responseError := service.FindTicketByID(ctx, statusDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Chamado{}, responseError
+	}
+
+	if ticket.Estado == entities.CHAMADO_FECHADO || ticket.Estado == statusDTO.Estado {
+		return entities.Chamado{}, utils.NewResponseError(utils.InvalidTicketState, http.StatusConflict)
+	}
+
+	previous := ticket.Estado
+	ticket.Estado = statusDTO.Estado
+
+	switch {
+	case !dtos.IsTicketClosed(ticket.Estado):
+		ticket.DataResolucao = nil
+	case ticket.DataResolucao == nil:
+		now := time.Now()
+		ticket.DataResolucao = &now
+	}
+
+	ticket, err := service.ticketRepository.UpdateTicket(ctx, ticket)
+	if err != nil {
+		return entities.Chamado{}, utils.NewInternalResponseError(err)
+	}
+
+	service.logger.InfoContext(ctx, "ticket status updated", slog.String("chamado_id", ticket.ID),
+		slog.String("estado_anterior", string(previous)), slog.String("estado", string(ticket.Estado)))
+
+	return ticket, utils.ResponseError{}
+}
+
+// AddTicketComment adiciona o comentario ao historico do chamado, que não pode estar fechado.
+func (service *ticketService) AddTicketComment(ctx context.Context, commentDTO dtos.TicketCommentDTO) (entities.Chamado, utils.ResponseError) {
+	ctx, span := tracer.Start(ctx, "TicketService.AddTicketComment")
+	defer span.End()
+
+	ticket, responseError := service.FindTicketByID(ctx, commentDTO.ID)
+	if responseError != (utils.ResponseError{}) {
+		return entities.Chamado{}, responseError
+	}
+
+	if ticket.Estado == entities.CHAMADO_FECHADO {
+		return entities.Chamado{}, utils.NewResponseError(utils.InvalidTicketState, http.StatusConflict)
+	}
+
+	comment := entities.ChamadoComentario{
+		ChamadoID: ticket.ID,
+		Autor:     strings.TrimSpace(commentDTO.Autor),
+		Mensagem:  strings.TrimSpace(commentDTO.Mensagem),
+	}
+
+	comment, err := service.ticketRepository.CreateTicketComment(ctx, comment)
+	if err != nil {
+		return entities.Chamado{}, utils.NewInternalResponseError(err)
+	}
+
+	ticket.Comentarios = append(ticket.Comentarios, comment)
+
+	service.logger.InfoContext(ctx, "ticket comment added", slog.String("chamado_id", ticket.ID),
+		slog.String("comentario_id", comment.ID))
+
+	return ticket, utils.ResponseError{}
+}
+
+// validateReferences verifica se o ponto e o contrato informados pertencem ao cliente do chamado. Quando os dois
+// são informados, o contrato deve ser do ponto.
+func (service *ticketService) validateReferences(ctx context.Context, clientID string, pointID string, contractID string) utils.ResponseError {
+	if pointID != "" {
+		point, err := service.pointRepository.FindPointByID(ctx, pointID)
+		if errors.Is(err, repositories.ErrNotFound) {
+			return utils.NewResponseError("ponto_id: "+utils.PointNotFound, http.StatusNotFound)
+		}
+
+		if err != nil {
+			return utils.NewInternalResponseError(err)
+		}
+
+		if point.ClienteID != clientID {
+			return utils.NewResponseError("ponto_id: "+utils.InvalidTicketReference, http.StatusBadRequest)
+		}
+	}
+
+	if contractID == "" {
+		return utils.ResponseError{}
+	}
+
+	contract, err := service.contractRepository.FindContractByID(ctx, contractID)
+	if errors.Is(err, repositories.ErrNotFound) {
+		return utils.NewResponseError("contrato_id: "+utils.ContractNotFound, http.StatusNotFound)
+	}
+
+	if err != nil {
+		return utils.NewInternalResponseError(err)
+	}
+
+	if pointID != "" && contract.PontoID != pointID {
+		return utils.NewResponseError("contrato_id: "+utils.InvalidTicketReference, http.StatusBadRequest)
+	}
+
+	point, err := service.pointRepository.FindPointByID(ctx, contract.PontoID)
+	if err != nil && !errors.Is(err, repositories.ErrNotFound) {
+		return utils.NewInternalResponseError(err)
+	}
+
+	if point.ClienteID != clientID {
+		return utils.NewResponseError("contrato_id: "+utils.InvalidTicketReference, http.StatusBadRequest)
+	}
+
+	return utils.ResponseError{}
+}
+
+// NewTicketService cria uma nova instancia de TicketService.
+func NewTicketService(ticketRepository repositories.TicketRepository, clientRepository repositories.ClientRepository, pointRepository repositories.PointRepository, contractRepository repositories.ContractRepository, clientPolicies policies.ClientPolicies, logger *slog.Logger) TicketService {
+	return &ticketService{
+		ticketRepository:   ticketRepository,
+		clientRepository:   clientRepository,
+		pointRepository:    pointRepository,
+		contractRepository: contractRepository,
+		clientPolicies:     clientPolicies,
+		logger:             logger,
+	}
+}
@@ -0,0 +1,241 @@
+package services_test
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/entities/dtos"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/logger"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/policies"
+	repositoriesFake "github.com/ThiagoRDS-042/Recrutamento-API-GO/repositories/fake"
+	addressService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/address_service"
+	clientService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/client_service"
+	contactService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contact_service"
+	contractEventService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_event_service"
+	contractService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_service"
+	contractVersionService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/contract_version_service"
+	equipmentService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/equipment_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/services/fixtures"
+	pointService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/point_service"
+	ticketService "github.com/ThiagoRDS-042/Recrutamento-API-GO/services/ticket_service"
+	"github.com/ThiagoRDS-042/Recrutamento-API-GO/utils"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	ctx    = context.Background()
+	logNop = logger.NewNop()
+
+	// Fake Databases
+	dbClient            = repositoriesFake.DBClient
+	dbAddress           = repositoriesFake.DBAddress
+	dbPoint             = repositoriesFake.DBPoint
+	dbContract          = repositoriesFake.DBContract
+	dbPlan              = repositoriesFake.DBPlan
+	dbContractEvent     = repositoriesFake.DBContractEvent
+	dbContractVersion   = repositoriesFake.DBContractVersion
+	dbServiceOrder      = repositoriesFake.DBServiceOrder
+	dbEquipment         = repositoriesFake.DBEquipment
+	dbEquipmentMovement = repositoriesFake.DBEquipmentMovement
+	dbTicket            = repositoriesFake.DBTicket
+	dbContact           = repositoriesFake.DBContact
+	dbCep               = repositoriesFake.DBCep
+
+	// Fake Repositories
+	clientRepositoryFake            = repositoriesFake.NewClientRepositoryFake(dbClient)
+	addressRepositoryFake           = repositoriesFake.NewAddressRepositoryFake(dbAddress)
+	pointRepositoryFake             = repositoriesFake.NewPointRepositoryFake(dbPoint, dbClient, dbAddress, dbContractEvent, dbContract)
+	contractRepositoryFake          = repositoriesFake.NewContractRepositoryFake(dbContract, dbClient, dbAddress, dbPoint, dbPlan, dbContractVersion)
+	planRepositoryFake              = repositoriesFake.NewPlanRepositoryFake(dbPlan)
+	contractEventRepositoryFake     = repositoriesFake.NewContractEventRepositoryFake(dbContractEvent)
+	contractVersionRepositoryFake   = repositoriesFake.NewContractVersionRepositoryFake(dbContractVersion)
+	serviceOrderRepositoryFake      = repositoriesFake.NewServiceOrderRepositoryFake(dbServiceOrder)
+	equipmentRepositoryFake         = repositoriesFake.NewEquipmentRepositoryFake(dbEquipment, dbContract, dbPoint, dbClient)
+	equipmentMovementRepositoryFake = repositoriesFake.NewEquipmentMovementRepositoryFake(dbEquipmentMovement)
+	cepRepositoryFake               = repositoriesFake.NewCEPRepositoryFake(dbCep)
+	ticketRepositoryFake            = repositoriesFake.NewTicketRepositoryFake(dbTicket)
+	contactRepositoryFake           = repositoriesFake.NewContactRepositoryFake(dbContact)
+
+	// Policies
+	clientPolicies = policies.Default()
+
+	// Services Tests
+	contractEventServiceTest   = contractEventService.NewContractEventService(contractEventRepositoryFake, contractRepositoryFake, logNop)
+	contractVersionServiceTest = contractVersionService.NewContractVersionService(contractVersionRepositoryFake, contractRepositoryFake, logNop)
+	equipmentServiceTest       = equipmentService.NewEquipmentService(equipmentRepositoryFake, equipmentMovementRepositoryFake, pointRepositoryFake, logNop)
+	contractServiceTest        = contractService.NewContractService(contractRepositoryFake, pointRepositoryFake, clientRepositoryFake, contactRepositoryFake, planRepositoryFake, serviceOrderRepositoryFake, contractEventServiceTest, contractVersionServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	pointServiceTest           = pointService.NewPointService(pointRepositoryFake, clientRepositoryFake, addressRepositoryFake, contractServiceTest, equipmentServiceTest, clientPolicies, logNop)
+	contactServiceTest         = contactService.NewContactService(contactRepositoryFake, clientRepositoryFake, logNop)
+	clientServiceTest          = clientService.NewClientService(clientRepositoryFake, ticketRepositoryFake, pointServiceTest, contactServiceTest, logNop)
+	addressServiceTest         = addressService.NewAddressService(addressRepositoryFake, cepRepositoryFake, pointServiceTest, logNop)
+	ticketServiceTest          = ticketService.NewTicketService(ticketRepositoryFake, clientRepositoryFake, pointRepositoryFake, contractRepositoryFake, clientPolicies, logNop)
+
+	// Fixtures
+	pointFactory = fixtures.NewPointFactory(clientServiceTest, addressServiceTest, pointServiceTest)
+)
+
+// TestCreateTicket testa se é possivel abrir um chamado, com o prazo do SLA menor para os clientes especiais.
+func TestCreateTicket(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 140.0", Tipo: entities.FISICO, Logradouro: "LogradouroTest 125.0", Numero: 125})
+	specialPoint := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 141.0", Tipo: entities.ESPECIAL, Logradouro: "LogradouroTest 126.0", Numero: 126})
+
+	ticketDTO := dtos.TicketCreateDTO{
+		ClienteID:  point.ClienteID,
+		PontoID:    point.ID,
+		Categoria:  entities.SUPORTE_TECNICO,
+		Prioridade: entities.PRIORIDADE_ALTA,
+		Assunto:    " Sem conexão ",
+	}
+	ticket, responseError := ticketServiceTest.CreateTicket(ctx, ticketDTO)
+
+	require.Empty(t, responseError)
+	require.NotEmpty(t, ticket.ID)
+	require.Equal(t, "Sem conexão", ticket.Assunto)
+	require.Equal(t, entities.CHAMADO_ABERTO, ticket.Estado)
+	require.WithinDuration(t, time.Now().Add(24*time.Hour), ticket.PrazoSLA, time.Minute)
+
+	specialTicketDTO := ticketDTO
+	specialTicketDTO.ClienteID = specialPoint.ClienteID
+	specialTicketDTO.PontoID = specialPoint.ID
+	specialTicket, responseError := ticketServiceTest.CreateTicket(ctx, specialTicketDTO)
+
+	require.Empty(t, responseError)
+	require.WithinDuration(t, time.Now().Add(6*time.Hour), specialTicket.PrazoSLA, time.Minute)
+	require.True(t, specialTicket.PrazoSLA.Before(ticket.PrazoSLA))
+
+	ticketDTO.PontoID = specialPoint.ID
+	ticket, responseError = ticketServiceTest.CreateTicket(ctx, ticketDTO)
+
+	require.Equal(t, "ponto_id: "+utils.InvalidTicketReference, responseError.Message)
+	require.Equal(t, http.StatusBadRequest, responseError.StatusCode)
+	require.Empty(t, ticket)
+
+	ticketDTO.ClienteID = "invalid-client-id"
+	ticket, responseError = ticketServiceTest.CreateTicket(ctx, ticketDTO)
+
+	require.Equal(t, "cliente_id: "+utils.ClientNotFound, responseError.Message)
+	require.Equal(t, http.StatusNotFound, responseError.StatusCode)
+	require.Empty(t, ticket)
+}
+
+// TestUpdateTicketStatus testa se é possivel resolver, reabrir e fechar o chamado, e se o chamado fechado
+// não pode mais ser alterado nem comentado.
+func TestUpdateTicketStatus(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 142.0", Tipo: entities.FISICO, Logradouro: "LogradouroTest 127.0", Numero: 127})
+
+	ticketDTO := dtos.TicketCreateDTO{
+		ClienteID:  point.ClienteID,
+		Categoria:  entities.RECLAMACAO,
+		Prioridade: entities.PRIORIDADE_MEDIA,
+		Assunto:    "Cobrança indevida",
+	}
+	ticket, responseError := ticketServiceTest.CreateTicket(ctx, ticketDTO)
+
+	require.Empty(t, responseError)
+
+	commentDTO := dtos.TicketCommentDTO{
+		Base: dtos.Base{
+			ID: ticket.ID,
+		},
+		Autor:    "Atendente Test",
+		Mensagem: "Fatura em analise",
+	}
+	ticketFound, responseError := ticketServiceTest.AddTicketComment(ctx, commentDTO)
+
+	require.Empty(t, responseError)
+	require.Len(t, ticketFound.Comentarios, 1)
+
+	statusDTO := dtos.TicketStatusDTO{
+		Base: dtos.Base{
+			ID: ticket.ID,
+		},
+		Estado: entities.CHAMADO_RESOLVIDO,
+	}
+	ticketFound, responseError = ticketServiceTest.UpdateTicketStatus(ctx, statusDTO)
+
+	require.Empty(t, responseError)
+	require.Equal(t, entities.CHAMADO_RESOLVIDO, ticketFound.Estado)
+	require.NotNil(t, ticketFound.DataResolucao)
+
+	statusDTO.Estado = entities.CHAMADO_ABERTO
+	ticketFound, responseError = ticketServiceTest.UpdateTicketStatus(ctx, statusDTO)
+
+	require.Empty(t, responseError)
+	require.Nil(t, ticketFound.DataResolucao)
+
+	statusDTO.Estado = entities.CHAMADO_FECHADO
+	ticketFound, responseError = ticketServiceTest.UpdateTicketStatus(ctx, statusDTO)
+
+	require.Empty(t, responseError)
+	require.NotNil(t, ticketFound.DataResolucao)
+	require.Len(t, ticketFound.Comentarios, 1)
+
+	statusDTO.Estado = entities.CHAMADO_ABERTO
+	ticketFound, responseError = ticketServiceTest.UpdateTicketStatus(ctx, statusDTO)
+
+	require.Equal(t, utils.InvalidTicketState, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, ticketFound)
+
+	ticketFound, responseError = ticketServiceTest.AddTicketComment(ctx, commentDTO)
+
+	require.Equal(t, utils.InvalidTicketState, responseError.Message)
+	require.Equal(t, http.StatusConflict, responseError.StatusCode)
+	require.Empty(t, ticketFound)
+}
+
+// TestFindOverdueTickets testa se os chamados com o prazo do SLA vencido são listados como atrasados e se a
+// pesquisa do cliente retorna apenas os chamados em aberto.
+func TestFindOverdueTickets(t *testing.T) {
+	point := pointFactory.CreatePoint(t, fixtures.PointData{Nome: "Test 143.0", Tipo: entities.FISICO, Logradouro: "LogradouroTest 128.0", Numero: 128})
+
+	ticketDTO := dtos.TicketCreateDTO{
+		ClienteID:  point.ClienteID,
+		PontoID:    point.ID,
+		Categoria:  entities.SOLICITACAO,
+		Prioridade: entities.PRIORIDADE_BAIXA,
+		Assunto:    "Mudança de endereço",
+	}
+	overdueTicket, responseError := ticketServiceTest.CreateTicket(ctx, ticketDTO)
+
+	require.Empty(t, responseError)
+
+	overdueTicket.PrazoSLA = time.Now().Add(-time.Hour)
+	overdueTicket, _ = ticketRepositoryFake.UpdateTicket(ctx, overdueTicket)
+
+	ticket, responseError := ticketServiceTest.CreateTicket(ctx, ticketDTO)
+
+	require.Empty(t, responseError)
+
+	resolvedTicket, responseError := ticketServiceTest.CreateTicket(ctx, ticketDTO)
+
+	require.Empty(t, responseError)
+
+	statusDTO := dtos.TicketStatusDTO{
+		Base: dtos.Base{
+			ID: resolvedTicket.ID,
+		},
+		Estado: entities.CHAMADO_RESOLVIDO,
+	}
+	_, responseError = ticketServiceTest.UpdateTicketStatus(ctx, statusDTO)
+
+	require.Empty(t, responseError)
+
+	tickets, responseError := ticketServiceTest.FindTickets(ctx, dtos.TicketFilter{ClienteID: point.ClienteID, Atrasados: true})
+
+	require.Empty(t, responseError)
+	require.Len(t, tickets, 1)
+	require.Equal(t, overdueTicket.ID, tickets[0].ID)
+	require.True(t, dtos.CreateTicketResponse(tickets[0]).Atrasado)
+
+	client, openTickets, responseError := clientServiceTest.FindClientDetail(ctx, point.ClienteID)
+
+	require.Empty(t, responseError)
+	require.Equal(t, point.ClienteID, client.ID)
+	require.Len(t, openTickets, 2)
+	require.Equal(t, overdueTicket.ID, openTickets[0].ID)
+	require.Equal(t, ticket.ID, openTickets[1].ID)
+}
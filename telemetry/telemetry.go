@@ -0,0 +1,92 @@
+package telemetry
+
+import (
+	"context"
+	"log"
+	"os"
+
+	"github.com/joho/godotenv"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// ServiceName nome usado para identificar a API nos traces exportados.
+const ServiceName = "recrutamento-api"
+
+// Exportadores de traces suportados pela variavel OTEL_TRACES_EXPORTER.
+const (
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+	ExporterNone   = "none"
+)
+
+var tracerProvider *sdktrace.TracerProvider
+
+// StartTracer configura o provedor de traces global e o propagador W3C (traceparent).
+func StartTracer() {
+	godotenv.Load()
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{}, propagation.Baggage{}))
+
+	// Sem a variavel os traces não são exportados, para que a saída padrão tenha apenas os logs em JSON.
+	exporterName := os.Getenv("OTEL_TRACES_EXPORTER")
+	if exporterName == "" {
+		exporterName = ExporterNone
+	}
+
+	if exporterName == ExporterNone {
+		return
+	}
+
+	var exporter sdktrace.SpanExporter
+	var err error
+
+	switch exporterName {
+	case ExporterOTLP:
+		exporter, err = otlptracehttp.New(context.Background())
+	case ExporterStdout:
+		exporter, err = stdouttrace.New()
+	default:
+		log.Fatalf("invalid traces exporter: %v", exporterName)
+	}
+
+	if err != nil {
+		log.Fatalf("error to create traces exporter: %v", err)
+	}
+
+	serviceName := os.Getenv("OTEL_SERVICE_NAME")
+	if serviceName == "" {
+		serviceName = ServiceName
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL, semconv.ServiceName(serviceName)))
+	if err != nil {
+		log.Fatalf("error to create traces resource: %v", err)
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+
+	otel.SetTracerProvider(tracerProvider)
+}
+
+// ShutdownTracer exporta os spans pendentes e encerra o provedor de traces.
+func ShutdownTracer() {
+	if tracerProvider == nil {
+		return
+	}
+
+	err := tracerProvider.Shutdown(context.Background())
+	if err != nil {
+		log.Printf("failed to shutdown tracer provider: %v", err)
+	}
+}
@@ -4,14 +4,81 @@ package utils
 const (
 	NameAlreadyExists         = "Name already exists"
 	ClientNotFound            = "Client not found"
+	InvalidClientMerge        = "Invalid client merge, the survivor must not be listed as a duplicate and duplicates must not repeat"
+	ClientMergeConflict       = "Client merge conflict, both clients have a contract on the same address"
+	ClientMergeNotFound       = "Client merge not found"
+	InvalidParentClient       = "Invalid parent client, only juridico clients can form a company group"
+	ClientHierarchyCycle      = "Invalid parent client, the hierarchy would form a cycle"
+	BranchesNotFound          = "Branches not found"
 	InvalidNumberOfCaracter   = "Invalid number of caracter"
 	InvalidClientType         = "Invalid client type"
 	AddressAlreadyExists      = "Address already exists"
 	AddressNotFound           = "Address not found"
+	InvalidSimilarity         = "Invalid similarity, must be between 0 and 1"
 	PointNotFound             = "Point not found"
 	PointAlreadyExists        = "Point already exists"
+	PointAlreadyOwned         = "Point already belongs to this client"
+	InvalidLocation           = "Invalid location, expected lat,lng"
+	InvalidRadius             = "Invalid radius, must be greater than 0 and at most 100000 meters"
+	InvalidPolygon            = "Invalid polygon, expected at least 3 vertices as lat,lng;lat,lng;lat,lng"
+	PlanNotFound              = "Plan not found"
+	PlanAlreadyExists         = "Plan already exists"
+	PlanInUse                 = "Plan in use by active contracts"
+	InvalidSpeed              = "Invalid speed, must be greater than 0"
+	InvalidNegativeValue      = "Invalid value, must not be negative"
+	InvalidPlanChange         = "Invalid plan change, the contract must not be cancelled or already on this plan"
+	InvalidEffectiveDate      = "Invalid effective date, must not be before the last plan change"
+	InvalidCompetencia        = "Invalid competencia, expected a closed month as YYYY-MM"
+	InvoiceNotFound           = "Invoice not found"
+	InvoiceAlreadyPaid        = "Invoice already paid"
+	PaymentAlreadyExists      = "Payment already exists"
+	PaymentNotFound           = "Payment not found"
+	InvalidReturnFile         = "Invalid return file"
 	ContractAlreadyExists     = "Contract already exists"
+	ReservedContractMotive    = "Invalid motive, reserved for the automatic contract changes"
+	InvalidContractDates      = "Invalid contract dates, activation must not be before signature and the end date must be after the start"
+	InvalidRenewalWindow      = "Invalid renewal window, must be between 1 and 365 days"
 	ContractNotFound          = "Contract not found"
+	InvalidRenewal            = "Invalid renewal, the contract must not be cancelled and the new end date must be after the current one and the effective date"
+	ContractVersionNotFound   = "Contract version not found"
+	InvalidVersionDiff        = "Invalid version diff, expected two different version numbers"
+	InvalidDate               = "Invalid date, expected YYYY-MM-DD"
+	ServiceOrderNotFound      = "Service order not found"
+	InvalidServiceOrderWindow = "Invalid service order window, the end must be after the start"
+	InvalidServiceOrderState  = "Invalid service order state change"
+	ScheduleConflict          = "Schedule conflict, the technician already has a service order in the window"
+	TechnicianNotFound        = "Technician not found"
+	TechnicianAlreadyExists   = "Technician already exists"
+	InvalidWorkingHours       = "Invalid working hours, the end must be after the start"
+	OutsideWorkingHours       = "Invalid service order window, outside the technician working hours"
+	SlotNotFound              = "No free slot found"
+	InvalidSuggestionLimit    = "Invalid limit, must be a positive number"
+	EquipmentNotFound         = "Equipment not found"
+	EquipmentAlreadyExists    = "Equipment already exists"
+	InvalidMAC                = "Invalid MAC address"
+	InvalidEquipmentMovement  = "Invalid equipment movement, the installation requires the point and the equipment must change state or point"
+	TicketNotFound            = "Ticket not found"
+	InvalidTicketState        = "Invalid ticket state change, a closed ticket can not be changed"
+	InvalidTicketReference    = "Invalid ticket reference, the point and the contract must belong to the client"
 	Unathorized               = "Unathorized"
 	HistoryOfContractNotFound = "History of contract not found"
+	RequestTimeout            = "Request timeout"
+	DocumentAlreadyExists     = "Document already exists"
+	InvalidDocument           = "Invalid document"
+	ContactNotFound           = "Contact not found"
+	ContactAlreadyExists      = "Contact already exists"
+	InvalidContactType        = "Invalid contact type"
+	InvalidEmail              = "Invalid email"
+	InvalidPhone              = "Invalid phone"
+	PreferredChannelNotFound  = "Preferred channel without contact"
+	InvalidCEP                = "Invalid CEP"
+	InvalidUF                 = "Invalid UF"
+	InvalidCoordinates        = "Invalid coordinates"
+	CEPNotFound               = "CEP not found"
+	InvalidCEPFile            = "Invalid CEP file"
+	RepeatedCEP               = "CEP repeated in the file, the last line was kept"
+	InvalidImportFile         = "Invalid import file, expected a CSV with the columns"
+	InvalidImportMapping      = "Invalid import column mapping, unknown field"
+	InvalidImportNumber       = "Invalid number, expected an integer"
+	AmbiguousClientName       = "Ambiguous client name, more than one client found with the name"
 )
@@ -1,6 +1,11 @@
 package utils
 
-import "strings"
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+)
 
 // Response usada como corpo estatico para a resposta de error em json, que contém mensagem.
 type Response struct {
@@ -29,3 +34,12 @@ func NewResponseError(message string, statusCode int) ResponseError {
 		StatusCode: statusCode,
 	}
 }
+
+// NewInternalResponseError converte um erro inesperado em ResponseError, usando 504 quando o prazo da requisição expirou.
+func NewInternalResponseError(err error) ResponseError {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return NewResponseError(RequestTimeout, http.StatusGatewayTimeout)
+	}
+
+	return NewResponseError(err.Error(), http.StatusInternalServerError)
+}